@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -42,11 +43,21 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	feeCoins := feeTx.GetFee()
 	evmDenom := evmtypes.GetEVMCoinDenom()
 
-	// only allow user to pass in aatom and stake native token as transaction fees
+	// only allow user to pass in aatom and stake native token, or a fee token, as transaction fees
 	// allow use stake native tokens for fees is just for unit tests to pass
 	//
 	// TODO: is the handling of stake necessary here? Why not adjust the tests to contain the correct denom?
 	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+
+	// the tokens of the x/feemarket fee token registry are also accepted
+	var feeToken *feemarkettypes.FeeToken
+	if !validFees && len(feeCoins) == 1 {
+		if ft, found := mpd.feemarketKeeper.GetFeeToken(ctx, feeCoins.GetDenomByIndex(0)); found {
+			feeToken = &ft
+			validFees = true
+		}
+	}
+
 	if !validFees && !simulate {
 		return ctx, fmt.Errorf("expected only native token %s for fee, but got %s", evmDenom, feeCoins.String())
 	}
@@ -85,6 +96,12 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			requiredFees)
 	}
 
+	if feeToken != nil {
+		// the fee is compared to the minimum global fee after converting it to the EVM coin
+		feeAmt := feeToken.ToEVMCoinAmount(math.LegacyNewDecFromInt(feeCoins[0].Amount))
+		feeCoins = sdk.Coins{{Denom: evmDenom, Amount: feeAmt.TruncateInt()}}
+	}
+
 	if !feeCoins.IsAnyGTE(requiredFees) {
 		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
 			"provided fee < minimum global fee (%s < %s). Please increase the gas price.",
@@ -4,7 +4,11 @@ import (
 	"errors"
 	"math/big"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -53,6 +57,16 @@ func checkDisabledCreateCall(
 	return nil
 }
 
+// ValidateTxType checks that the transaction type is enabled by the chain rules
+// at the current height. EIP-7702 set code txs are only accepted once the
+// Prague hard fork is active.
+func ValidateTxType(txData evmtypes.TxData, rules params.Rules) error {
+	if txData.TxType() == ethtypes.SetCodeTxType && !rules.IsPrague {
+		return errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "set code txs are not enabled before the Prague hard fork")
+	}
+	return nil
+}
+
 // ValidateTx validates an Ethereum specific transaction type and returns an error if invalid.
 //
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type.
@@ -100,6 +114,29 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	return authInfo.Fee, nil
 }
 
+// GetFeeToken returns the token of the x/feemarket fee token registry declared
+// in the signed access list of an Ethereum transaction, or nil if the fees are
+// paid in the EVM coin.
+func GetFeeToken(
+	ctx sdktypes.Context,
+	k anteinterfaces.FeeMarketKeeper,
+	accessList ethtypes.AccessList,
+) (*feemarkettypes.FeeToken, error) {
+	feeTokenKey, found, err := evmtypes.GetFeeTokenStorageKey(accessList)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	// the access list only declares the hash of the denomination
+	feeTokens := k.GetFeeTokens(ctx)
+	for i := range feeTokens {
+		if evmtypes.FeeTokenStorageKey(feeTokens[i].Denom) == feeTokenKey {
+			return &feeTokens[i], nil
+		}
+	}
+	return nil, errorsmod.Wrapf(feemarkettypes.ErrFeeTokenNotFound, "the fee token %s is not accepted to pay fees", feeTokenKey)
+}
+
 // CheckTxFee checks if the Amount and GasLimit fields of the txFeeInfo input
 // are equal to the txFee coins and the txGasLimit value.
 // The function expects txFeeInfo to contains coins in the original decimal
@@ -2,6 +2,7 @@ package evm
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	"github.com/cosmos/evm/x/vm/keeper"
@@ -15,24 +16,31 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
+// VerifyAccountBalance checks that the account balance is greater than the total transaction cost,
+// or than the transaction value if the fees are not paid in the EVM coin by the sender, i.e. they
+// are paid by a fee granter or in a fee token.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
-// - from address is NOT an EOA
+// - from address is NOT an EOA (EOAs with an EIP-7702 code delegation are allowed)
 // - account balance is lower than the transaction cost
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper anteinterfaces.AccountKeeper,
+	evmKeeper anteinterfaces.EVMKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	payFees bool,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
-		return errorsmod.Wrapf(
-			errortypes.ErrInvalidType,
-			"the sender is not EOA: address %s", from,
-		)
+		code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+		if _, delegated := ethtypes.ParseDelegation(code); !delegated {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidType,
+				"the sender is not EOA: address %s", from,
+			)
+		}
 	}
 
 	if account == nil {
@@ -41,7 +49,18 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
+	balance := sdkmath.NewIntFromBigInt(account.Balance.ToBig())
+	if !payFees {
+		if balance.BigInt().Cmp(txData.GetValue()) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"failed to check sender balance: sender balance < tx value (%s < %s)", balance, txData.GetValue(),
+			)
+		}
+		return nil
+	}
+
+	if err := keeper.CheckSenderBalance(balance, txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	"github.com/cosmos/evm/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -60,6 +61,17 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// ConvertFeesToFeeToken converts the fees of an Ethereum transaction, in the
+// EVM coin with 18 decimals, to the given fee token. The amount is rounded up.
+func ConvertFeesToFeeToken(fees sdktypes.Coins, feeToken feemarkettypes.FeeToken) sdktypes.Coins {
+	if fees.IsZero() {
+		return fees
+	}
+
+	amount := evmtypes.ConvertBigIntFrom18DecimalsToLegacyDec(fees.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+	return sdktypes.Coins{{Denom: feeToken.Denom, Amount: feeToken.ToFeeTokenAmount(amount).Ceil().TruncateInt()}}
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
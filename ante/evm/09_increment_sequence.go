@@ -4,6 +4,7 @@ import (
 	"math"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -21,9 +22,18 @@ func IncrementNonce(
 	nonce := account.GetSequence()
 	// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 	// with same sender, they'll be accepted.
-	if txNonce != nonce {
+	//
+	// NOTE: the app mempool relies on these errors to queue txs with a nonce gap
+	// and to replace pending ones.
+	if txNonce > nonce {
 		return errorsmod.Wrapf(
-			errortypes.ErrInvalidSequence,
+			evmtypes.ErrNonceGap,
+			"invalid nonce; got %d, expected %d", txNonce, nonce,
+		)
+	}
+	if txNonce < nonce {
+		return errorsmod.Wrapf(
+			evmtypes.ErrNonceLow,
 			"invalid nonce; got %d, expected %d", txNonce, nonce,
 		)
 	}
@@ -7,6 +7,7 @@ import (
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	cosmosevmtypes "github.com/cosmos/evm/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -25,6 +26,8 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
+// - fees paid in a token of the x/feemarket fee token registry are converted to
+// the EVM coin with its conversion rate for the checks and the priority.
 func NewDynamicFeeChecker(k anteinterfaces.FeeMarketKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
@@ -92,7 +95,15 @@ func FeeChecker(
 	}
 
 	feeCoins := feeTx.GetFee()
+	feeToken := getFeeToken(ctx, k, denom, feeCoins)
+
+	feeDenom := denom
 	feeAmtDec := sdkmath.LegacyNewDecFromInt(feeCoins.AmountOfNoDenomValidation(denom))
+	if feeToken != nil {
+		// the fee is checked against the base fee after converting it to the EVM coin
+		feeDenom = feeToken.Denom
+		feeAmtDec = feeToken.ToEVMCoinAmount(sdkmath.LegacyNewDecFromInt(feeCoins[0].Amount))
+	}
 
 	feeCap := feeAmtDec.QuoInt(gas)
 
@@ -103,11 +114,21 @@ func FeeChecker(
 	// calculate the effective gas price using the EIP-1559 logic.
 	effectivePrice := effectiveGasPriceLegacyDec(baseFee, feeCap, maxPriorityPrice)
 
+	effectiveFeeAmt := effectivePrice.MulInt(gas).Ceil().RoundInt()
+	if feeToken != nil {
+		// the fee token amount is capped by the provided fee, which can be
+		// exceeded when rounding up the converted amount.
+		effectiveFeeAmt = sdkmath.MinInt(
+			feeToken.ToFeeTokenAmount(effectivePrice.MulInt(gas)).Ceil().RoundInt(),
+			feeCoins[0].Amount,
+		)
+	}
+
 	// NOTE: create a new coins slice without having to validate the denom
 	effectiveFee := sdk.Coins{
 		{
-			Denom:  denom,
-			Amount: effectivePrice.MulInt(gas).Ceil().RoundInt(),
+			Denom:  feeDenom,
+			Amount: effectiveFeeAmt,
 		},
 	}
 
@@ -121,6 +142,21 @@ func FeeChecker(
 	return effectiveFee, priority, nil
 }
 
+// getFeeToken returns the fee token of the x/feemarket registry used to pay
+// the given fees, or nil if they aren't paid in a single registered token
+// other than the EVM coin.
+func getFeeToken(ctx sdk.Context, k anteinterfaces.FeeMarketKeeper, evmDenom string, fees sdk.Coins) *feemarkettypes.FeeToken {
+	if len(fees) != 1 || fees[0].Denom == evmDenom {
+		return nil
+	}
+
+	feeToken, found := k.GetFeeToken(ctx, fees[0].Denom)
+	if !found {
+		return nil
+	}
+	return &feeToken
+}
+
 // checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
 // unit of gas is fixed and set by each validator, and the tx priority is computed from the gas price.
 func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.FeeTx) (sdk.Coins, int64, error) {
@@ -28,7 +28,8 @@ import (
 var _ anteinterfaces.FeeMarketKeeper = MockFeemarketKeeper{}
 
 type MockFeemarketKeeper struct {
-	BaseFee math.LegacyDec
+	BaseFee   math.LegacyDec
+	FeeTokens []feemarkettypes.FeeToken
 }
 
 func (m MockFeemarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec {
@@ -47,6 +48,19 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+func (m MockFeemarketKeeper) GetFeeTokens(_ sdk.Context) []feemarkettypes.FeeToken {
+	return m.FeeTokens
+}
+
+func (m MockFeemarketKeeper) GetFeeToken(_ sdk.Context, denom string) (feemarkettypes.FeeToken, bool) {
+	for _, feeToken := range m.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+	return feemarkettypes.FeeToken{}, false
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -224,6 +238,59 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee in fee token",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee:   math.LegacyNewDec(10),
+				FeeTokens: []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uusdc", math.LegacyNewDecWithPrec(5, 1), "")},
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction).Add(math.NewInt(10)).QuoRaw(2))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"5000005uusdc",
+			10,
+			true,
+		},
+		{
+			"fail, dynamic fee in fee token lower than base fee",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee:   math.LegacyNewDec(10),
+				FeeTokens: []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uusdc", math.LegacyNewDecWithPrec(5, 1), "")},
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(4))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, dynamic fee in unregistered token",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee: math.LegacyNewDec(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
 		{
 			"fail, negative dynamic fee tipFeeCap",
 			deliverTxCtx,
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // MonoDecorator is a single decorator that handles all the prechecks for
@@ -24,6 +25,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
 }
 
@@ -32,17 +34,21 @@ type MonoDecorator struct {
 //
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
-// decorators using the returned DecoratorUtils
+// decorators using the returned DecoratorUtils.
+//
+// The feegrant keeper can be nil, in which case transactions with a fee granter are rejected.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 	}
 }
@@ -104,7 +110,8 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
-	if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+	isDynamicFeeTx := txData.TxType() == ethtypes.DynamicFeeTxType || txData.TxType() == ethtypes.SetCodeTxType
+	if isDynamicFeeTx && decUtils.BaseFee != nil {
 		// If the base fee is not empty, we compute the effective gas price
 		// according to current base fee price. The gas limit is specified
 		// by the user, while the price is given by the minimum between the
@@ -128,6 +135,10 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if err := ValidateTxType(txData, decUtils.Rules); err != nil {
+		return ctx, err
+	}
+
 	// 5. signature verification
 	if err := SignatureVerification(
 		ethMsg,
@@ -140,6 +151,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
 
+	// the fee granter is declared in the signed access list of the tx, and its
+	// allowance can only pay for the fees of txs signed by the grantee.
+	feeGranter, err := evmtypes.GetFeeGranter(txData.GetAccessList())
+	if err != nil {
+		return ctx, err
+	}
+	if feeGranter != nil && md.feegrantKeeper == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	// the fees can be paid in a token of the x/feemarket fee token registry
+	// declared in the signed access list of the tx, converted from the EVM
+	// coin with its current conversion rate.
+	feeToken, err := GetFeeToken(ctx, md.feeMarketKeeper, txData.GetAccessList())
+	if err != nil {
+		return ctx, err
+	}
+
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
@@ -148,9 +177,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	if err := VerifyAccountBalance(
 		ctx,
 		md.accountKeeper,
+		md.evmKeeper,
 		account,
 		fromAddr,
 		txData,
+		feeGranter.Empty() && feeToken == nil,
 	); err != nil {
 		return ctx, err
 	}
@@ -189,11 +220,32 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if feeToken != nil {
+		msgFees = ConvertFeesToFeeToken(msgFees, *feeToken)
+	}
+
+	feePayer := from
+	if feeGranter != nil {
+		// the allowance is charged the full fee in the denomination the
+		// allowances are granted in, which is the evm denom rather than its
+		// extended denom, rounded up to the decimals of the evm denom.
+		err = md.feegrantKeeper.UseGrantedFees(ctx, feeGranter, from, evmtypes.ConvertCoinsFrom18DecimalsRoundUp(msgFees), msgs)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+		}
+		feePayer = feeGranter
+	}
+
+	// the leftover gas is refunded to the fee payer after the execution, in the
+	// fee token if any
+	md.evmKeeper.SetTransientFeePayer(ctx, feeGranter)
+	md.evmKeeper.SetTransientFeeToken(ctx, feeToken)
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
 		msgFees,
-		from,
+		feePayer,
 	)
 	if err != nil {
 		return ctx, err
@@ -222,7 +274,23 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// current message.
 	decUtils.TxGasLimit += gas
 
-	// 9. increment sequence
+	// 9. gas wanted, fee and block gas limit checks, which run before the nonce
+	// check as the app mempool accepts the txs failing it with a nonce gap or
+	// replacing a pooled one.
+	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
+		return ctx, err
+	}
+
+	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+		return ctx, err
+	}
+
+	newCtx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
+	if err != nil {
+		return ctx, err
+	}
+
+	// 10. increment sequence
 	acc := md.accountKeeper.GetAccount(ctx, from)
 	if acc == nil {
 		// safety check: shouldn't happen
@@ -237,23 +305,9 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// 10. gas wanted
-	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
-		return ctx, err
-	}
-
 	// 11. emit events
 	txIdx := uint64(msgIndex) //nolint:gosec // G115
 	EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
 
-	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
-		return ctx, err
-	}
-
-	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
-	if err != nil {
-		return ctx, err
-	}
-
-	return next(ctx, tx, simulate)
+	return next(newCtx, tx, simulate)
 }
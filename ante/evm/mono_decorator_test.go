@@ -62,7 +62,9 @@ func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address)
 	return uint256.NewInt(0)
 }
 
-func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
+func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context)                            {}
+func (k *ExtendedEVMKeeper) SetTransientFeePayer(_ sdk.Context, _ sdk.AccAddress)           {}
+func (k *ExtendedEVMKeeper) SetTransientFeeToken(_ sdk.Context, _ *feemarkettypes.FeeToken) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
@@ -82,6 +84,13 @@ func (m MockFeeMarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uin
 }
 func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool    { return true }
 func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
+func (m MockFeeMarketKeeper) GetFeeToken(_ sdk.Context, _ string) (feemarkettypes.FeeToken, bool) {
+	return feemarkettypes.FeeToken{}, false
+}
+
+func (m MockFeeMarketKeeper) GetFeeTokens(_ sdk.Context) []feemarkettypes.FeeToken {
+	return nil
+}
 
 // matches the actual signatures
 type MockAccountKeeper struct {
@@ -199,7 +208,7 @@ func TestMonoDecorator(t *testing.T) {
 			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
 			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
 
-			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0)
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, nil, 0)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
 
@@ -28,6 +28,8 @@ type EVMKeeper interface {
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	SetTransientFeePayer(ctx sdk.Context, feePayer sdk.AccAddress)
+	SetTransientFeeToken(ctx sdk.Context, feeToken *feemarkettypes.FeeToken)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
@@ -44,6 +46,12 @@ type FeeMarketKeeper interface {
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	// GetFeeToken returns the token, other than the EVM coin, accepted to pay
+	// the fees of transactions with the given denomination, if any
+	GetFeeToken(ctx sdk.Context, denom string) (feemarkettypes.FeeToken, bool)
+	// GetFeeTokens returns all the tokens, other than the EVM coin, accepted to
+	// pay the fees of transactions
+	GetFeeTokens(ctx sdk.Context) []feemarkettypes.FeeToken
 }
 
 type ProtoTxProvider interface {
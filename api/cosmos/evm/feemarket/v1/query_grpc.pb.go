@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName    = "/cosmos.evm.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/BaseFee"
+	Query_BlockGas_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_FeeTokens_FullMethodName = "/cosmos.evm.feemarket.v1.Query/FeeTokens"
+	Query_FeeToken_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/FeeToken"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeTokens queries the tokens, other than the EVM coin, accepted to pay the
+	// fees of transactions.
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// FeeToken queries a token accepted to pay the fees of transactions.
+	FeeToken(ctx context.Context, in *QueryFeeTokenRequest, opts ...grpc.CallOption) (*QueryFeeTokenResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, Query_FeeTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeToken(ctx context.Context, in *QueryFeeTokenRequest, opts ...grpc.CallOption) (*QueryFeeTokenResponse, error) {
+	out := new(QueryFeeTokenResponse)
+	err := c.cc.Invoke(ctx, Query_FeeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeTokens queries the tokens, other than the EVM coin, accepted to pay the
+	// fees of transactions.
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// FeeToken queries a token accepted to pay the fees of transactions.
+	FeeToken(context.Context, *QueryFeeTokenRequest) (*QueryFeeTokenResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (UnimplementedQueryServer) FeeToken(context.Context, *QueryFeeTokenRequest) (*QueryFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeToken not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeToken(ctx, req.(*QueryFeeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "FeeToken",
+			Handler:    _Query_FeeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.evm.feemarket.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.evm.feemarket.v1.Msg/UpdateParams"
+	Msg_RegisterFeeToken_FullMethodName   = "/cosmos.evm.feemarket.v1.Msg/RegisterFeeToken"
+	Msg_RemoveFeeToken_FullMethodName     = "/cosmos.evm.feemarket.v1.Msg/RemoveFeeToken"
+	Msg_UpdateFeeTokenRate_FullMethodName = "/cosmos.evm.feemarket.v1.Msg/UpdateFeeTokenRate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -30,6 +33,16 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterFeeToken defines a governance operation for registering a token
+	// accepted to pay the fees of transactions, or for replacing an existing
+	// one.
+	RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a token
+	// accepted to pay the fees of transactions.
+	RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error)
+	// UpdateFeeTokenRate updates the conversion rate of a fee token. It can be
+	// executed by the authority or by the rate updater of the token.
+	UpdateFeeTokenRate(ctx context.Context, in *MsgUpdateFeeTokenRate, opts ...grpc.CallOption) (*MsgUpdateFeeTokenRateResponse, error)
 }
 
 type msgClient struct {
@@ -49,6 +62,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error) {
+	out := new(MsgRegisterFeeTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterFeeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error) {
+	out := new(MsgRemoveFeeTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFeeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeTokenRate(ctx context.Context, in *MsgUpdateFeeTokenRate, opts ...grpc.CallOption) (*MsgUpdateFeeTokenRateResponse, error) {
+	out := new(MsgUpdateFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeTokenRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -57,6 +97,16 @@ type MsgServer interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterFeeToken defines a governance operation for registering a token
+	// accepted to pay the fees of transactions, or for replacing an existing
+	// one.
+	RegisterFeeToken(context.Context, *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a token
+	// accepted to pay the fees of transactions.
+	RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error)
+	// UpdateFeeTokenRate updates the conversion rate of a fee token. It can be
+	// executed by the authority or by the rate updater of the token.
+	UpdateFeeTokenRate(context.Context, *MsgUpdateFeeTokenRate) (*MsgUpdateFeeTokenRateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -67,6 +117,15 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RegisterFeeToken(context.Context, *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeToken not implemented")
+}
+func (UnimplementedMsgServer) RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeToken not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeeTokenRate(context.Context, *MsgUpdateFeeTokenRate) (*MsgUpdateFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokenRate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +157,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterFeeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeToken(ctx, req.(*MsgRegisterFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFeeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeToken(ctx, req.(*MsgRemoveFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokenRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeTokenRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokenRate(ctx, req.(*MsgUpdateFeeTokenRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +222,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterFeeToken",
+			Handler:    _Msg_RegisterFeeToken_Handler,
+		},
+		{
+			MethodName: "RemoveFeeToken",
+			Handler:    _Msg_RemoveFeeToken_Handler,
+		},
+		{
+			MethodName: "UpdateFeeTokenRate",
+			Handler:    _Msg_UpdateFeeTokenRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/tx.proto",
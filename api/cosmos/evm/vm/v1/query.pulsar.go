@@ -5376,26 +5376,74 @@ func (x *fastReflection_QueryStorageResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryStorageProofRequest_2_list)(nil)
+
+type _QueryStorageProofRequest_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryStorageProofRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryStorageProofRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryStorageProofRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryStorageProofRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryStorageProofRequest_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryStorageProofRequest at list field Keys as it is not of Message kind"))
+}
+
+func (x *_QueryStorageProofRequest_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryStorageProofRequest_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryStorageProofRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCodeRequest         protoreflect.MessageDescriptor
-	fd_QueryCodeRequest_address protoreflect.FieldDescriptor
+	md_QueryStorageProofRequest         protoreflect.MessageDescriptor
+	fd_QueryStorageProofRequest_address protoreflect.FieldDescriptor
+	fd_QueryStorageProofRequest_keys    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryCodeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeRequest")
-	fd_QueryCodeRequest_address = md_QueryCodeRequest.Fields().ByName("address")
+	md_QueryStorageProofRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryStorageProofRequest")
+	fd_QueryStorageProofRequest_address = md_QueryStorageProofRequest.Fields().ByName("address")
+	fd_QueryStorageProofRequest_keys = md_QueryStorageProofRequest.Fields().ByName("keys")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStorageProofRequest)(nil)
 
-type fastReflection_QueryCodeRequest QueryCodeRequest
+type fastReflection_QueryStorageProofRequest QueryStorageProofRequest
 
-func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCodeRequest)(x)
+func (x *QueryStorageProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofRequest)(x)
 }
 
-func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryStorageProofRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5407,43 +5455,43 @@ func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCodeRequest_messageType fastReflection_QueryCodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCodeRequest_messageType{}
+var _fastReflection_QueryStorageProofRequest_messageType fastReflection_QueryStorageProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStorageProofRequest_messageType{}
 
-type fastReflection_QueryCodeRequest_messageType struct{}
+type fastReflection_QueryStorageProofRequest_messageType struct{}
 
-func (x fastReflection_QueryCodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCodeRequest)(nil)
+func (x fastReflection_QueryStorageProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofRequest)(nil)
 }
-func (x fastReflection_QueryCodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeRequest)
+func (x fastReflection_QueryStorageProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofRequest)
 }
-func (x fastReflection_QueryCodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeRequest
+func (x fastReflection_QueryStorageProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeRequest
+func (x *fastReflection_QueryStorageProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCodeRequest_messageType
+func (x *fastReflection_QueryStorageProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStorageProofRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCodeRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeRequest)
+func (x *fastReflection_QueryStorageProofRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCodeRequest)(x)
+func (x *fastReflection_QueryStorageProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStorageProofRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5451,10 +5499,16 @@ func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryStorageProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryCodeRequest_address, value) {
+		if !f(fd_QueryStorageProofRequest_address, value) {
+			return
+		}
+	}
+	if len(x.Keys) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStorageProofRequest_2_list{list: &x.Keys})
+		if !f(fd_QueryStorageProofRequest_keys, value) {
 			return
 		}
 	}
@@ -5471,15 +5525,17 @@ func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStorageProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.address":
 		return x.Address != ""
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.keys":
+		return len(x.Keys) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5489,15 +5545,17 @@ func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStorageProofRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.address":
 		x.Address = ""
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.keys":
+		x.Keys = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5507,16 +5565,22 @@ func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.keys":
+		if len(x.Keys) == 0 {
+			return protoreflect.ValueOfList(&_QueryStorageProofRequest_2_list{})
+		}
+		listValue := &_QueryStorageProofRequest_2_list{list: &x.Keys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5530,15 +5594,19 @@ func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStorageProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.address":
 		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.keys":
+		lv := value.List()
+		clv := lv.(*_QueryStorageProofRequest_2_list)
+		x.Keys = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5552,40 +5620,49 @@ func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryCodeRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.keys":
+		if x.Keys == nil {
+			x.Keys = []string{}
+		}
+		value := &_QueryStorageProofRequest_2_list{list: &x.Keys}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryStorageProofRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryStorageProofRequest.keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryStorageProofRequest_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStorageProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryStorageProofRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5593,7 +5670,7 @@ func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStorageProofRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5604,7 +5681,7 @@ func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStorageProofRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5616,7 +5693,7 @@ func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCodeRequest) IsValid() bool {
+func (x *fastReflection_QueryStorageProofRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5626,9 +5703,9 @@ func (x *fastReflection_QueryCodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStorageProofRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryStorageProofRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5644,6 +5721,12 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Keys) > 0 {
+			for _, s := range x.Keys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5654,7 +5737,7 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryStorageProofRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5673,6 +5756,15 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Keys) > 0 {
+			for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Keys[iNdEx])
+				copy(dAtA[i:], x.Keys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Keys[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.Address) > 0 {
 			i -= len(x.Address)
 			copy(dAtA[i:], x.Address)
@@ -5691,7 +5783,7 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryStorageProofRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5723,10 +5815,10 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5761,6 +5853,38 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Keys = append(x.Keys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5796,26 +5920,76 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_StorageKeyProof_3_list)(nil)
+
+type _StorageKeyProof_3_list struct {
+	list *[][]byte
+}
+
+func (x *_StorageKeyProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StorageKeyProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_StorageKeyProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StorageKeyProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StorageKeyProof_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StorageKeyProof at list field Proof as it is not of Message kind"))
+}
+
+func (x *_StorageKeyProof_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StorageKeyProof_3_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_StorageKeyProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCodeResponse      protoreflect.MessageDescriptor
-	fd_QueryCodeResponse_code protoreflect.FieldDescriptor
+	md_StorageKeyProof       protoreflect.MessageDescriptor
+	fd_StorageKeyProof_key   protoreflect.FieldDescriptor
+	fd_StorageKeyProof_value protoreflect.FieldDescriptor
+	fd_StorageKeyProof_proof protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryCodeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeResponse")
-	fd_QueryCodeResponse_code = md_QueryCodeResponse.Fields().ByName("code")
+	md_StorageKeyProof = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("StorageKeyProof")
+	fd_StorageKeyProof_key = md_StorageKeyProof.Fields().ByName("key")
+	fd_StorageKeyProof_value = md_StorageKeyProof.Fields().ByName("value")
+	fd_StorageKeyProof_proof = md_StorageKeyProof.Fields().ByName("proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_StorageKeyProof)(nil)
 
-type fastReflection_QueryCodeResponse QueryCodeResponse
+type fastReflection_StorageKeyProof StorageKeyProof
 
-func (x *QueryCodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCodeResponse)(x)
+func (x *StorageKeyProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageKeyProof)(x)
 }
 
-func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
+func (x *StorageKeyProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5827,43 +6001,43 @@ func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCodeResponse_messageType fastReflection_QueryCodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCodeResponse_messageType{}
+var _fastReflection_StorageKeyProof_messageType fastReflection_StorageKeyProof_messageType
+var _ protoreflect.MessageType = fastReflection_StorageKeyProof_messageType{}
 
-type fastReflection_QueryCodeResponse_messageType struct{}
+type fastReflection_StorageKeyProof_messageType struct{}
 
-func (x fastReflection_QueryCodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCodeResponse)(nil)
+func (x fastReflection_StorageKeyProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageKeyProof)(nil)
 }
-func (x fastReflection_QueryCodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeResponse)
+func (x fastReflection_StorageKeyProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageKeyProof)
 }
-func (x fastReflection_QueryCodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeResponse
+func (x fastReflection_StorageKeyProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageKeyProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeResponse
+func (x *fastReflection_StorageKeyProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageKeyProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCodeResponse_messageType
+func (x *fastReflection_StorageKeyProof) Type() protoreflect.MessageType {
+	return _fastReflection_StorageKeyProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCodeResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeResponse)
+func (x *fastReflection_StorageKeyProof) New() protoreflect.Message {
+	return new(fastReflection_StorageKeyProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCodeResponse)(x)
+func (x *fastReflection_StorageKeyProof) Interface() protoreflect.ProtoMessage {
+	return (*StorageKeyProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5871,10 +6045,22 @@ func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Code) != 0 {
-		value := protoreflect.ValueOfBytes(x.Code)
-		if !f(fd_QueryCodeResponse_code, value) {
+func (x *fastReflection_StorageKeyProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_StorageKeyProof_key, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_StorageKeyProof_value, value) {
+			return
+		}
+	}
+	if len(x.Proof) != 0 {
+		value := protoreflect.ValueOfList(&_StorageKeyProof_3_list{list: &x.Proof})
+		if !f(fd_StorageKeyProof_proof, value) {
 			return
 		}
 	}
@@ -5891,15 +6077,19 @@ func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StorageKeyProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		return len(x.Code) != 0
+	case "cosmos.evm.vm.v1.StorageKeyProof.key":
+		return x.Key != ""
+	case "cosmos.evm.vm.v1.StorageKeyProof.value":
+		return x.Value != ""
+	case "cosmos.evm.vm.v1.StorageKeyProof.proof":
+		return len(x.Proof) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageKeyProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageKeyProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5909,15 +6099,19 @@ func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StorageKeyProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		x.Code = nil
+	case "cosmos.evm.vm.v1.StorageKeyProof.key":
+		x.Key = ""
+	case "cosmos.evm.vm.v1.StorageKeyProof.value":
+		x.Value = ""
+	case "cosmos.evm.vm.v1.StorageKeyProof.proof":
+		x.Proof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageKeyProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageKeyProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5927,16 +6121,25 @@ func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageKeyProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		value := x.Code
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.StorageKeyProof.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.StorageKeyProof.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.StorageKeyProof.proof":
+		if len(x.Proof) == 0 {
+			return protoreflect.ValueOfList(&_StorageKeyProof_3_list{})
+		}
+		listValue := &_StorageKeyProof_3_list{list: &x.Proof}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageKeyProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageKeyProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5950,15 +6153,21 @@ func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StorageKeyProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		x.Code = value.Bytes()
+	case "cosmos.evm.vm.v1.StorageKeyProof.key":
+		x.Key = value.Interface().(string)
+	case "cosmos.evm.vm.v1.StorageKeyProof.value":
+		x.Value = value.Interface().(string)
+	case "cosmos.evm.vm.v1.StorageKeyProof.proof":
+		lv := value.List()
+		clv := lv.(*_StorageKeyProof_3_list)
+		x.Proof = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageKeyProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageKeyProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5972,40 +6181,53 @@ func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageKeyProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.QueryCodeResponse is not mutable"))
+	case "cosmos.evm.vm.v1.StorageKeyProof.proof":
+		if x.Proof == nil {
+			x.Proof = [][]byte{}
+		}
+		value := &_StorageKeyProof_3_list{list: &x.Proof}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.StorageKeyProof.key":
+		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.StorageKeyProof is not mutable"))
+	case "cosmos.evm.vm.v1.StorageKeyProof.value":
+		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.StorageKeyProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageKeyProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageKeyProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageKeyProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.StorageKeyProof.key":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.StorageKeyProof.value":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.StorageKeyProof.proof":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_StorageKeyProof_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageKeyProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageKeyProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StorageKeyProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.StorageKeyProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6013,7 +6235,7 @@ func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StorageKeyProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6024,7 +6246,7 @@ func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StorageKeyProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6036,7 +6258,7 @@ func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCodeResponse) IsValid() bool {
+func (x *fastReflection_StorageKeyProof) IsValid() bool {
 	return x != nil
 }
 
@@ -6046,9 +6268,9 @@ func (x *fastReflection_QueryCodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StorageKeyProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*StorageKeyProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6060,10 +6282,20 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Code)
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Proof) > 0 {
+			for _, b := range x.Proof {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6074,7 +6306,7 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*StorageKeyProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6093,10 +6325,26 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+		if len(x.Proof) > 0 {
+			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proof[iNdEx])
+				copy(dAtA[i:], x.Proof[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6111,7 +6359,7 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*StorageKeyProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6143,15 +6391,79 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageKeyProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageKeyProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -6178,10 +6490,8 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
-				if x.Code == nil {
-					x.Code = []byte{}
-				}
+				x.Proof = append(x.Proof, make([]byte, postIndex-iNdEx))
+				copy(x.Proof[len(x.Proof)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6218,28 +6528,79 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryStorageProofResponse_2_list)(nil)
+
+type _QueryStorageProofResponse_2_list struct {
+	list *[]*StorageKeyProof
+}
+
+func (x *_QueryStorageProofResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryStorageProofResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryStorageProofResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageKeyProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryStorageProofResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageKeyProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryStorageProofResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(StorageKeyProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStorageProofResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryStorageProofResponse_2_list) NewElement() protoreflect.Value {
+	v := new(StorageKeyProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStorageProofResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
-	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
-	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryStorageProofResponse              protoreflect.MessageDescriptor
+	fd_QueryStorageProofResponse_storage_hash protoreflect.FieldDescriptor
+	fd_QueryStorageProofResponse_proofs       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTxLogsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
-	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
-	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
+	md_QueryStorageProofResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryStorageProofResponse")
+	fd_QueryStorageProofResponse_storage_hash = md_QueryStorageProofResponse.Fields().ByName("storage_hash")
+	fd_QueryStorageProofResponse_proofs = md_QueryStorageProofResponse.Fields().ByName("proofs")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStorageProofResponse)(nil)
 
-type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
+type fastReflection_QueryStorageProofResponse QueryStorageProofResponse
 
-func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(x)
+func (x *QueryStorageProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofResponse)(x)
 }
 
-func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryStorageProofResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6251,43 +6612,43 @@ func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
+var _fastReflection_QueryStorageProofResponse_messageType fastReflection_QueryStorageProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStorageProofResponse_messageType{}
 
-type fastReflection_QueryTxLogsRequest_messageType struct{}
+type fastReflection_QueryStorageProofResponse_messageType struct{}
 
-func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(nil)
+func (x fastReflection_QueryStorageProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofResponse)(nil)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x fastReflection_QueryStorageProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofResponse)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x fastReflection_QueryStorageProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x *fastReflection_QueryStorageProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsRequest_messageType
+func (x *fastReflection_QueryStorageProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStorageProofResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x *fastReflection_QueryStorageProofResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsRequest)(x)
+func (x *fastReflection_QueryStorageProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStorageProofResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6295,16 +6656,16 @@ func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_QueryTxLogsRequest_hash, value) {
+func (x *fastReflection_QueryStorageProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StorageHash != "" {
+		value := protoreflect.ValueOfString(x.StorageHash)
+		if !f(fd_QueryStorageProofResponse_storage_hash, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsRequest_pagination, value) {
+	if len(x.Proofs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStorageProofResponse_2_list{list: &x.Proofs})
+		if !f(fd_QueryStorageProofResponse_proofs, value) {
 			return
 		}
 	}
@@ -6321,17 +6682,17 @@ func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStorageProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		return x.Hash != ""
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.storage_hash":
+		return x.StorageHash != ""
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.proofs":
+		return len(x.Proofs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6341,17 +6702,17 @@ func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStorageProofResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		x.Hash = ""
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.storage_hash":
+		x.StorageHash = ""
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.proofs":
+		x.Proofs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6361,19 +6722,22 @@ func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		value := x.Hash
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.storage_hash":
+		value := x.StorageHash
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.proofs":
+		if len(x.Proofs) == 0 {
+			return protoreflect.ValueOfList(&_QueryStorageProofResponse_2_list{})
+		}
+		listValue := &_QueryStorageProofResponse_2_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6387,17 +6751,19 @@ func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStorageProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		x.Hash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.storage_hash":
+		x.StorageHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.proofs":
+		lv := value.List()
+		clv := lv.(*_QueryStorageProofResponse_2_list)
+		x.Proofs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6411,48 +6777,49 @@ func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.proofs":
+		if x.Proofs == nil {
+			x.Proofs = []*StorageKeyProof{}
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxLogsRequest is not mutable"))
+		value := &_QueryStorageProofResponse_2_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.storage_hash":
+		panic(fmt.Errorf("field storage_hash of message cosmos.evm.vm.v1.QueryStorageProofResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.storage_hash":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryStorageProofResponse.proofs":
+		list := []*StorageKeyProof{}
+		return protoreflect.ValueOfList(&_QueryStorageProofResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStorageProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryStorageProofResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6460,7 +6827,7 @@ func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStorageProofResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6471,7 +6838,7 @@ func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStorageProofResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6483,7 +6850,7 @@ func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
+func (x *fastReflection_QueryStorageProofResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6493,9 +6860,9 @@ func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStorageProofResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryStorageProofResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6507,13 +6874,15 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.StorageHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Proofs) > 0 {
+			for _, e := range x.Proofs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6525,7 +6894,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryStorageProofResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6544,24 +6913,26 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Proofs) > 0 {
+			for iNdEx := len(x.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proofs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.StorageHash) > 0 {
+			i -= len(x.StorageHash)
+			copy(dAtA[i:], x.StorageHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6576,7 +6947,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryStorageProofResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6608,15 +6979,15 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6644,11 +7015,11 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.StorageHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6675,10 +7046,8 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				x.Proofs = append(x.Proofs, &StorageKeyProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proofs[len(x.Proofs)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6717,79 +7086,26 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
-
-type _QueryTxLogsResponse_1_list struct {
-	list *[]*Log
-}
-
-func (x *_QueryTxLogsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Log)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
-	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
-	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryCodeRequest         protoreflect.MessageDescriptor
+	fd_QueryCodeRequest_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTxLogsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
-	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
-	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
+	md_QueryCodeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeRequest")
+	fd_QueryCodeRequest_address = md_QueryCodeRequest.Fields().ByName("address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeRequest)(nil)
 
-type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
+type fastReflection_QueryCodeRequest QueryCodeRequest
 
-func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(x)
+func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeRequest)(x)
 }
 
-func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6801,43 +7117,43 @@ func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
+var _fastReflection_QueryCodeRequest_messageType fastReflection_QueryCodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeRequest_messageType{}
 
-type fastReflection_QueryTxLogsResponse_messageType struct{}
+type fastReflection_QueryCodeRequest_messageType struct{}
 
-func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(nil)
+func (x fastReflection_QueryCodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeRequest)(nil)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x fastReflection_QueryCodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeRequest)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x fastReflection_QueryCodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x *fastReflection_QueryCodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsResponse_messageType
+func (x *fastReflection_QueryCodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x *fastReflection_QueryCodeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsResponse)(x)
+func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6845,16 +7161,10 @@ func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
-		if !f(fd_QueryTxLogsResponse_logs, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsResponse_pagination, value) {
+func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryCodeRequest_address, value) {
 			return
 		}
 	}
@@ -6871,17 +7181,15 @@ func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		return len(x.Logs) != 0
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6891,17 +7199,15 @@ func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		x.Logs = nil
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6911,22 +7217,16 @@ func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
-		}
-		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6940,19 +7240,15 @@ func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		lv := value.List()
-		clv := lv.(*_QueryTxLogsResponse_1_list)
-		x.Logs = *clv.list
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6966,53 +7262,40 @@ func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
-		}
-		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryCodeRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7020,7 +7303,7 @@ func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7031,7 +7314,7 @@ func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7043,7 +7326,7 @@ func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
+func (x *fastReflection_QueryCodeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7053,9 +7336,9 @@ func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7067,14 +7350,8 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Address)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7087,7 +7364,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7106,35 +7383,12 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7147,7 +7401,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7179,51 +7433,17 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7233,27 +7453,23 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7291,23 +7507,25 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryCodeResponse      protoreflect.MessageDescriptor
+	fd_QueryCodeResponse_code protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryParamsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryCodeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeResponse")
+	fd_QueryCodeResponse_code = md_QueryCodeResponse.Fields().ByName("code")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeResponse)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryCodeResponse QueryCodeResponse
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryCodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeResponse)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7319,43 +7537,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryCodeResponse_messageType fastReflection_QueryCodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeResponse_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryCodeResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryCodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeResponse)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryCodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeResponse)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryCodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryCodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryCodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryCodeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7363,7 +7581,13 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Code) != 0 {
+		value := protoreflect.ValueOfBytes(x.Code)
+		if !f(fd_QueryCodeResponse_code, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7377,13 +7601,15 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		return len(x.Code) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7393,13 +7619,15 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		x.Code = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7409,13 +7637,16 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		value := x.Code
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7429,13 +7660,15 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		x.Code = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7449,36 +7682,40 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.QueryCodeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7486,7 +7723,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7497,7 +7734,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7509,7 +7746,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryCodeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7519,9 +7756,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7533,6 +7770,10 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7543,7 +7784,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7562,6 +7803,13 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7573,7 +7821,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7605,22 +7853,56 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
+				if x.Code == nil {
+					x.Code = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -7647,25 +7929,27 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
+	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
+	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryParamsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_QueryTxLogsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
+	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
+	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7677,43 +7961,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryTxLogsRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7721,10 +8005,16 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_QueryTxLogsRequest_hash, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsRequest_pagination, value) {
 			return
 		}
 	}
@@ -7741,15 +8031,17 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		return x.Hash != ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7759,15 +8051,17 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		x.Hash = ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7777,16 +8071,19 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7800,15 +8097,17 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		x.Hash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7822,44 +8121,48 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxLogsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7867,7 +8170,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7878,7 +8181,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7890,7 +8193,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7900,9 +8203,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7914,8 +8217,12 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7928,7 +8235,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7947,8 +8254,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7959,6 +8266,13 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -7972,7 +8286,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8004,15 +8318,47 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8039,10 +8385,10 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8081,32 +8427,79 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var (
-	md_EthCallRequest                  protoreflect.MessageDescriptor
-	fd_EthCallRequest_args             protoreflect.FieldDescriptor
-	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
-	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
-	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
 
-func init() {
-	file_cosmos_evm_vm_v1_query_proto_init()
-	md_EthCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallRequest")
-	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
-	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
-	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
-	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
+type _QueryTxLogsResponse_1_list struct {
+	list *[]*Log
 }
 
-var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
+func (x *_QueryTxLogsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_EthCallRequest EthCallRequest
+func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(x)
+func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
+func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
+	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
+	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTxLogsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
+	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
+	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
+
+type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
+
+func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(x)
+}
+
+func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8118,43 +8511,43 @@ func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
-var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
+var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
 
-type fastReflection_EthCallRequest_messageType struct{}
+type fastReflection_QueryTxLogsResponse_messageType struct{}
 
-func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(nil)
+func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(nil)
 }
-func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
-func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
-	return _fastReflection_EthCallRequest_messageType
+func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
-	return (*EthCallRequest)(x)
+func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8162,28 +8555,16 @@ func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Args) != 0 {
-		value := protoreflect.ValueOfBytes(x.Args)
-		if !f(fd_EthCallRequest_args, value) {
-			return
-		}
-	}
-	if x.GasCap != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasCap)
-		if !f(fd_EthCallRequest_gas_cap, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_EthCallRequest_proposer_address, value) {
+func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
+		if !f(fd_QueryTxLogsResponse_logs, value) {
 			return
 		}
 	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_EthCallRequest_chain_id, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsResponse_pagination, value) {
 			return
 		}
 	}
@@ -8200,21 +8581,17 @@ func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		return len(x.Args) != 0
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		return x.GasCap != uint64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		return len(x.Logs) != 0
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8224,21 +8601,17 @@ func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		x.Args = nil
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		x.GasCap = uint64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		x.Logs = nil
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8248,25 +8621,22 @@ func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		value := x.Args
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		value := x.GasCap
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
+		}
+		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8280,21 +8650,19 @@ func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		x.Args = value.Bytes()
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		x.GasCap = value.Uint()
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		lv := value.List()
+		clv := lv.(*_QueryTxLogsResponse_1_list)
+		x.Logs = *clv.list
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8308,52 +8676,53 @@ func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
+		}
+		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8361,7 +8730,7 @@ func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8372,7 +8741,7 @@ func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8384,7 +8753,7 @@ func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EthCallRequest) IsValid() bool {
+func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8394,9 +8763,9 @@ func (x *fastReflection_EthCallRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8408,20 +8777,16 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Args)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GasCap != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasCap))
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8432,7 +8797,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8451,29 +8816,35 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.GasCap != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Args) > 0 {
-			i -= len(x.Args)
-			copy(dAtA[i:], x.Args)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8486,7 +8857,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8518,17 +8889,17 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8538,50 +8909,31 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
-				if x.Args == nil {
-					x.Args = []byte{}
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
-				}
-				x.GasCap = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasCap |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8591,45 +8943,28 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8666,29 +9001,23 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EstimateGasResponse          protoreflect.MessageDescriptor
-	fd_EstimateGasResponse_gas      protoreflect.FieldDescriptor
-	fd_EstimateGasResponse_ret      protoreflect.FieldDescriptor
-	fd_EstimateGasResponse_vm_error protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_EstimateGasResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EstimateGasResponse")
-	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
-	fd_EstimateGasResponse_ret = md_EstimateGasResponse.Fields().ByName("ret")
-	fd_EstimateGasResponse_vm_error = md_EstimateGasResponse.Fields().ByName("vm_error")
+	md_QueryParamsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_EstimateGasResponse EstimateGasResponse
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8700,43 +9029,43 @@ func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
-var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_EstimateGasResponse_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
-	return _fastReflection_EstimateGasResponse_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
-	return (*EstimateGasResponse)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8744,25 +9073,7 @@ func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Gas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Gas)
-		if !f(fd_EstimateGasResponse_gas, value) {
-			return
-		}
-	}
-	if len(x.Ret) != 0 {
-		value := protoreflect.ValueOfBytes(x.Ret)
-		if !f(fd_EstimateGasResponse_ret, value) {
-			return
-		}
-	}
-	if x.VmError != "" {
-		value := protoreflect.ValueOfString(x.VmError)
-		if !f(fd_EstimateGasResponse_vm_error, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8776,19 +9087,13 @@ func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		return x.Gas != uint64(0)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		return len(x.Ret) != 0
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		return x.VmError != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8798,19 +9103,13 @@ func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		x.Gas = uint64(0)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		x.Ret = nil
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		x.VmError = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8820,22 +9119,13 @@ func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		value := x.Gas
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		value := x.Ret
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		value := x.VmError
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8849,19 +9139,13 @@ func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		x.Gas = value.Uint()
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		x.Ret = value.Bytes()
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		x.VmError = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8875,48 +9159,36 @@ func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		panic(fmt.Errorf("field gas of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		panic(fmt.Errorf("field vm_error of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EstimateGasResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8924,7 +9196,7 @@ func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8935,7 +9207,7 @@ func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8947,7 +9219,7 @@ func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EstimateGasResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8957,9 +9229,9 @@ func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8971,17 +9243,6 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Gas != 0 {
-			n += 1 + runtime.Sov(uint64(x.Gas))
-		}
-		l = len(x.Ret)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VmError)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8992,7 +9253,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9011,25 +9272,6 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VmError) > 0 {
-			i -= len(x.VmError)
-			copy(dAtA[i:], x.VmError)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Ret) > 0 {
-			i -= len(x.Ret)
-			copy(dAtA[i:], x.Ret)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Gas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9041,7 +9283,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9073,97 +9315,12 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-				}
-				x.Gas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Gas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
-				if x.Ret == nil {
-					x.Ret = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VmError = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9199,141 +9356,74 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+var (
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+)
 
-type _QueryTraceTxRequest_4_list struct {
-	list *[]*MsgEthereumTx
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryParamsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-func (x *_QueryTraceTxRequest_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	(*x.list)[i] = concreteValue
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	*x.list = append(*x.list, concreteValue)
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-
-func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceTxRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
-	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
-	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
-	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
-	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
-	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
-	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
-	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
-	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
-
-type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
-
-func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(x)
-}
-
-func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
-
-type fastReflection_QueryTraceTxRequest_messageType struct{}
-
-func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(nil)
-}
-func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxRequest_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxRequest)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9341,58 +9431,10 @@ func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Msg != nil {
-		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_msg, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_trace_config, value) {
-			return
-		}
-	}
-	if len(x.Predecessors) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
-		if !f(fd_QueryTraceTxRequest_predecessors, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceTxRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceTxRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceTxRequest_chain_id, value) {
-			return
-		}
-	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -9409,31 +9451,15 @@ func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		return x.Msg != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		return len(x.Predecessors) != 0
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9443,31 +9469,15 @@ func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		x.Msg = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		x.Predecessors = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9477,43 +9487,16 @@ func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		value := x.Msg
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		if len(x.Predecessors) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
-		}
-		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		value := x.BlockTime
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9527,33 +9510,15 @@ func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		x.Msg = value.Message().Interface().(*MsgEthereumTx)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		lv := value.List()
-		clv := lv.(*_QueryTraceTxRequest_4_list)
-		x.Predecessors = *clv.list
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9567,89 +9532,44 @@ func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		if x.Msg == nil {
-			x.Msg = new(MsgEthereumTx)
-		}
-		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		if x.Predecessors == nil {
-			x.Predecessors = []*MsgEthereumTx{}
-		}
-		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		m := new(MsgEthereumTx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		m := new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9657,7 +9577,7 @@ func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9668,7 +9588,7 @@ func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9680,7 +9600,7 @@ func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9690,9 +9610,9 @@ func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9704,41 +9624,10 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Msg != nil {
-			l = options.Size(x.Msg)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Predecessors) > 0 {
-			for _, e := range x.Predecessors {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9749,7 +9638,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9768,81 +9657,8 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Predecessors) > 0 {
-			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Predecessors[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Msg != nil {
-			encoded, err := options.Marshal(x.Msg)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9866,7 +9682,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9898,15 +9714,15 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9933,256 +9749,27 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Msg == nil {
-					x.Msg = &MsgEthereumTx{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-				}
-				x.BlockMaxGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -10205,25 +9792,35 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+	md_EthCallRequest                  protoreflect.MessageDescriptor
+	fd_EthCallRequest_args             protoreflect.FieldDescriptor
+	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
+	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
+	fd_EthCallRequest_overrides        protoreflect.FieldDescriptor
+	fd_EthCallRequest_block_overrides  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceTxResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
-	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+	md_EthCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallRequest")
+	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
+	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
+	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
+	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
+	fd_EthCallRequest_overrides = md_EthCallRequest.Fields().ByName("overrides")
+	fd_EthCallRequest_block_overrides = md_EthCallRequest.Fields().ByName("block_overrides")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
 
-type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+type fastReflection_EthCallRequest EthCallRequest
 
-func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(x)
+func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(x)
 }
 
-func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10235,43 +9832,43 @@ func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
 
-type fastReflection_QueryTraceTxResponse_messageType struct{}
+type fastReflection_EthCallRequest_messageType struct{}
 
-func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(nil)
+func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(nil)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxResponse_messageType
+func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxResponse)(x)
+func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
+	return (*EthCallRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10279,10 +9876,40 @@ func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceTxResponse_data, value) {
+func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfBytes(x.Args)
+		if !f(fd_EthCallRequest_args, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_EthCallRequest_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_EthCallRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_EthCallRequest_chain_id, value) {
+			return
+		}
+	}
+	if len(x.Overrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.Overrides)
+		if !f(fd_EthCallRequest_overrides, value) {
+			return
+		}
+	}
+	if len(x.BlockOverrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.BlockOverrides)
+		if !f(fd_EthCallRequest_block_overrides, value) {
 			return
 		}
 	}
@@ -10299,15 +9926,25 @@ func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		return len(x.Args) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		return len(x.Overrides) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.block_overrides":
+		return len(x.BlockOverrides) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10317,15 +9954,25 @@ func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		x.Data = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		x.Args = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		x.Overrides = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.block_overrides":
+		x.BlockOverrides = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10335,16 +9982,31 @@ func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		value := x.Data
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		value := x.Args
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.block_overrides":
+		value := x.BlockOverrides
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10358,15 +10020,25 @@ func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		x.Args = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		x.Overrides = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.block_overrides":
+		x.BlockOverrides = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10380,40 +10052,60 @@ func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceTxResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		panic(fmt.Errorf("field overrides of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.block_overrides":
+		panic(fmt.Errorf("field block_overrides of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.block_overrides":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10421,7 +10113,7 @@ func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10432,7 +10124,7 @@ func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10444,7 +10136,7 @@ func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+func (x *fastReflection_EthCallRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10454,9 +10146,9 @@ func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10468,7 +10160,25 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
+		l = len(x.Args)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Overrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockOverrides)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -10482,7 +10192,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10501,10 +10211,41 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.BlockOverrides) > 0 {
+			i -= len(x.BlockOverrides)
+			copy(dAtA[i:], x.BlockOverrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockOverrides)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Overrides) > 0 {
+			i -= len(x.Overrides)
+			copy(dAtA[i:], x.Overrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Overrides)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -10519,7 +10260,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10551,15 +10292,15 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -10586,35 +10327,175 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
+				if x.Args == nil {
+					x.Args = []byte{}
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Overrides = append(x.Overrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.Overrides == nil {
+					x.Overrides = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockOverrides = append(x.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.BlockOverrides == nil {
+					x.BlockOverrides = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
 	return &protoiface.Methods{
 		NoUnkeyedLiterals: struct{}{},
 		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
@@ -10626,91 +10507,30 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
-
-type _QueryTraceBlockRequest_1_list struct {
-	list *[]*MsgEthereumTx
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+	md_EstimateGasResponse          protoreflect.MessageDescriptor
+	fd_EstimateGasResponse_gas      protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_ret      protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_vm_error protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceBlockRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
-	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
-	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
-	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
-	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
-	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
-	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
-	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+	md_EstimateGasResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EstimateGasResponse")
+	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
+	fd_EstimateGasResponse_ret = md_EstimateGasResponse.Fields().ByName("ret")
+	fd_EstimateGasResponse_vm_error = md_EstimateGasResponse.Fields().ByName("vm_error")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
 
-type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+type fastReflection_EstimateGasResponse EstimateGasResponse
 
-func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(x)
+func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(x)
 }
 
-func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10722,43 +10542,43 @@ func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
+var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
 
-type fastReflection_QueryTraceBlockRequest_messageType struct{}
+type fastReflection_EstimateGasResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(nil)
+func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(nil)
 }
-func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
 }
-func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
+func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
+func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockRequest_messageType
+func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EstimateGasResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockRequest)(x)
+func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
+	return (*EstimateGasResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10766,52 +10586,22 @@ func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
-		if !f(fd_QueryTraceBlockRequest_txs, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceBlockRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
+func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Gas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Gas)
+		if !f(fd_EstimateGasResponse_gas, value) {
 			return
 		}
 	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
+	if len(x.Ret) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ret)
+		if !f(fd_EstimateGasResponse_ret, value) {
 			return
 		}
 	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+	if x.VmError != "" {
+		value := protoreflect.ValueOfString(x.VmError)
+		if !f(fd_EstimateGasResponse_vm_error, value) {
 			return
 		}
 	}
@@ -10828,29 +10618,19 @@ func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		return len(x.Txs) != 0
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		return x.Gas != uint64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		return len(x.Ret) != 0
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		return x.VmError != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10860,29 +10640,19 @@ func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		x.Txs = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		x.Gas = uint64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		x.Ret = nil
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		x.VmError = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10892,40 +10662,22 @@ func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
-		}
-		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		value := x.BlockTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		value := x.ProposerAddress
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		value := x.Gas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		value := x.Ret
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		value := x.VmError
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10939,31 +10691,19 @@ func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		lv := value.List()
-		clv := lv.(*_QueryTraceBlockRequest_1_list)
-		x.Txs = *clv.list
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		x.Gas = value.Uint()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		x.Ret = value.Bytes()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		x.VmError = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10977,81 +10717,48 @@ func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		if x.Txs == nil {
-			x.Txs = []*MsgEthereumTx{}
-		}
-		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		panic(fmt.Errorf("field gas of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		panic(fmt.Errorf("field vm_error of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EstimateGasResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11059,7 +10766,7 @@ func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11070,7 +10777,7 @@ func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11082,7 +10789,7 @@ func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11092,9 +10799,9 @@ func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11106,37 +10813,17 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
 		}
-		l = len(x.BlockHash)
+		l = len(x.Ret)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposerAddress)
+		l = len(x.VmError)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11147,7 +10834,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11166,78 +10853,24 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+		if len(x.VmError) > 0 {
+			i -= len(x.VmError)
+			copy(dAtA[i:], x.VmError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1a
 		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if len(x.Ret) > 0 {
+			i -= len(x.Ret)
+			copy(dAtA[i:], x.Ret)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x12
 		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11250,7 +10883,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11282,17 +10915,17 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 				}
-				var msglen int
+				x.Gas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11302,31 +10935,16 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Gas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Txs = append(x.Txs, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11336,50 +10954,29 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ret == nil {
+					x.Ret = []byte{}
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11407,102 +11004,4977 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				x.VmError = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateV1Request                  protoreflect.MessageDescriptor
+	fd_QuerySimulateV1Request_opts             protoreflect.FieldDescriptor
+	fd_QuerySimulateV1Request_gas_cap          protoreflect.FieldDescriptor
+	fd_QuerySimulateV1Request_proposer_address protoreflect.FieldDescriptor
+	fd_QuerySimulateV1Request_chain_id         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QuerySimulateV1Request = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QuerySimulateV1Request")
+	fd_QuerySimulateV1Request_opts = md_QuerySimulateV1Request.Fields().ByName("opts")
+	fd_QuerySimulateV1Request_gas_cap = md_QuerySimulateV1Request.Fields().ByName("gas_cap")
+	fd_QuerySimulateV1Request_proposer_address = md_QuerySimulateV1Request.Fields().ByName("proposer_address")
+	fd_QuerySimulateV1Request_chain_id = md_QuerySimulateV1Request.Fields().ByName("chain_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateV1Request)(nil)
+
+type fastReflection_QuerySimulateV1Request QuerySimulateV1Request
+
+func (x *QuerySimulateV1Request) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateV1Request)(x)
+}
+
+func (x *QuerySimulateV1Request) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateV1Request_messageType fastReflection_QuerySimulateV1Request_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateV1Request_messageType{}
+
+type fastReflection_QuerySimulateV1Request_messageType struct{}
+
+func (x fastReflection_QuerySimulateV1Request_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateV1Request)(nil)
+}
+func (x fastReflection_QuerySimulateV1Request_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateV1Request)
+}
+func (x fastReflection_QuerySimulateV1Request_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateV1Request
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateV1Request) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateV1Request
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateV1Request) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateV1Request_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateV1Request) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateV1Request)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateV1Request) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateV1Request)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateV1Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Opts) != 0 {
+		value := protoreflect.ValueOfBytes(x.Opts)
+		if !f(fd_QuerySimulateV1Request_opts, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_QuerySimulateV1Request_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QuerySimulateV1Request_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QuerySimulateV1Request_chain_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateV1Request) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.opts":
+		return len(x.Opts) != 0
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.chain_id":
+		return x.ChainId != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Request) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.opts":
+		x.Opts = nil
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.chain_id":
+		x.ChainId = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateV1Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.opts":
+		value := x.Opts
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Request does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.opts":
+		x.Opts = value.Bytes()
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.chain_id":
+		x.ChainId = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.opts":
+		panic(fmt.Errorf("field opts of message cosmos.evm.vm.v1.QuerySimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.QuerySimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QuerySimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QuerySimulateV1Request is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateV1Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.opts":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QuerySimulateV1Request.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateV1Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QuerySimulateV1Request", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateV1Request) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Request) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateV1Request) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateV1Request) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateV1Request)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Opts)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateV1Request)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Opts) > 0 {
+			i -= len(x.Opts)
+			copy(dAtA[i:], x.Opts)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Opts)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateV1Request)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateV1Request: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Opts = append(x.Opts[:0], dAtA[iNdEx:postIndex]...)
+				if x.Opts == nil {
+					x.Opts = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySimulateV1Response      protoreflect.MessageDescriptor
+	fd_QuerySimulateV1Response_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QuerySimulateV1Response = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QuerySimulateV1Response")
+	fd_QuerySimulateV1Response_data = md_QuerySimulateV1Response.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateV1Response)(nil)
+
+type fastReflection_QuerySimulateV1Response QuerySimulateV1Response
+
+func (x *QuerySimulateV1Response) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateV1Response)(x)
+}
+
+func (x *QuerySimulateV1Response) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateV1Response_messageType fastReflection_QuerySimulateV1Response_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateV1Response_messageType{}
+
+type fastReflection_QuerySimulateV1Response_messageType struct{}
+
+func (x fastReflection_QuerySimulateV1Response_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateV1Response)(nil)
+}
+func (x fastReflection_QuerySimulateV1Response_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateV1Response)
+}
+func (x fastReflection_QuerySimulateV1Response_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateV1Response
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateV1Response) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateV1Response
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateV1Response) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateV1Response_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateV1Response) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateV1Response)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateV1Response) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateV1Response)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateV1Response) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QuerySimulateV1Response_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateV1Response) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Response.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Response) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Response.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateV1Response) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Response.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Response does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Response) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Response.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Response) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Response.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QuerySimulateV1Response is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateV1Response) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QuerySimulateV1Response.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QuerySimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QuerySimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateV1Response) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QuerySimulateV1Response", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateV1Response) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateV1Response) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateV1Response) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateV1Response) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateV1Response)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateV1Response)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateV1Response)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateV1Response: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+
+type _QueryTraceTxRequest_4_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceTxRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceTxRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
+	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
+	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
+	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
+	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
+	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
+	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
+	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
+	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+
+type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+
+func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(x)
+}
+
+func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+
+type fastReflection_QueryTraceTxRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(nil)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_msg, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_trace_config, value) {
+			return
+		}
+	}
+	if len(x.Predecessors) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
+		if !f(fd_QueryTraceTxRequest_predecessors, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceTxRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceTxRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceTxRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		return x.Msg != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		return len(x.Predecessors) != 0
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		x.Msg = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		x.Predecessors = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		if len(x.Predecessors) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
+		}
+		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		x.Msg = value.Message().Interface().(*MsgEthereumTx)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		lv := value.List()
+		clv := lv.(*_QueryTraceTxRequest_4_list)
+		x.Predecessors = *clv.list
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		if x.Msg == nil {
+			x.Msg = new(MsgEthereumTx)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		if x.Predecessors == nil {
+			x.Predecessors = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		m := new(MsgEthereumTx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Predecessors) > 0 {
+			for _, e := range x.Predecessors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Predecessors) > 0 {
+			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Predecessors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &MsgEthereumTx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceTxResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
+	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+
+type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+
+func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(x)
+}
+
+func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+
+type fastReflection_QueryTraceTxResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(nil)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceTxResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceTxResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceCallRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceCallRequest_args             protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_overrides        protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_block_overrides  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceCallRequest")
+	fd_QueryTraceCallRequest_args = md_QueryTraceCallRequest.Fields().ByName("args")
+	fd_QueryTraceCallRequest_gas_cap = md_QueryTraceCallRequest.Fields().ByName("gas_cap")
+	fd_QueryTraceCallRequest_proposer_address = md_QueryTraceCallRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceCallRequest_chain_id = md_QueryTraceCallRequest.Fields().ByName("chain_id")
+	fd_QueryTraceCallRequest_trace_config = md_QueryTraceCallRequest.Fields().ByName("trace_config")
+	fd_QueryTraceCallRequest_overrides = md_QueryTraceCallRequest.Fields().ByName("overrides")
+	fd_QueryTraceCallRequest_block_overrides = md_QueryTraceCallRequest.Fields().ByName("block_overrides")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceCallRequest)(nil)
+
+type fastReflection_QueryTraceCallRequest QueryTraceCallRequest
+
+func (x *QueryTraceCallRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallRequest)(x)
+}
+
+func (x *QueryTraceCallRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceCallRequest_messageType fastReflection_QueryTraceCallRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceCallRequest_messageType{}
+
+type fastReflection_QueryTraceCallRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceCallRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallRequest)(nil)
+}
+func (x fastReflection_QueryTraceCallRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallRequest)
+}
+func (x fastReflection_QueryTraceCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceCallRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceCallRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceCallRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceCallRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceCallRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceCallRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfBytes(x.Args)
+		if !f(fd_QueryTraceCallRequest_args, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_QueryTraceCallRequest_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceCallRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceCallRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceCallRequest_trace_config, value) {
+			return
+		}
+	}
+	if len(x.Overrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.Overrides)
+		if !f(fd_QueryTraceCallRequest_overrides, value) {
+			return
+		}
+	}
+	if len(x.BlockOverrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.BlockOverrides)
+		if !f(fd_QueryTraceCallRequest_block_overrides, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		return len(x.Args) != 0
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.overrides":
+		return len(x.Overrides) != 0
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_overrides":
+		return len(x.BlockOverrides) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		x.Args = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.overrides":
+		x.Overrides = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_overrides":
+		x.BlockOverrides = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		value := x.Args
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_overrides":
+		value := x.BlockOverrides
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		x.Args = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.overrides":
+		x.Overrides = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_overrides":
+		x.BlockOverrides = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.overrides":
+		panic(fmt.Errorf("field overrides of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_overrides":
+		panic(fmt.Errorf("field block_overrides of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.overrides":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_overrides":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceCallRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceCallRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceCallRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceCallRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Args)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Overrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockOverrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BlockOverrides) > 0 {
+			i -= len(x.BlockOverrides)
+			copy(dAtA[i:], x.BlockOverrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockOverrides)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Overrides) > 0 {
+			i -= len(x.Overrides)
+			copy(dAtA[i:], x.Overrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Overrides)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
+				if x.Args == nil {
+					x.Args = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Overrides = append(x.Overrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.Overrides == nil {
+					x.Overrides = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockOverrides = append(x.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.BlockOverrides == nil {
+					x.BlockOverrides = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceCallResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceCallResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceCallResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceCallResponse")
+	fd_QueryTraceCallResponse_data = md_QueryTraceCallResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceCallResponse)(nil)
+
+type fastReflection_QueryTraceCallResponse QueryTraceCallResponse
+
+func (x *QueryTraceCallResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallResponse)(x)
+}
+
+func (x *QueryTraceCallResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceCallResponse_messageType fastReflection_QueryTraceCallResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceCallResponse_messageType{}
+
+type fastReflection_QueryTraceCallResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceCallResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallResponse)(nil)
+}
+func (x fastReflection_QueryTraceCallResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallResponse)
+}
+func (x fastReflection_QueryTraceCallResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceCallResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceCallResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceCallResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceCallResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceCallResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceCallResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceCallResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceCallResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceCallResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceCallResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceCallResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceCallResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceCallResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceCallResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceCallResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceCallResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceCallResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
+
+type _QueryTraceBlockRequest_1_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceBlockRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
+	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
+	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
+	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
+	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
+	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
+	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
+	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+
+type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+
+func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(x)
+}
+
+func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
+
+type fastReflection_QueryTraceBlockRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(nil)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
+		if !f(fd_QueryTraceBlockRequest_txs, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceBlockRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		return len(x.Txs) != 0
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		x.Txs = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
+		}
+		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		lv := value.List()
+		clv := lv.(*_QueryTraceBlockRequest_1_list)
+		x.Txs = *clv.list
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		if x.Txs == nil {
+			x.Txs = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceBlockResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
+	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+
+type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+
+func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(x)
+}
+
+func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+
+type fastReflection_QueryTraceBlockResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(nil)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceBlockResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceBlockResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
-				x.BlockMaxGas = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11512,11 +15984,26 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11552,27 +16039,73 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_QueryIntermediateRootsResponse_1_list)(nil)
+
+type _QueryIntermediateRootsResponse_1_list struct {
+	list *[][]byte
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryIntermediateRootsResponse at list field Roots as it is not of Message kind"))
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_QueryIntermediateRootsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+	md_QueryIntermediateRootsResponse       protoreflect.MessageDescriptor
+	fd_QueryIntermediateRootsResponse_roots protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceBlockResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
-	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+	md_QueryIntermediateRootsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryIntermediateRootsResponse")
+	fd_QueryIntermediateRootsResponse_roots = md_QueryIntermediateRootsResponse.Fields().ByName("roots")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryIntermediateRootsResponse)(nil)
 
-type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+type fastReflection_QueryIntermediateRootsResponse QueryIntermediateRootsResponse
 
-func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(x)
+func (x *QueryIntermediateRootsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryIntermediateRootsResponse)(x)
 }
 
-func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+func (x *QueryIntermediateRootsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11583,43 +16116,43 @@ func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+var _fastReflection_QueryIntermediateRootsResponse_messageType fastReflection_QueryIntermediateRootsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryIntermediateRootsResponse_messageType{}
 
-type fastReflection_QueryTraceBlockResponse_messageType struct{}
+type fastReflection_QueryIntermediateRootsResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(nil)
+func (x fastReflection_QueryIntermediateRootsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryIntermediateRootsResponse)(nil)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x fastReflection_QueryIntermediateRootsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryIntermediateRootsResponse)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x fastReflection_QueryIntermediateRootsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIntermediateRootsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x *fastReflection_QueryIntermediateRootsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryIntermediateRootsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockResponse_messageType
+func (x *fastReflection_QueryIntermediateRootsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryIntermediateRootsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x *fastReflection_QueryIntermediateRootsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryIntermediateRootsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockResponse)(x)
+func (x *fastReflection_QueryIntermediateRootsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryIntermediateRootsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11627,10 +16160,10 @@ func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceBlockResponse_data, value) {
+func (x *fastReflection_QueryIntermediateRootsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Roots) != 0 {
+		value := protoreflect.ValueOfList(&_QueryIntermediateRootsResponse_1_list{list: &x.Roots})
+		if !f(fd_QueryIntermediateRootsResponse_roots, value) {
 			return
 		}
 	}
@@ -11647,15 +16180,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryIntermediateRootsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.QueryIntermediateRootsResponse.roots":
+		return len(x.Roots) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryIntermediateRootsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryIntermediateRootsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11665,15 +16198,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryIntermediateRootsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		x.Data = nil
+	case "cosmos.evm.vm.v1.QueryIntermediateRootsResponse.roots":
+		x.Roots = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryIntermediateRootsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryIntermediateRootsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11683,16 +16216,19 @@ func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIntermediateRootsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryIntermediateRootsResponse.roots":
+		if len(x.Roots) == 0 {
+			return protoreflect.ValueOfList(&_QueryIntermediateRootsResponse_1_list{})
+		}
+		listValue := &_QueryIntermediateRootsResponse_1_list{list: &x.Roots}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryIntermediateRootsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryIntermediateRootsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11706,15 +16242,17 @@ func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryIntermediateRootsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryIntermediateRootsResponse.roots":
+		lv := value.List()
+		clv := lv.(*_QueryIntermediateRootsResponse_1_list)
+		x.Roots = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryIntermediateRootsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryIntermediateRootsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11728,40 +16266,45 @@ func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIntermediateRootsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceBlockResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryIntermediateRootsResponse.roots":
+		if x.Roots == nil {
+			x.Roots = [][]byte{}
+		}
+		value := &_QueryIntermediateRootsResponse_1_list{list: &x.Roots}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryIntermediateRootsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryIntermediateRootsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryIntermediateRootsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryIntermediateRootsResponse.roots":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_QueryIntermediateRootsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryIntermediateRootsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryIntermediateRootsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryIntermediateRootsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryIntermediateRootsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11769,7 +16312,7 @@ func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryIntermediateRootsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11780,7 +16323,7 @@ func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryIntermediateRootsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11792,7 +16335,7 @@ func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+func (x *fastReflection_QueryIntermediateRootsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11802,9 +16345,9 @@ func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryIntermediateRootsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryIntermediateRootsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11816,9 +16359,11 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Roots) > 0 {
+			for _, b := range x.Roots {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11830,7 +16375,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryIntermediateRootsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11849,12 +16394,14 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Roots) > 0 {
+			for iNdEx := len(x.Roots) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Roots[iNdEx])
+				copy(dAtA[i:], x.Roots[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Roots[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11867,7 +16414,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryIntermediateRootsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11899,15 +16446,15 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIntermediateRootsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryIntermediateRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -11934,10 +16481,8 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
-				}
+				x.Roots = append(x.Roots, make([]byte, postIndex-iNdEx))
+				copy(x.Roots[len(x.Roots)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -11992,7 +16537,7 @@ func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12350,7 +16895,7 @@ func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12768,7 +17313,7 @@ func (x *QueryGlobalMinGasPriceRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13126,7 +17671,7 @@ func (x *QueryGlobalMinGasPriceResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14041,6 +18586,158 @@ func (x *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryStorageProofRequest is the request type for the Query/StorageProof RPC
+// method.
+type QueryStorageProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address to compute the storage root of.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// keys defines the storage keys to prove.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *QueryStorageProofRequest) Reset() {
+	*x = QueryStorageProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStorageProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorageProofRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryStorageProofRequest.ProtoReflect.Descriptor instead.
+func (*QueryStorageProofRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryStorageProofRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryStorageProofRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// StorageKeyProof is the Merkle-Patricia proof of a storage key.
+type StorageKeyProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key defines the storage key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value defines the storage value associated with the key.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proof defines the RLP encoded trie nodes from the storage root to the
+	// key, as returned by `eth_getProof`.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *StorageKeyProof) Reset() {
+	*x = StorageKeyProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageKeyProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageKeyProof) ProtoMessage() {}
+
+// Deprecated: Use StorageKeyProof.ProtoReflect.Descriptor instead.
+func (*StorageKeyProof) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StorageKeyProof) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StorageKeyProof) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StorageKeyProof) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// QueryStorageProofResponse is the response type for the Query/StorageProof
+// RPC method.
+type QueryStorageProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// storage_hash is the root hash of the Merkle-Patricia trie of the account
+	// storage, computed as in Ethereum. It is not committed to by the app hash
+	// or any block header, so the proofs are only consistent with this root.
+	StorageHash string `protobuf:"bytes,1,opt,name=storage_hash,json=storageHash,proto3" json:"storage_hash,omitempty"`
+	// proofs defines the proofs of the requested storage keys.
+	Proofs []*StorageKeyProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *QueryStorageProofResponse) Reset() {
+	*x = QueryStorageProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStorageProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorageProofResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryStorageProofResponse.ProtoReflect.Descriptor instead.
+func (*QueryStorageProofResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryStorageProofResponse) GetStorageHash() string {
+	if x != nil {
+		return x.StorageHash
+	}
+	return ""
+}
+
+func (x *QueryStorageProofResponse) GetProofs() []*StorageKeyProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	state         protoimpl.MessageState
@@ -14054,7 +18751,7 @@ type QueryCodeRequest struct {
 func (x *QueryCodeRequest) Reset() {
 	*x = QueryCodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14068,7 +18765,7 @@ func (*QueryCodeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCodeRequest.ProtoReflect.Descriptor instead.
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryCodeRequest) GetAddress() string {
@@ -14092,7 +18789,7 @@ type QueryCodeResponse struct {
 func (x *QueryCodeResponse) Reset() {
 	*x = QueryCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14106,7 +18803,7 @@ func (*QueryCodeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCodeResponse.ProtoReflect.Descriptor instead.
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryCodeResponse) GetCode() []byte {
@@ -14131,7 +18828,7 @@ type QueryTxLogsRequest struct {
 func (x *QueryTxLogsRequest) Reset() {
 	*x = QueryTxLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14145,7 +18842,7 @@ func (*QueryTxLogsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryTxLogsRequest) GetHash() string {
@@ -14177,7 +18874,7 @@ type QueryTxLogsResponse struct {
 func (x *QueryTxLogsResponse) Reset() {
 	*x = QueryTxLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14191,7 +18888,7 @@ func (*QueryTxLogsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryTxLogsResponse) GetLogs() []*Log {
@@ -14218,7 +18915,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14232,7 +18929,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 // QueryParamsResponse defines the response type for querying x/vm parameters.
@@ -14248,7 +18945,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14262,7 +18959,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -14286,12 +18983,18 @@ type EthCallRequest struct {
 	ProposerAddress []byte `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the json encoded state overrides (balance, nonce, code, state
+	// and state diff per account) applied before executing the call
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the json encoded block overrides (number, time, fee
+	// recipient and base fee) applied to the block context of the call
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (x *EthCallRequest) Reset() {
 	*x = EthCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14305,7 +19008,7 @@ func (*EthCallRequest) ProtoMessage() {}
 
 // Deprecated: Use EthCallRequest.ProtoReflect.Descriptor instead.
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EthCallRequest) GetArgs() []byte {
@@ -14336,6 +19039,20 @@ func (x *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (x *EthCallRequest) GetOverrides() []byte {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *EthCallRequest) GetBlockOverrides() []byte {
+	if x != nil {
+		return x.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	state         protoimpl.MessageState
@@ -14354,7 +19071,7 @@ type EstimateGasResponse struct {
 func (x *EstimateGasResponse) Reset() {
 	*x = EstimateGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14368,7 +19085,7 @@ func (*EstimateGasResponse) ProtoMessage() {}
 
 // Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *EstimateGasResponse) GetGas() uint64 {
@@ -14392,118 +19109,351 @@ func (x *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// QuerySimulateV1Request defines SimulateV1 request
+type QuerySimulateV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// opts is the json encoded simulation options (block state calls, trace
+	// transfers, validation and return full transactions), using the same json
+	// format as the json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the gas budget shared by all the simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress []byte `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *QuerySimulateV1Request) Reset() {
+	*x = QuerySimulateV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySimulateV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateV1Request) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateV1Request.ProtoReflect.Descriptor instead.
+func (*QuerySimulateV1Request) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuerySimulateV1Request) GetOpts() []byte {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+func (x *QuerySimulateV1Request) GetGasCap() uint64 {
+	if x != nil {
+		return x.GasCap
+	}
+	return 0
+}
+
+func (x *QuerySimulateV1Request) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *QuerySimulateV1Request) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+// QuerySimulateV1Response defines SimulateV1 response
+type QuerySimulateV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *QuerySimulateV1Response) Reset() {
+	*x = QuerySimulateV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySimulateV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateV1Response) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateV1Response.ProtoReflect.Descriptor instead.
+func (*QuerySimulateV1Response) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuerySimulateV1Response) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// msg is the MsgEthereumTx for the requested transaction
-	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// trace_config holds extra parameters to trace functions.
-	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
-	// predecessors is an array of transactions included in the same block
-	// need to be replayed first to get correct context for tracing.
-	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
-	// block_number of requested transaction
-	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// block_hash of requested transaction
-	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block_time of requested transaction
-	BlockTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	// proposer_address is the proposer of the requested block
-	ProposerAddress []byte `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// msg is the MsgEthereumTx for the requested transaction
+	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// predecessors is an array of transactions included in the same block
+	// need to be replayed first to get correct context for tracing.
+	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
+	// block_number of requested transaction
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash of requested transaction
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of requested transaction
+	BlockTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress []byte `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block of the requested transaction
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (x *QueryTraceTxRequest) Reset() {
+	*x = QueryTraceTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTraceTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTraceTxRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTraceTxRequest.ProtoReflect.Descriptor instead.
+func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+	if x != nil {
+		return x.TraceConfig
+	}
+	return nil
+}
+
+func (x *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+	if x != nil {
+		return x.Predecessors
+	}
+	return nil
+}
+
+func (x *QueryTraceTxRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *QueryTraceTxRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *QueryTraceTxRequest) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *QueryTraceTxRequest) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *QueryTraceTxRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *QueryTraceTxRequest) GetBlockMaxGas() int64 {
+	if x != nil {
+		return x.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryTraceTxResponse defines TraceTx response
+type QueryTraceTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *QueryTraceTxResponse) Reset() {
+	*x = QueryTraceTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTraceTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTraceTxResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTraceTxResponse.ProtoReflect.Descriptor instead.
+func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryTraceTxResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress []byte `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
-	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// block_max_gas of the block of the requested transaction
-	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,5,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// overrides is the json encoded state overrides (balance, nonce, code, state
+	// and state diff per account) applied before tracing the call
+	Overrides []byte `protobuf:"bytes,6,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the json encoded block overrides (number, time, fee
+	// recipient and base fee) applied to the block context of the call
+	BlockOverrides []byte `protobuf:"bytes,7,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
-func (x *QueryTraceTxRequest) Reset() {
-	*x = QueryTraceTxRequest{}
+func (x *QueryTraceCallRequest) Reset() {
+	*x = QueryTraceCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTraceTxRequest) String() string {
+func (x *QueryTraceCallRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTraceTxRequest) ProtoMessage() {}
+func (*QueryTraceCallRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTraceTxRequest.ProtoReflect.Descriptor instead.
-func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use QueryTraceCallRequest.ProtoReflect.Descriptor instead.
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+func (x *QueryTraceCallRequest) GetArgs() []byte {
 	if x != nil {
-		return x.Msg
+		return x.Args
 	}
 	return nil
 }
 
-func (x *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+func (x *QueryTraceCallRequest) GetGasCap() uint64 {
 	if x != nil {
-		return x.TraceConfig
+		return x.GasCap
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+func (x *QueryTraceCallRequest) GetProposerAddress() []byte {
 	if x != nil {
-		return x.Predecessors
+		return x.ProposerAddress
 	}
 	return nil
 }
 
-func (x *QueryTraceTxRequest) GetBlockNumber() int64 {
+func (x *QueryTraceCallRequest) GetChainId() int64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.ChainId
 	}
 	return 0
 }
 
-func (x *QueryTraceTxRequest) GetBlockHash() string {
-	if x != nil {
-		return x.BlockHash
-	}
-	return ""
-}
-
-func (x *QueryTraceTxRequest) GetBlockTime() *timestamppb.Timestamp {
+func (x *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
 	if x != nil {
-		return x.BlockTime
+		return x.TraceConfig
 	}
 	return nil
 }
 
-func (x *QueryTraceTxRequest) GetProposerAddress() []byte {
+func (x *QueryTraceCallRequest) GetOverrides() []byte {
 	if x != nil {
-		return x.ProposerAddress
+		return x.Overrides
 	}
 	return nil
 }
 
-func (x *QueryTraceTxRequest) GetChainId() int64 {
-	if x != nil {
-		return x.ChainId
-	}
-	return 0
-}
-
-func (x *QueryTraceTxRequest) GetBlockMaxGas() int64 {
+func (x *QueryTraceCallRequest) GetBlockOverrides() []byte {
 	if x != nil {
-		return x.BlockMaxGas
+		return x.BlockOverrides
 	}
-	return 0
+	return nil
 }
 
-// QueryTraceTxResponse defines TraceTx response
-type QueryTraceTxResponse struct {
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -14512,27 +19462,27 @@ type QueryTraceTxResponse struct {
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *QueryTraceTxResponse) Reset() {
-	*x = QueryTraceTxResponse{}
+func (x *QueryTraceCallResponse) Reset() {
+	*x = QueryTraceCallResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTraceTxResponse) String() string {
+func (x *QueryTraceCallResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTraceTxResponse) ProtoMessage() {}
+func (*QueryTraceCallResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTraceTxResponse.ProtoReflect.Descriptor instead.
-func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueryTraceCallResponse.ProtoReflect.Descriptor instead.
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryTraceTxResponse) GetData() []byte {
+func (x *QueryTraceCallResponse) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
@@ -14566,7 +19516,7 @@ type QueryTraceBlockRequest struct {
 func (x *QueryTraceBlockRequest) Reset() {
 	*x = QueryTraceBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14580,7 +19530,7 @@ func (*QueryTraceBlockRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
@@ -14652,7 +19602,7 @@ type QueryTraceBlockResponse struct {
 func (x *QueryTraceBlockResponse) Reset() {
 	*x = QueryTraceBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14666,7 +19616,7 @@ func (*QueryTraceBlockResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryTraceBlockResponse) GetData() []byte {
@@ -14676,6 +19626,45 @@ func (x *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryIntermediateRootsResponse defines IntermediateRoots response
+type QueryIntermediateRootsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// roots are the dirty-state digests after each transaction of the block,
+	// chaining the hashes of the EVM StateDB changes of the transactions. They
+	// are not state roots and don't cover the Cosmos state changes.
+	Roots [][]byte `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *QueryIntermediateRootsResponse) Reset() {
+	*x = QueryIntermediateRootsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIntermediateRootsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIntermediateRootsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryIntermediateRootsResponse.ProtoReflect.Descriptor instead.
+func (*QueryIntermediateRootsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *QueryIntermediateRootsResponse) GetRoots() [][]byte {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -14687,7 +19676,7 @@ type QueryBaseFeeRequest struct {
 func (x *QueryBaseFeeRequest) Reset() {
 	*x = QueryBaseFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14701,7 +19690,7 @@ func (*QueryBaseFeeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeRequest.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
 // QueryBaseFeeResponse returns the EIP1559 base fee.
@@ -14717,7 +19706,7 @@ type QueryBaseFeeResponse struct {
 func (x *QueryBaseFeeResponse) Reset() {
 	*x = QueryBaseFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14731,7 +19720,7 @@ func (*QueryBaseFeeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeResponse.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryBaseFeeResponse) GetBaseFee() string {
@@ -14752,7 +19741,7 @@ type QueryGlobalMinGasPriceRequest struct {
 func (x *QueryGlobalMinGasPriceRequest) Reset() {
 	*x = QueryGlobalMinGasPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14766,7 +19755,7 @@ func (*QueryGlobalMinGasPriceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGlobalMinGasPriceRequest.ProtoReflect.Descriptor instead.
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{26}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
 // QueryGlobalMinGasPriceResponse returns the GlobalMinGasPrice
@@ -14782,7 +19771,7 @@ type QueryGlobalMinGasPriceResponse struct {
 func (x *QueryGlobalMinGasPriceResponse) Reset() {
 	*x = QueryGlobalMinGasPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14796,7 +19785,7 @@ func (*QueryGlobalMinGasPriceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGlobalMinGasPriceResponse.ProtoReflect.Descriptor instead.
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{27}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueryGlobalMinGasPriceResponse) GetMinGasPrice() string {
@@ -14881,266 +19870,368 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x03, 0x6b, 0x65, 0x79, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x2c,
 	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x10,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
-	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a,
-	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
-	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0xb7, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12,
-	0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x13, 0x45, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67,
-	0x61, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x89, 0x04, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72,
-	0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0c,
-	0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x22, 0x4f, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x7f, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x22, 0x36, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
+	0x89, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x52, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67,
+	0x61, 0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbf, 0x01, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61,
+	0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32,
+	0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x2d,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x89, 0x04,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
 	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x54, 0x78, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a,
-	0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10,
-	0x03, 0x52, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x03, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78,
-	0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
-	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa,
-	0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61,
-	0x73, 0x22, 0x2d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61,
-	0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b,
-	0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x8a, 0x0f, 0x0a, 0x05,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9e, 0x01,
-	0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xaf,
-	0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x12, 0x86, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
-	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x07, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63,
+	0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x72,
+	0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54,
+	0x78, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52,
+	0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x7a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x12, 0x21, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x7d, 0x12, 0x77, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22,
+	0x2c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x03,
+	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x36, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x15,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0xd9, 0x13, 0x0a, 0x05, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0d,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x78, 0x0a, 0x07,
-	0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74,
-	0x68, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x7e, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
-	0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x5f, 0x74, 0x78, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x7c, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
+	0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xaf, 0x01, 0x0a,
+	0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f,
+	0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x86,
+	0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
-	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x77, 0x0a,
-	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f,
+	0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x7d, 0x12, 0x7a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x77,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x78, 0x0a, 0x07, 0x45, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x12, 0x7e, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61,
+	0x73, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31,
+	0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x31, 0x12, 0x7c, 0x0a, 0x07,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x47, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x63,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x78, 0x12, 0x84, 0x01, 0x0a, 0x09, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x9d, 0x01, 0x0a,
+	0x11, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
+	0x74, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61,
-	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47,
-	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x67,
-	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0xad, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07,
+	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d,
-	0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d,
-	0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x77, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69,
+	0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0xad, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
+	0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56,
+	0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -15155,7 +20246,7 @@ func file_cosmos_evm_vm_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_vm_v1_query_proto_rawDescData
 }
 
-var file_cosmos_evm_vm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_cosmos_evm_vm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_cosmos_evm_vm_v1_query_proto_goTypes = []interface{}{
 	(*QueryConfigRequest)(nil),             // 0: cosmos.evm.vm.v1.QueryConfigRequest
 	(*QueryConfigResponse)(nil),            // 1: cosmos.evm.vm.v1.QueryConfigResponse
@@ -15169,78 +20260,96 @@ var file_cosmos_evm_vm_v1_query_proto_goTypes = []interface{}{
 	(*QueryBalanceResponse)(nil),           // 9: cosmos.evm.vm.v1.QueryBalanceResponse
 	(*QueryStorageRequest)(nil),            // 10: cosmos.evm.vm.v1.QueryStorageRequest
 	(*QueryStorageResponse)(nil),           // 11: cosmos.evm.vm.v1.QueryStorageResponse
-	(*QueryCodeRequest)(nil),               // 12: cosmos.evm.vm.v1.QueryCodeRequest
-	(*QueryCodeResponse)(nil),              // 13: cosmos.evm.vm.v1.QueryCodeResponse
-	(*QueryTxLogsRequest)(nil),             // 14: cosmos.evm.vm.v1.QueryTxLogsRequest
-	(*QueryTxLogsResponse)(nil),            // 15: cosmos.evm.vm.v1.QueryTxLogsResponse
-	(*QueryParamsRequest)(nil),             // 16: cosmos.evm.vm.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),            // 17: cosmos.evm.vm.v1.QueryParamsResponse
-	(*EthCallRequest)(nil),                 // 18: cosmos.evm.vm.v1.EthCallRequest
-	(*EstimateGasResponse)(nil),            // 19: cosmos.evm.vm.v1.EstimateGasResponse
-	(*QueryTraceTxRequest)(nil),            // 20: cosmos.evm.vm.v1.QueryTraceTxRequest
-	(*QueryTraceTxResponse)(nil),           // 21: cosmos.evm.vm.v1.QueryTraceTxResponse
-	(*QueryTraceBlockRequest)(nil),         // 22: cosmos.evm.vm.v1.QueryTraceBlockRequest
-	(*QueryTraceBlockResponse)(nil),        // 23: cosmos.evm.vm.v1.QueryTraceBlockResponse
-	(*QueryBaseFeeRequest)(nil),            // 24: cosmos.evm.vm.v1.QueryBaseFeeRequest
-	(*QueryBaseFeeResponse)(nil),           // 25: cosmos.evm.vm.v1.QueryBaseFeeResponse
-	(*QueryGlobalMinGasPriceRequest)(nil),  // 26: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
-	(*QueryGlobalMinGasPriceResponse)(nil), // 27: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
-	(*ChainConfig)(nil),                    // 28: cosmos.evm.vm.v1.ChainConfig
-	(*v1beta1.PageRequest)(nil),            // 29: cosmos.base.query.v1beta1.PageRequest
-	(*Log)(nil),                            // 30: cosmos.evm.vm.v1.Log
-	(*v1beta1.PageResponse)(nil),           // 31: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                         // 32: cosmos.evm.vm.v1.Params
-	(*MsgEthereumTx)(nil),                  // 33: cosmos.evm.vm.v1.MsgEthereumTx
-	(*TraceConfig)(nil),                    // 34: cosmos.evm.vm.v1.TraceConfig
-	(*timestamppb.Timestamp)(nil),          // 35: google.protobuf.Timestamp
-	(*MsgEthereumTxResponse)(nil),          // 36: cosmos.evm.vm.v1.MsgEthereumTxResponse
+	(*QueryStorageProofRequest)(nil),       // 12: cosmos.evm.vm.v1.QueryStorageProofRequest
+	(*StorageKeyProof)(nil),                // 13: cosmos.evm.vm.v1.StorageKeyProof
+	(*QueryStorageProofResponse)(nil),      // 14: cosmos.evm.vm.v1.QueryStorageProofResponse
+	(*QueryCodeRequest)(nil),               // 15: cosmos.evm.vm.v1.QueryCodeRequest
+	(*QueryCodeResponse)(nil),              // 16: cosmos.evm.vm.v1.QueryCodeResponse
+	(*QueryTxLogsRequest)(nil),             // 17: cosmos.evm.vm.v1.QueryTxLogsRequest
+	(*QueryTxLogsResponse)(nil),            // 18: cosmos.evm.vm.v1.QueryTxLogsResponse
+	(*QueryParamsRequest)(nil),             // 19: cosmos.evm.vm.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 20: cosmos.evm.vm.v1.QueryParamsResponse
+	(*EthCallRequest)(nil),                 // 21: cosmos.evm.vm.v1.EthCallRequest
+	(*EstimateGasResponse)(nil),            // 22: cosmos.evm.vm.v1.EstimateGasResponse
+	(*QuerySimulateV1Request)(nil),         // 23: cosmos.evm.vm.v1.QuerySimulateV1Request
+	(*QuerySimulateV1Response)(nil),        // 24: cosmos.evm.vm.v1.QuerySimulateV1Response
+	(*QueryTraceTxRequest)(nil),            // 25: cosmos.evm.vm.v1.QueryTraceTxRequest
+	(*QueryTraceTxResponse)(nil),           // 26: cosmos.evm.vm.v1.QueryTraceTxResponse
+	(*QueryTraceCallRequest)(nil),          // 27: cosmos.evm.vm.v1.QueryTraceCallRequest
+	(*QueryTraceCallResponse)(nil),         // 28: cosmos.evm.vm.v1.QueryTraceCallResponse
+	(*QueryTraceBlockRequest)(nil),         // 29: cosmos.evm.vm.v1.QueryTraceBlockRequest
+	(*QueryTraceBlockResponse)(nil),        // 30: cosmos.evm.vm.v1.QueryTraceBlockResponse
+	(*QueryIntermediateRootsResponse)(nil), // 31: cosmos.evm.vm.v1.QueryIntermediateRootsResponse
+	(*QueryBaseFeeRequest)(nil),            // 32: cosmos.evm.vm.v1.QueryBaseFeeRequest
+	(*QueryBaseFeeResponse)(nil),           // 33: cosmos.evm.vm.v1.QueryBaseFeeResponse
+	(*QueryGlobalMinGasPriceRequest)(nil),  // 34: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
+	(*QueryGlobalMinGasPriceResponse)(nil), // 35: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
+	(*ChainConfig)(nil),                    // 36: cosmos.evm.vm.v1.ChainConfig
+	(*v1beta1.PageRequest)(nil),            // 37: cosmos.base.query.v1beta1.PageRequest
+	(*Log)(nil),                            // 38: cosmos.evm.vm.v1.Log
+	(*v1beta1.PageResponse)(nil),           // 39: cosmos.base.query.v1beta1.PageResponse
+	(*Params)(nil),                         // 40: cosmos.evm.vm.v1.Params
+	(*MsgEthereumTx)(nil),                  // 41: cosmos.evm.vm.v1.MsgEthereumTx
+	(*TraceConfig)(nil),                    // 42: cosmos.evm.vm.v1.TraceConfig
+	(*timestamppb.Timestamp)(nil),          // 43: google.protobuf.Timestamp
+	(*MsgEthereumTxResponse)(nil),          // 44: cosmos.evm.vm.v1.MsgEthereumTxResponse
 }
 var file_cosmos_evm_vm_v1_query_proto_depIdxs = []int32{
-	28, // 0: cosmos.evm.vm.v1.QueryConfigResponse.config:type_name -> cosmos.evm.vm.v1.ChainConfig
-	29, // 1: cosmos.evm.vm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	30, // 2: cosmos.evm.vm.v1.QueryTxLogsResponse.logs:type_name -> cosmos.evm.vm.v1.Log
-	31, // 3: cosmos.evm.vm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	32, // 4: cosmos.evm.vm.v1.QueryParamsResponse.params:type_name -> cosmos.evm.vm.v1.Params
-	33, // 5: cosmos.evm.vm.v1.QueryTraceTxRequest.msg:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	34, // 6: cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	33, // 7: cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	35, // 8: cosmos.evm.vm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
-	33, // 9: cosmos.evm.vm.v1.QueryTraceBlockRequest.txs:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	34, // 10: cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	35, // 11: cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
-	2,  // 12: cosmos.evm.vm.v1.Query.Account:input_type -> cosmos.evm.vm.v1.QueryAccountRequest
-	4,  // 13: cosmos.evm.vm.v1.Query.CosmosAccount:input_type -> cosmos.evm.vm.v1.QueryCosmosAccountRequest
-	6,  // 14: cosmos.evm.vm.v1.Query.ValidatorAccount:input_type -> cosmos.evm.vm.v1.QueryValidatorAccountRequest
-	8,  // 15: cosmos.evm.vm.v1.Query.Balance:input_type -> cosmos.evm.vm.v1.QueryBalanceRequest
-	10, // 16: cosmos.evm.vm.v1.Query.Storage:input_type -> cosmos.evm.vm.v1.QueryStorageRequest
-	12, // 17: cosmos.evm.vm.v1.Query.Code:input_type -> cosmos.evm.vm.v1.QueryCodeRequest
-	16, // 18: cosmos.evm.vm.v1.Query.Params:input_type -> cosmos.evm.vm.v1.QueryParamsRequest
-	18, // 19: cosmos.evm.vm.v1.Query.EthCall:input_type -> cosmos.evm.vm.v1.EthCallRequest
-	18, // 20: cosmos.evm.vm.v1.Query.EstimateGas:input_type -> cosmos.evm.vm.v1.EthCallRequest
-	20, // 21: cosmos.evm.vm.v1.Query.TraceTx:input_type -> cosmos.evm.vm.v1.QueryTraceTxRequest
-	22, // 22: cosmos.evm.vm.v1.Query.TraceBlock:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
-	24, // 23: cosmos.evm.vm.v1.Query.BaseFee:input_type -> cosmos.evm.vm.v1.QueryBaseFeeRequest
-	0,  // 24: cosmos.evm.vm.v1.Query.Config:input_type -> cosmos.evm.vm.v1.QueryConfigRequest
-	26, // 25: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:input_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
-	3,  // 26: cosmos.evm.vm.v1.Query.Account:output_type -> cosmos.evm.vm.v1.QueryAccountResponse
-	5,  // 27: cosmos.evm.vm.v1.Query.CosmosAccount:output_type -> cosmos.evm.vm.v1.QueryCosmosAccountResponse
-	7,  // 28: cosmos.evm.vm.v1.Query.ValidatorAccount:output_type -> cosmos.evm.vm.v1.QueryValidatorAccountResponse
-	9,  // 29: cosmos.evm.vm.v1.Query.Balance:output_type -> cosmos.evm.vm.v1.QueryBalanceResponse
-	11, // 30: cosmos.evm.vm.v1.Query.Storage:output_type -> cosmos.evm.vm.v1.QueryStorageResponse
-	13, // 31: cosmos.evm.vm.v1.Query.Code:output_type -> cosmos.evm.vm.v1.QueryCodeResponse
-	17, // 32: cosmos.evm.vm.v1.Query.Params:output_type -> cosmos.evm.vm.v1.QueryParamsResponse
-	36, // 33: cosmos.evm.vm.v1.Query.EthCall:output_type -> cosmos.evm.vm.v1.MsgEthereumTxResponse
-	19, // 34: cosmos.evm.vm.v1.Query.EstimateGas:output_type -> cosmos.evm.vm.v1.EstimateGasResponse
-	21, // 35: cosmos.evm.vm.v1.Query.TraceTx:output_type -> cosmos.evm.vm.v1.QueryTraceTxResponse
-	23, // 36: cosmos.evm.vm.v1.Query.TraceBlock:output_type -> cosmos.evm.vm.v1.QueryTraceBlockResponse
-	25, // 37: cosmos.evm.vm.v1.Query.BaseFee:output_type -> cosmos.evm.vm.v1.QueryBaseFeeResponse
-	1,  // 38: cosmos.evm.vm.v1.Query.Config:output_type -> cosmos.evm.vm.v1.QueryConfigResponse
-	27, // 39: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:output_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
-	26, // [26:40] is the sub-list for method output_type
-	12, // [12:26] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	36, // 0: cosmos.evm.vm.v1.QueryConfigResponse.config:type_name -> cosmos.evm.vm.v1.ChainConfig
+	13, // 1: cosmos.evm.vm.v1.QueryStorageProofResponse.proofs:type_name -> cosmos.evm.vm.v1.StorageKeyProof
+	37, // 2: cosmos.evm.vm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	38, // 3: cosmos.evm.vm.v1.QueryTxLogsResponse.logs:type_name -> cosmos.evm.vm.v1.Log
+	39, // 4: cosmos.evm.vm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	40, // 5: cosmos.evm.vm.v1.QueryParamsResponse.params:type_name -> cosmos.evm.vm.v1.Params
+	41, // 6: cosmos.evm.vm.v1.QueryTraceTxRequest.msg:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	42, // 7: cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	41, // 8: cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	43, // 9: cosmos.evm.vm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
+	42, // 10: cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	41, // 11: cosmos.evm.vm.v1.QueryTraceBlockRequest.txs:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	42, // 12: cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	43, // 13: cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
+	2,  // 14: cosmos.evm.vm.v1.Query.Account:input_type -> cosmos.evm.vm.v1.QueryAccountRequest
+	4,  // 15: cosmos.evm.vm.v1.Query.CosmosAccount:input_type -> cosmos.evm.vm.v1.QueryCosmosAccountRequest
+	6,  // 16: cosmos.evm.vm.v1.Query.ValidatorAccount:input_type -> cosmos.evm.vm.v1.QueryValidatorAccountRequest
+	8,  // 17: cosmos.evm.vm.v1.Query.Balance:input_type -> cosmos.evm.vm.v1.QueryBalanceRequest
+	10, // 18: cosmos.evm.vm.v1.Query.Storage:input_type -> cosmos.evm.vm.v1.QueryStorageRequest
+	12, // 19: cosmos.evm.vm.v1.Query.StorageProof:input_type -> cosmos.evm.vm.v1.QueryStorageProofRequest
+	15, // 20: cosmos.evm.vm.v1.Query.Code:input_type -> cosmos.evm.vm.v1.QueryCodeRequest
+	19, // 21: cosmos.evm.vm.v1.Query.Params:input_type -> cosmos.evm.vm.v1.QueryParamsRequest
+	21, // 22: cosmos.evm.vm.v1.Query.EthCall:input_type -> cosmos.evm.vm.v1.EthCallRequest
+	21, // 23: cosmos.evm.vm.v1.Query.EstimateGas:input_type -> cosmos.evm.vm.v1.EthCallRequest
+	23, // 24: cosmos.evm.vm.v1.Query.SimulateV1:input_type -> cosmos.evm.vm.v1.QuerySimulateV1Request
+	25, // 25: cosmos.evm.vm.v1.Query.TraceTx:input_type -> cosmos.evm.vm.v1.QueryTraceTxRequest
+	27, // 26: cosmos.evm.vm.v1.Query.TraceCall:input_type -> cosmos.evm.vm.v1.QueryTraceCallRequest
+	29, // 27: cosmos.evm.vm.v1.Query.TraceBlock:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
+	29, // 28: cosmos.evm.vm.v1.Query.IntermediateRoots:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
+	32, // 29: cosmos.evm.vm.v1.Query.BaseFee:input_type -> cosmos.evm.vm.v1.QueryBaseFeeRequest
+	0,  // 30: cosmos.evm.vm.v1.Query.Config:input_type -> cosmos.evm.vm.v1.QueryConfigRequest
+	34, // 31: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:input_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
+	3,  // 32: cosmos.evm.vm.v1.Query.Account:output_type -> cosmos.evm.vm.v1.QueryAccountResponse
+	5,  // 33: cosmos.evm.vm.v1.Query.CosmosAccount:output_type -> cosmos.evm.vm.v1.QueryCosmosAccountResponse
+	7,  // 34: cosmos.evm.vm.v1.Query.ValidatorAccount:output_type -> cosmos.evm.vm.v1.QueryValidatorAccountResponse
+	9,  // 35: cosmos.evm.vm.v1.Query.Balance:output_type -> cosmos.evm.vm.v1.QueryBalanceResponse
+	11, // 36: cosmos.evm.vm.v1.Query.Storage:output_type -> cosmos.evm.vm.v1.QueryStorageResponse
+	14, // 37: cosmos.evm.vm.v1.Query.StorageProof:output_type -> cosmos.evm.vm.v1.QueryStorageProofResponse
+	16, // 38: cosmos.evm.vm.v1.Query.Code:output_type -> cosmos.evm.vm.v1.QueryCodeResponse
+	20, // 39: cosmos.evm.vm.v1.Query.Params:output_type -> cosmos.evm.vm.v1.QueryParamsResponse
+	44, // 40: cosmos.evm.vm.v1.Query.EthCall:output_type -> cosmos.evm.vm.v1.MsgEthereumTxResponse
+	22, // 41: cosmos.evm.vm.v1.Query.EstimateGas:output_type -> cosmos.evm.vm.v1.EstimateGasResponse
+	24, // 42: cosmos.evm.vm.v1.Query.SimulateV1:output_type -> cosmos.evm.vm.v1.QuerySimulateV1Response
+	26, // 43: cosmos.evm.vm.v1.Query.TraceTx:output_type -> cosmos.evm.vm.v1.QueryTraceTxResponse
+	28, // 44: cosmos.evm.vm.v1.Query.TraceCall:output_type -> cosmos.evm.vm.v1.QueryTraceCallResponse
+	30, // 45: cosmos.evm.vm.v1.Query.TraceBlock:output_type -> cosmos.evm.vm.v1.QueryTraceBlockResponse
+	31, // 46: cosmos.evm.vm.v1.Query.IntermediateRoots:output_type -> cosmos.evm.vm.v1.QueryIntermediateRootsResponse
+	33, // 47: cosmos.evm.vm.v1.Query.BaseFee:output_type -> cosmos.evm.vm.v1.QueryBaseFeeResponse
+	1,  // 48: cosmos.evm.vm.v1.Query.Config:output_type -> cosmos.evm.vm.v1.QueryConfigResponse
+	35, // 49: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:output_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
+	32, // [32:50] is the sub-list for method output_type
+	14, // [14:32] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_query_proto_init() }
@@ -15396,7 +20505,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCodeRequest); i {
+			switch v := v.(*QueryStorageProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15408,7 +20517,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCodeResponse); i {
+			switch v := v.(*StorageKeyProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15420,7 +20529,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsRequest); i {
+			switch v := v.(*QueryStorageProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15432,7 +20541,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsResponse); i {
+			switch v := v.(*QueryCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15444,7 +20553,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15456,7 +20565,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryTxLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15468,7 +20577,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthCallRequest); i {
+			switch v := v.(*QueryTxLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15480,7 +20589,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasResponse); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15492,7 +20601,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxRequest); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15504,7 +20613,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxResponse); i {
+			switch v := v.(*EthCallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15516,7 +20625,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockRequest); i {
+			switch v := v.(*EstimateGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15528,7 +20637,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockResponse); i {
+			switch v := v.(*QuerySimulateV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15540,7 +20649,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeRequest); i {
+			switch v := v.(*QuerySimulateV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15552,7 +20661,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeResponse); i {
+			switch v := v.(*QueryTraceTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15564,7 +20673,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGlobalMinGasPriceRequest); i {
+			switch v := v.(*QueryTraceTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15576,6 +20685,102 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceCallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryIntermediateRootsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGlobalMinGasPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGlobalMinGasPriceResponse); i {
 			case 0:
 				return &v.state
@@ -15594,7 +20799,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,12 +24,16 @@ const (
 	Query_ValidatorAccount_FullMethodName  = "/cosmos.evm.vm.v1.Query/ValidatorAccount"
 	Query_Balance_FullMethodName           = "/cosmos.evm.vm.v1.Query/Balance"
 	Query_Storage_FullMethodName           = "/cosmos.evm.vm.v1.Query/Storage"
+	Query_StorageProof_FullMethodName      = "/cosmos.evm.vm.v1.Query/StorageProof"
 	Query_Code_FullMethodName              = "/cosmos.evm.vm.v1.Query/Code"
 	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
 	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
 	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
+	Query_SimulateV1_FullMethodName        = "/cosmos.evm.vm.v1.Query/SimulateV1"
 	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
+	Query_TraceCall_FullMethodName         = "/cosmos.evm.vm.v1.Query/TraceCall"
 	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
+	Query_IntermediateRoots_FullMethodName = "/cosmos.evm.vm.v1.Query/IntermediateRoots"
 	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
 	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
 	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
@@ -51,6 +55,13 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageProof queries the Merkle-Patricia storage root of an account and
+	// the proofs of the given storage keys against it. The storage root is
+	// built on demand and is NOT committed to by the app hash, so the proofs
+	// are not verifiable against the chain state. It is only served by the
+	// nodes enabling the storage proofs, for the accounts with up to the
+	// configured max number of storage slots.
+	StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
@@ -59,11 +70,17 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// IntermediateRoots implements the `debug_intermediateRoots` rpc api
+	IntermediateRoots(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryIntermediateRootsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -130,6 +147,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error) {
+	out := new(QueryStorageProofResponse)
+	err := c.cc.Invoke(ctx, Query_StorageProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, Query_Code_FullMethodName, in, out, opts...)
@@ -166,6 +192,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error) {
+	out := new(QuerySimulateV1Response)
+	err := c.cc.Invoke(ctx, Query_SimulateV1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, Query_TraceTx_FullMethodName, in, out, opts...)
@@ -175,6 +210,15 @@ func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, Query_TraceCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error) {
 	out := new(QueryTraceBlockResponse)
 	err := c.cc.Invoke(ctx, Query_TraceBlock_FullMethodName, in, out, opts...)
@@ -184,6 +228,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) IntermediateRoots(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryIntermediateRootsResponse, error) {
+	out := new(QueryIntermediateRootsResponse)
+	err := c.cc.Invoke(ctx, Query_IntermediateRoots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, opts...)
@@ -227,6 +280,13 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageProof queries the Merkle-Patricia storage root of an account and
+	// the proofs of the given storage keys against it. The storage root is
+	// built on demand and is NOT committed to by the app hash, so the proofs
+	// are not verifiable against the chain state. It is only served by the
+	// nodes enabling the storage proofs, for the accounts with up to the
+	// configured max number of storage slots.
+	StorageProof(context.Context, *QueryStorageProofRequest) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
@@ -235,11 +295,17 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *QuerySimulateV1Request) (*QuerySimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// IntermediateRoots implements the `debug_intermediateRoots` rpc api
+	IntermediateRoots(context.Context, *QueryTraceBlockRequest) (*QueryIntermediateRootsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -273,6 +339,9 @@ func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (
 func (UnimplementedQueryServer) Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (UnimplementedQueryServer) StorageProof(context.Context, *QueryStorageProofRequest) (*QueryStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProof not implemented")
+}
 func (UnimplementedQueryServer) Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -285,12 +354,21 @@ func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgE
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedQueryServer) SimulateV1(context.Context, *QuerySimulateV1Request) (*QuerySimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (UnimplementedQueryServer) TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
+func (UnimplementedQueryServer) TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (UnimplementedQueryServer) TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (UnimplementedQueryServer) IntermediateRoots(context.Context, *QueryTraceBlockRequest) (*QueryIntermediateRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediateRoots not implemented")
+}
 func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -403,6 +481,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProof(ctx, req.(*QueryStorageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -475,6 +571,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateV1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*QuerySimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -493,6 +607,24 @@ func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TraceCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceBlockRequest)
 	if err := dec(in); err != nil {
@@ -511,6 +643,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IntermediateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediateRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IntermediateRoots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediateRoots(ctx, req.(*QueryTraceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -592,6 +742,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "StorageProof",
+			Handler:    _Query_StorageProof_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -608,14 +762,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "IntermediateRoots",
+			Handler:    _Query_IntermediateRoots_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -0,0 +1,102 @@
+package vmv1
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	ethutils "github.com/cosmos/evm/utils/eth"
+)
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	return stringToBigInt(tx.GetChainId())
+}
+
+// AsEthereumData returns an SetCodeTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.SetCodeTx{
+		ChainID:    bigToUint256(tx.GetChainID()),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  bigToUint256(stringToBigInt(tx.GetGasTipCap())),
+		GasFeeCap:  bigToUint256(stringToBigInt(tx.GetGasFeeCap())),
+		Gas:        tx.GetGas(),
+		To:         common.HexToAddress(tx.GetTo()),
+		Value:      bigToUint256(stringToBigInt(tx.GetValue())),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		AuthList:   tx.GetAuthorizationList(),
+		V:          bigToUint256(v),
+		R:          bigToUint256(r),
+		S:          bigToUint256(s),
+	}
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	var ethAccessList ethtypes.AccessList
+
+	for _, tuple := range tx.Accesses {
+		storageKeys := make([]common.Hash, len(tuple.StorageKeys))
+
+		for i := range tuple.StorageKeys {
+			storageKeys[i] = common.HexToHash(tuple.StorageKeys[i])
+		}
+
+		ethAccessList = append(ethAccessList, ethtypes.AccessTuple{
+			Address:     common.HexToAddress(tuple.Address),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return ethAccessList
+}
+
+// GetAuthorizationList returns the Authorizations field.
+func (tx *SetCodeTx) GetAuthorizationList() []ethtypes.SetCodeAuthorization {
+	if tx.Authorizations == nil {
+		return nil
+	}
+	authList := make([]ethtypes.SetCodeAuthorization, 0, len(tx.Authorizations))
+
+	for _, auth := range tx.Authorizations {
+		ethAuth := ethtypes.SetCodeAuthorization{
+			Address: common.HexToAddress(auth.GetAddress()),
+			Nonce:   auth.GetNonce(),
+		}
+		if chainID := bigToUint256(stringToBigInt(auth.GetChainId())); chainID != nil {
+			ethAuth.ChainID = *chainID
+		}
+		if v := auth.GetV(); len(v) > 0 {
+			ethAuth.V = v[len(v)-1]
+		}
+		ethAuth.R.SetBytes(auth.GetR())
+		ethAuth.S.SetBytes(auth.GetS())
+
+		authList = append(authList, ethAuth)
+	}
+
+	return authList
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// helper function to convert a bigInt to uint256, returning nil on nil input
+func bigToUint256(i *big.Int) *uint256.Int {
+	if i == nil {
+		return nil
+	}
+	res, _ := uint256.FromBig(i)
+	return res
+}
@@ -2,12 +2,15 @@ package evmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 
 	"github.com/spf13/cast"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
@@ -20,6 +23,8 @@ import (
 	evmconfig "github.com/cosmos/evm/config"
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/evmd/ante"
+	evmmempool "github.com/cosmos/evm/mempool"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
@@ -40,8 +45,14 @@ import (
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	_ "github.com/cosmos/evm/x/vm/tracers"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -86,6 +97,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -180,9 +192,10 @@ type EVMD struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
@@ -190,6 +203,9 @@ type EVMD struct {
 	Erc20Keeper       erc20keeper.Keeper
 	PreciseBankKeeper precisebankkeeper.Keeper
 
+	// EVM-aware application mempool, nil if the mempool is disabled
+	EVMMempool *evmmempool.EVMMempool
+
 	// the module manager
 	ModuleManager      *module.Manager
 	BasicModuleManager module.BasicManager
@@ -219,31 +235,9 @@ func NewExampleApp(
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 	txConfig := encodingConfig.TxConfig
 
-	// Below we could construct and set an application specific mempool and
-	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
-	// already set in the SDK's BaseApp, this shows an example of how to override
-	// them.
-	//
-	// Example:
-	//
-	// bApp := baseapp.NewBaseApp(...)
-	// nonceMempool := mempool.NewSenderNonceMempool()
-	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, bApp)
-	//
-	// bApp.SetMempool(nonceMempool)
-	// bApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// bApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
-	//
-	// Alternatively, you can construct BaseApp options, append those to
-	// baseAppOptions and pass them to NewBaseApp.
-	//
-	// Example:
-	//
-	// prepareOpt = func(app *baseapp.BaseApp) {
-	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app)
-	// 	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// }
-	// baseAppOptions = append(baseAppOptions, prepareOpt)
+	// NOTE: the application mempool, along with the ABCI 1.0 PrepareProposal
+	// and ProcessProposal handlers, is set from the `mempool.max-txs` option
+	// once the EVM keeper is constructed. See setMempool.
 
 	bApp := baseapp.NewBaseApp(
 		appName,
@@ -269,7 +263,7 @@ func NewExampleApp(
 		govtypes.StoreKey, paramstypes.StoreKey, consensusparamtypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
 	)
@@ -382,7 +376,10 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	// NOTE: the bank keeper is set as in the feegrant depinject provider, so that
+	// the keeper used by the feegrant precompile can create the grantee accounts
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -483,6 +480,9 @@ func NewExampleApp(
 		&app.Erc20Keeper,
 		tracer,
 	)
+	if cast.ToBool(appOpts.Get(srvflags.JSONRPCEnableStorageProofs)) {
+		app.EVMKeeper.WithStorageProofs(cast.ToInt(appOpts.Get(srvflags.JSONRPCStorageProofMaxSlots)))
+	}
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -509,6 +509,17 @@ func NewExampleApp(
 		authAddr,
 	)
 
+	// ICA Controller keeper
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
 	/*
 		Create Transfer Stack
 
@@ -537,13 +548,33 @@ func NewExampleApp(
 	)
 	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
 
+	/*
+		Create Interchain Accounts Stack
+
+		controller stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ICA Controller
+
+		SendPacket, since it is originating from the application to core IBC:
+			icaControllerKeeper.SendTx -> callbacks.SendPacket -> channel.SendPacket
+
+		Acknowledgements and timeouts are delivered to the contracts set in the
+		"src_callback" of the packet memo by the callbacks middleware.
+	*/
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	// the callbacks middleware wraps the channel keeper to send the controller packets
+	app.ICAControllerKeeper.WithICS4Wrapper(icaControllerStack.(porttypes.ICS4Wrapper))
+
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer and interchain accounts routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -558,19 +589,32 @@ func NewExampleApp(
 	// Override the ICS20 app module
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
+	// NOTE: with 18 decimals, the EVM denom is also the PreciseBank extended
+	// denom, and PreciseBank emits bank events for it on top of the x/bank ones,
+	// which the precompiles would sync twice into the EVM balances. The EVM
+	// coin is then sent straight through x/bank.
+	var precompileBankKeeper cmn.BankKeeper = app.PreciseBankKeeper
+	if evmtypes.GetEVMCoinExtendedDenom() == evmtypes.GetEVMCoinDenom() {
+		precompileBankKeeper = app.BankKeeper
+	}
+
 	// NOTE: we are adding all available Cosmos EVM EVM extensions.
 	// Not all of them need to be enabled, which can be configured on a per-chain basis.
 	app.EVMKeeper.WithStaticPrecompiles(
 		NewAvailableStaticPrecompiles(
 			*app.StakingKeeper,
 			app.DistrKeeper,
-			app.PreciseBankKeeper,
+			precompileBankKeeper,
 			app.Erc20Keeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.IBCKeeper.ChannelKeeperV2,
+			&app.ICAControllerKeeper,
 			app.EVMKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
 			app.AppCodec(),
 		),
 	)
@@ -601,6 +645,7 @@ func NewExampleApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, nil),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
@@ -644,7 +689,7 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
 		erc20types.ModuleName, feemarkettypes.ModuleName,
@@ -670,7 +715,7 @@ func NewExampleApp(
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
@@ -697,7 +742,7 @@ func NewExampleApp(
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	}
@@ -751,6 +796,7 @@ func NewExampleApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	app.setAnteHandler(app.txConfig, maxGasWanted)
+	app.setMempool(appOpts)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -817,6 +863,54 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setMempool sets the application mempool along with the proposal handlers
+// that build blocks from it. Unless it is disabled by a negative
+// `mempool.max-txs`, the EVM-aware mempool is used, which queues EVM txs with a
+// nonce gap and replaces pending ones on a fee bump, with the CheckTx handler
+// that feeds it.
+func (app *EVMD) setMempool(appOpts servertypes.AppOptions) {
+	maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+	if maxTxs < 0 {
+		mp := sdkmempool.NoOpMempool{}
+		abciPropHandler := baseapp.NewDefaultProposalHandler(mp, app.BaseApp)
+		app.SetMempool(mp)
+		app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
+		app.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
+		return
+	}
+
+	config := evmmempool.DefaultConfig()
+	config.MaxTxs = maxTxs
+	config.MaxCosmosTxs = maxTxs
+	mp := evmmempool.NewEVMMempool(app.EVMKeeper, config)
+	app.EVMMempool = mp
+
+	abciPropHandler := baseapp.NewDefaultProposalHandler(mp, app.BaseApp)
+	app.SetMempool(mp)
+	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
+	app.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
+	app.SetCheckTxHandler(evmmempool.NewCheckTxHandler(mp, app.txConfig.TxDecoder(), app.GetContextForCheckTx, cast.ToBool(appOpts.Get(server.FlagTrace))))
+	// remove the executed EVM txs from the mempool after each commit, with the
+	// new check state
+	app.SetPrepareCheckStater(mp.RemoveStaleTxs)
+}
+
+// TxPoolContent returns the pending and queued EVM txs of the app mempool,
+// split against the committed account nonces. It implements the TxPool read
+// by the txpool JSON-RPC namespace.
+func (app *EVMD) TxPoolContent() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error) {
+	if app.EVMMempool == nil {
+		return nil, nil, errors.New("the EVM mempool is disabled")
+	}
+
+	ctx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	pending, queued = app.EVMMempool.Content(ctx)
+	return pending, queued, nil
+}
+
 func (app *EVMD) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -1133,6 +1227,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	// TODO: do we need a keytable? copied from Evmos repo
 
 	return paramsKeeper
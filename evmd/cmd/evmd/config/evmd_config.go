@@ -6,12 +6,14 @@ import (
 
 	corevm "github.com/ethereum/go-ethereum/core/vm"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	cosmosevmutils "github.com/cosmos/evm/utils"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
@@ -37,6 +39,7 @@ var maccPerms = map[string][]string{
 	authtypes.FeeCollectorName:     nil,
 	distrtypes.ModuleName:          nil,
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+	icatypes.ModuleName:            nil,
 	minttypes.ModuleName:           {authtypes.Minter},
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
@@ -115,6 +118,9 @@ func InitAppConfig(denom string, evmChainID uint64) (string, interface{}) {
 	//
 	// In this example application, we set the min gas prices to 0.
 	srvCfg.MinGasPrices = "0" + denom
+	// The SDK disables the app mempool by default, while the EVM mempool is
+	// required to queue and replace EVM transactions.
+	srvCfg.Mempool.MaxTxs = evmmempool.DefaultConfig().MaxTxs
 
 	evmCfg := cosmosevmserverconfig.DefaultEVMConfig()
 	evmCfg.EVMChainID = evmChainID
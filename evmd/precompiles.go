@@ -9,11 +9,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	evmgovprecompile "github.com/cosmos/evm/precompiles/evmgov"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
+	icaprecompile "github.com/cosmos/evm/precompiles/ica"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
@@ -21,10 +25,14 @@ import (
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
+	channelkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -35,9 +43,10 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
-	ValidatorAddrCodec address.Codec // used by slashing
-	ConsensusAddrCodec address.Codec // used by slashing
+	AddressCodec        address.Codec  // used by gov/staking/authz/feegrant
+	ValidatorAddrCodec  address.Codec  // used by slashing
+	ConsensusAddrCodec  address.Codec  // used by slashing
+	GovernanceAuthority sdk.AccAddress // used by evmgov
 }
 
 func defaultOptionals() Optionals {
@@ -68,6 +77,15 @@ func WithConsensusAddrCodec(codec address.Codec) Option {
 	}
 }
 
+// WithGovernanceAuthority sets an address, e.g. a DAO contract, allowed to update the
+// x/vm and x/erc20 params and token pairs through the EVM governance precompile, in
+// addition to the authorities of the modules.
+func WithGovernanceAuthority(authority sdk.AccAddress) Option {
+	return func(opts *Optionals) {
+		opts.GovernanceAuthority = authority
+	}
+}
+
 const bech32PrecompileBaseGas = 6_000
 
 // NewAvailableStaticPrecompiles returns the list of all available static precompiled contracts from Cosmos EVM.
@@ -80,9 +98,13 @@ func NewAvailableStaticPrecompiles(
 	erc20Keeper erc20Keeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
+	channelKeeperV2 *channelkeeperv2.Keeper,
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -121,6 +143,7 @@ func NewAvailableStaticPrecompiles(
 		stakingKeeper,
 		transferKeeper,
 		channelKeeper,
+		channelKeeperV2,
 		evmKeeper,
 	)
 	if err != nil {
@@ -142,6 +165,26 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
 	}
 
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	icaPrecompile, err := icaprecompile.NewPrecompile(icaControllerKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ICA precompile: %w", err))
+	}
+
+	evmGovPrecompile, err := evmgovprecompile.NewPrecompile(evmKeeper, erc20Keeper, options.GovernanceAuthority)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate EVM governance precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -153,6 +196,10 @@ func NewAvailableStaticPrecompiles(
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[evmGovPrecompile.Address()] = evmGovPrecompile
 
 	return precompiles
 }
@@ -0,0 +1,207 @@
+package ibc
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ica"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	testutil2 "github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type ICAPrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ica.Precompile
+	chainB           *evmibctesting.TestChain
+}
+
+func (suite *ICAPrecompileTestSuite) SetupTest() {
+	// evmd is not an interchain accounts host, so the host chain is a simapp chain
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	var err error
+	suite.chainAPrecompile, err = ica.NewPrecompile(&evmAppA.ICAControllerKeeper)
+	suite.Require().NoError(err)
+}
+
+// registerInterchainAccount registers the interchain account of the owner
+// through the precompile and completes the channel handshake.
+func (suite *ICAPrecompileTestSuite) registerInterchainAccount(path *evmibctesting.Path, senderIdx int) {
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+
+	data, err := suite.chainAPrecompile.Pack(ica.RegisterInterchainAccountMethod, owner, path.EndpointA.ConnectionID, "")
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+
+	portID, err := icatypes.NewControllerPortID(senderAccount.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID, err = evmibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+}
+
+func (suite *ICAPrecompileTestSuite) TestSendTx() {
+	path := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	simAppB := suite.chainB.GetSimApp()
+
+	suite.registerInterchainAccount(path, senderIdx)
+
+	// the interchain account is queried through the precompile
+	ctxA := evmante.BuildEvmExecutionCtx(suite.chainA.GetContext())
+	evmRes, err := evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		owner,
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ica.InterchainAccountMethod,
+		owner,
+		path.EndpointA.ConnectionID,
+	)
+	suite.Require().NoError(err)
+	var accountAddress string
+	err = suite.chainAPrecompile.UnpackIntoInterface(&accountAddress, ica.InterchainAccountMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(accountAddress)
+
+	hostAccount, found := simAppB.ICAHostKeeper.GetInterchainAccountAddress(
+		suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(hostAccount, accountAddress)
+
+	// fund the interchain account on the host chain
+	bondDenom, err := simAppB.StakingKeeper.BondDenom(suite.chainB.GetContext())
+	suite.Require().NoError(err)
+	amount := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1000)))
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(
+		suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(accountAddress), amount,
+	))
+	suite.Require().NoError(err)
+
+	// deploy the contract receiving the acknowledgement on the controller chain
+	contractData, err := testutil2.LoadCounterWithCallbacksContract()
+	suite.Require().NoError(err)
+	contractAddr, err := DeployContract(suite.T(), suite.chainA, testutiltypes.ContractDeploymentData{
+		Contract: contractData,
+	})
+	suite.Require().NoError(err)
+	// the deployment increments the nonce of the account relaying the packets
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+
+	receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	receiverBalance := simAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+
+	msgSend := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(accountAddress), receiver, amount)
+	msgBz, err := msgSend.Marshal()
+	suite.Require().NoError(err)
+	msgs := []ica.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(msgSend), Value: msgBz}}
+	memo := fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "1000000"}}`, contractAddr.Hex())
+
+	data, err := suite.chainAPrecompile.Pack(ica.SendTxMethod, owner, path.EndpointA.ConnectionID, msgs, memo, uint64(600_000_000_000))
+	suite.Require().NoError(err)
+	res, _, ethRes, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+
+	var sequence uint64
+	err = suite.chainAPrecompile.UnpackIntoInterface(&sequence, ica.SendTxMethod, ethRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, packet.SourcePort)
+	suite.Require().Equal(sequence, packet.Sequence)
+
+	_, ack, err := path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+	var ackRes channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack, &ackRes))
+	suite.Require().True(ackRes.Success(), "expected a successful acknowledgement, got %s", ack)
+
+	// the messages were executed by the interchain account
+	afterReceiverBalance := simAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+	suite.Require().Equal(receiverBalance.Add(amount[0]), afterReceiverBalance)
+
+	// the acknowledgement was delivered to the callback contract
+	counterRes, err := evmAppA.EVMKeeper.CallEVM(
+		suite.chainA.GetContext(),
+		contractData.ABI,
+		owner,
+		contractAddr,
+		false,
+		big.NewInt(100000),
+		"getCounter",
+	)
+	suite.Require().NoError(err)
+	var counter *big.Int
+	err = contractData.ABI.UnpackIntoInterface(&counter, "getCounter", counterRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), counter.Int64())
+}
+
+func (suite *ICAPrecompileTestSuite) TestSendTxRequiresOwner() {
+	path := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	senderIdx := 1
+	suite.registerInterchainAccount(path, senderIdx)
+
+	// another account can't send transactions with the interchain account
+	owner := common.BytesToAddress(suite.chainA.SenderAccounts[senderIdx].SenderAccount.GetAddress().Bytes())
+	msgs := []ica.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}}
+	data, err := suite.chainAPrecompile.Pack(ica.SendTxMethod, owner, path.EndpointA.ConnectionID, msgs, "", uint64(600_000_000_000))
+	suite.Require().NoError(err)
+
+	otherIdx := 2
+	_, _, _, err = suite.chainA.SendEvmTx(suite.chainA.SenderAccounts[otherIdx], otherIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().Error(err)
+}
+
+func TestICAPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAPrecompileTestSuite))
+}
@@ -15,17 +15,20 @@ import (
 
 	"github.com/cosmos/evm/evmd"
 	"github.com/cosmos/evm/evmd/tests/integration"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/ics20"
 	chainutil "github.com/cosmos/evm/testutil"
 	evmibctesting "github.com/cosmos/evm/testutil/ibc"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 type ICS20TransferTestSuite struct {
@@ -51,6 +54,7 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		*evmAppA.StakingKeeper,
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
+		evmAppA.IBCKeeper.ChannelKeeperV2,
 		evmAppA.EVMKeeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
@@ -59,6 +63,7 @@ func (suite *ICS20TransferTestSuite) SetupTest() {
 		*evmAppB.StakingKeeper,
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
+		evmAppB.IBCKeeper.ChannelKeeperV2,
 		evmAppB.EVMKeeper,
 	)
 }
@@ -357,6 +362,158 @@ func (suite *ICS20TransferTestSuite) TestHandleMsgTransfer() {
 	}
 }
 
+// Constructs a transfer of multiple tokens from evmChainA to chainB, and checks
+// the status of the packets sent through the ics20 precompile queries.
+func (suite *ICS20TransferTestSuite) TestHandleMsgTransferV2() {
+	pathAToB := evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAToB.Setup()
+	traceAToB := transfertypes.NewHop(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID)
+
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	senderAddr := senderAccount.SenderAccount.GetAddress()
+	senderEVMAddr := common.BytesToAddress(senderAddr.Bytes())
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	ctxA := suite.chainA.GetContext()
+	bondDenom, err := evmAppA.StakingKeeper.BondDenom(ctxA)
+	suite.Require().NoError(err)
+
+	otherCoin := sdk.NewCoin("foo", evmibctesting.DefaultCoinAmount)
+	suite.Require().NoError(evmAppA.BankKeeper.MintCoins(ctxA, minttypes.ModuleName, sdk.NewCoins(otherCoin)))
+	suite.Require().NoError(evmAppA.BankKeeper.SendCoinsFromModuleToAccount(ctxA, minttypes.ModuleName, senderAddr, sdk.NewCoins(otherCoin)))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	tokens := []cmn.Coin{
+		{Denom: bondDenom, Amount: evmibctesting.DefaultCoinAmount.BigInt()},
+		{Denom: otherCoin.Denom, Amount: otherCoin.Amount.BigInt()},
+	}
+
+	queryA := func(method string, args ...interface{}) []interface{} {
+		evmRes, err := evmAppA.EVMKeeper.CallEVM(
+			evmante.BuildEvmExecutionCtx(suite.chainA.GetContext()),
+			suite.chainAPrecompile.ABI,
+			senderEVMAddr,
+			suite.chainAPrecompile.Address(),
+			false,
+			nil,
+			method,
+			args...,
+		)
+		suite.Require().NoError(err)
+		out, err := suite.chainAPrecompile.Unpack(method, evmRes.Ret)
+		suite.Require().NoError(err)
+		return out
+	}
+
+	data, err := suite.chainAPrecompile.Pack(ics20.TransferV2Method,
+		pathAToB.EndpointA.ChannelConfig.PortID,
+		pathAToB.EndpointA.ChannelID,
+		tokens,
+		senderEVMAddr,
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110),
+		uint64(0),
+		"",
+		"",
+		[]transfertypes.Hop{},
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	packets, err := evmibctesting.ParsePacketsFromEvents(channeltypes.EventTypeSendPacket, res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, len(tokens))
+
+	// the packets are pending until they are acknowledged
+	for _, packet := range packets {
+		out := queryA(ics20.PacketStatusMethod, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().Equal(uint8(ics20.PacketStatusPending), out[0])
+	}
+
+	escrowAddress := transfertypes.GetEscrowAddress(pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID)
+	out := queryA(ics20.EscrowAddressMethod, pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID)
+	suite.Require().Equal(common.BytesToAddress(escrowAddress), out[0])
+
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	for i, packet := range packets {
+		suite.Require().NoError(pathAToB.RelayPacket(packet))
+
+		out := queryA(ics20.PacketStatusMethod, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().Equal(uint8(ics20.PacketStatusCompleted), out[0])
+
+		// check that the tokens are escrowed on chain A and the vouchers exist on chain B
+		escrowBalance := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, tokens[i].Denom)
+		suite.Require().Equal(tokens[i].Amount.String(), escrowBalance.Amount.String())
+
+		out = queryA(ics20.TotalEscrowMethod, tokens[i].Denom)
+		totalEscrow, ok := out[0].(struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		})
+		suite.Require().True(ok)
+		suite.Require().Equal(tokens[i].Denom, totalEscrow.Denom)
+		suite.Require().Equal(tokens[i].Amount.String(), totalEscrow.Amount.String())
+
+		chainBDenom := transfertypes.NewDenom(tokens[i].Denom, traceAToB)
+		chainBBalance := evmAppB.BankKeeper.GetBalance(
+			suite.chainB.GetContext(),
+			suite.chainB.SenderAccount.GetAddress(),
+			chainBDenom.IBCDenom(),
+		)
+		suite.Require().Equal(tokens[i].Amount.String(), chainBBalance.Amount.String())
+	}
+
+	// the next sequence hasn't been sent yet
+	nextSequence := packets[len(packets)-1].GetSequence() + 1
+	out = queryA(ics20.PacketStatusMethod, pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID, nextSequence)
+	suite.Require().Equal(uint8(ics20.PacketStatusNotSent), out[0])
+}
+
+// Checks that a transfer forwarded through intermediate chains sets the
+// packet forward middleware metadata in the memo of the packet.
+func (suite *ICS20TransferTestSuite) TestHandleMsgTransferV2Forwarding() {
+	pathAToB := evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAToB.Setup()
+
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	senderEVMAddr := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	receiver := "cosmos1receiver"
+	hops := []transfertypes.Hop{transfertypes.NewHop(transfertypes.PortID, "channel-7")}
+	data, err := suite.chainAPrecompile.Pack(ics20.TransferV2Method,
+		pathAToB.EndpointA.ChannelConfig.PortID,
+		pathAToB.EndpointA.ChannelID,
+		[]cmn.Coin{{Denom: bondDenom, Amount: evmibctesting.DefaultCoinAmount.BigInt()}},
+		senderEVMAddr,
+		receiver,
+		clienttypes.NewHeight(1, 110),
+		uint64(0),
+		"",
+		"",
+		hops,
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	packetData, err := transfertypes.UnmarshalPacketData(packet.GetData(), pathAToB.EndpointA.GetChannel().Version, "")
+	suite.Require().NoError(err)
+	expMemo, err := ics20.NewForwardingMemo(receiver, hops, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(ics20.ForwardingReceiver, packetData.Receiver)
+	suite.Require().Equal(expMemo, packetData.Memo)
+}
+
 func TestICS20TransferTestSuite(t *testing.T) {
 	suite.Run(t, new(ICS20TransferTestSuite))
 }
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/evm/evmd"
 	"github.com/cosmos/evm/evmd/tests/integration"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/ics20"
 	chainutil "github.com/cosmos/evm/testutil"
 	evmibctesting "github.com/cosmos/evm/testutil/ibc"
@@ -52,6 +53,7 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		*evmAppA.StakingKeeper,
 		evmAppA.TransferKeeper,
 		evmAppA.IBCKeeper.ChannelKeeper,
+		evmAppA.IBCKeeper.ChannelKeeperV2,
 		evmAppA.EVMKeeper,
 	)
 	evmAppB := suite.chainB.App.(*evmd.EVMD)
@@ -60,6 +62,7 @@ func (suite *ICS20TransferV2TestSuite) SetupTest() {
 		*evmAppB.StakingKeeper,
 		evmAppB.TransferKeeper,
 		evmAppB.IBCKeeper.ChannelKeeper,
+		evmAppB.IBCKeeper.ChannelKeeperV2,
 		evmAppB.EVMKeeper,
 	)
 }
@@ -361,6 +364,78 @@ func (suite *ICS20TransferV2TestSuite) TestHandleMsgTransfer() {
 	}
 }
 
+// Constructs a transfer of the bond denom from evmChainA to chainB through the
+// IBC v2 client, and checks the status of the packet sent.
+func (suite *ICS20TransferV2TestSuite) TestHandleMsgTransferV2() {
+	pathAToB := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	pathAToB.SetupV2()
+	traceAToB := transfertypes.NewHop(transfertypes.PortID, pathAToB.EndpointB.ClientID)
+
+	senderIdx := 1
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	senderEVMAddr := common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes())
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	queryPacketStatus := func(sequence uint64) uint8 {
+		evmRes, err := evmAppA.EVMKeeper.CallEVM(
+			evmante.BuildEvmExecutionCtx(suite.chainA.GetContext()),
+			suite.chainAPrecompile.ABI,
+			senderEVMAddr,
+			suite.chainAPrecompile.Address(),
+			false,
+			nil,
+			ics20.PacketStatusMethod,
+			transfertypes.PortID,
+			pathAToB.EndpointA.ClientID,
+			sequence,
+		)
+		suite.Require().NoError(err)
+		out, err := suite.chainAPrecompile.Unpack(ics20.PacketStatusMethod, evmRes.Ret)
+		suite.Require().NoError(err)
+		return out[0].(uint8)
+	}
+
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
+	data, err := suite.chainAPrecompile.Pack(ics20.TransferV2Method,
+		transfertypes.PortID,
+		pathAToB.EndpointA.ClientID, // Note: should be client id on v2 packet
+		[]cmn.Coin{{Denom: bondDenom, Amount: evmibctesting.DefaultCoinAmount.BigInt()}},
+		senderEVMAddr,
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		"",
+		transfertypes.EncodingJSON,
+		[]transfertypes.Hop{},
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	packets, err := pathAToB.EndpointA.ParseV2PacketFromEvent(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	suite.Require().Equal(transfertypes.EncodingJSON, packets[0].Payloads[0].Encoding)
+	suite.Require().Equal(uint8(ics20.PacketStatusPending), queryPacketStatus(packets[0].Sequence))
+
+	suite.Require().NoError(pathAToB.RelayPacketV2(packets[0]))
+	suite.Require().Equal(uint8(ics20.PacketStatusCompleted), queryPacketStatus(packets[0].Sequence))
+	suite.Require().Equal(uint8(ics20.PacketStatusNotSent), queryPacketStatus(packets[0].Sequence+1))
+
+	// check that voucher exists on chain B
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	chainBDenom := transfertypes.NewDenom(bondDenom, traceAToB)
+	chainBBalance := evmAppB.BankKeeper.GetBalance(
+		suite.chainB.GetContext(),
+		suite.chainB.SenderAccount.GetAddress(),
+		chainBDenom.IBCDenom(),
+	)
+	suite.Require().Equal(evmibctesting.DefaultCoinAmount.String(), chainBBalance.Amount.String())
+}
+
 func TestICS20TransferV2TestSuite(t *testing.T) {
 	suite.Run(t, new(ICS20TransferV2TestSuite))
 }
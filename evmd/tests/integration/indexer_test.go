@@ -9,3 +9,15 @@ import (
 func TestKVIndexer(t *testing.T) {
 	indexer.TestKVIndexer(t, CreateEvmd)
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	indexer.TestKVIndexerLogs(t, CreateEvmd)
+}
+
+func TestKVIndexerMaintenance(t *testing.T) {
+	indexer.TestKVIndexerMaintenance(t, CreateEvmd)
+}
+
+func TestSQLIndexer(t *testing.T) {
+	indexer.TestSQLIndexer(t, CreateEvmd)
+}
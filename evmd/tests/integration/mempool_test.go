@@ -0,0 +1,11 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/cosmos/evm/tests/integration/mempool"
+)
+
+func TestEVMMempool(t *testing.T) {
+	mempool.TestEVMMempool(t, CreateEvmd)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 
+	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/server"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -133,7 +134,8 @@ func startInProcess(cfg Config, val *Validator) error {
 		tmEndpoint := "/websocket"
 		tmRPCAddr := fmt.Sprintf("tcp://%s", val.AppConfig.GRPC.Address)
 
-		val.jsonrpc, err = server.StartJSONRPC(ctx, val.Ctx, val.ClientCtx, val.errGroup, tmRPCAddr, tmEndpoint, val.AppConfig, nil)
+		txPool, _ := app.(backend.TxPool)
+		val.jsonrpc, err = server.StartJSONRPC(ctx, val.Ctx, val.ClientCtx, val.errGroup, tmRPCAddr, tmEndpoint, val.AppConfig, nil, txPool)
 		if err != nil {
 			return err
 		}
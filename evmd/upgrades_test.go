@@ -0,0 +1,38 @@
+package evmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+func TestUpgradeHandlerEnablesHistoryStorage(t *testing.T) {
+	chainID := constants.ExampleChainID
+	app := evmd.Setup(t, chainID.ChainID, chainID.EVMChainID)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10)
+
+	// an existing chain without the history storage
+	ctx.KVStore(app.GetKey(evmtypes.StoreKey)).Delete(evmtypes.KeyHistoryStorageStart)
+	_, enabled := app.EVMKeeper.GetHistoryStorageStart(ctx)
+	require.False(t, enabled)
+
+	require.True(t, app.UpgradeKeeper.HasHandler(evmd.UpgradeName))
+	err := app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: evmd.UpgradeName, Height: ctx.BlockHeight()})
+	require.NoError(t, err)
+
+	start, enabled := app.EVMKeeper.GetHistoryStorageStart(ctx)
+	require.True(t, enabled)
+	require.Equal(t, uint64(10), start)
+
+	// the block hashes are stored from the upgrade block
+	hash := []byte("01234567890123456789012345678901")
+	require.NoError(t, app.EVMKeeper.EndBlock(ctx.WithHeaderHash(hash)))
+	nextCtx := ctx.WithBlockHeight(11)
+	require.Equal(t, hash, app.EVMKeeper.GetHistoricalBlockHash(nextCtx, 10).Bytes())
+}
@@ -26,22 +26,39 @@ const (
 	KeyPrefixTxHash  = 1
 	KeyPrefixTxIndex = 2
 
+	KeyPrefixLog          = 3
+	KeyPrefixLogAddress   = 4
+	KeyPrefixLogTopic     = 5
+	KeyPrefixLogIndexMeta = 6
+
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
-var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
+var _ cosmosevmtypes.EVMLogIndexer = &KVIndexer{}
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// logIndex enables the indexing of the logs, see indexLogs
+	logIndex bool
 }
 
-// NewKVIndexer creates the KVIndexer
+// NewKVIndexer creates the KVIndexer, which also indexes the logs unless
+// disabled with WithLogIndex.
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx, logIndex: true}
+}
+
+// WithLogIndex enables or disables the indexing of the logs. When disabled, no
+// block is covered by the log index, so that the logs are read from the block
+// results.
+func (kv *KVIndexer) WithLogIndex(enabled bool) *KVIndexer {
+	kv.logIndex = enabled
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -49,12 +66,50 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Indexes the logs of the block by address and topic, see indexLogs
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
-	height := block.Height
-
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
+	if err := kv.indexBlock(batch, block, txResults); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// indexBlock indexes the eth txs of a block, and its logs if the log index is
+// enabled, into the kv db batch.
+func (kv *KVIndexer) indexBlock(batch dbm.Batch, block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+	for _, tx := range parseBlock(kv.clientCtx.TxConfig.TxDecoder(), kv.logger, block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, tx.hash, tx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if kv.logIndex {
+		if err := kv.indexLogs(batch, height, txResults); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	return nil
+}
+
+// indexedTx is an eth tx of a block along with the result to index.
+type indexedTx struct {
+	hash   common.Hash
+	msg    *evmtypes.MsgEthereumTx
+	result *cosmosevmtypes.TxResult
+}
+
+// parseBlock returns the eth txs of a block with their results, in the order
+// of their eth tx index.
+func parseBlock(txDecoder sdk.TxDecoder, logger log.Logger, block *cmttypes.Block, txResults []*abci.ExecTxResult) []indexedTx {
+	height := block.Height
+
+	var indexedTxs []indexedTx
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -63,9 +118,9 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			continue
 		}
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := txDecoder(tx)
 		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -75,7 +130,7 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 		txs, err := rpctypes.ParseTxResult(result, tx)
 		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
@@ -98,11 +153,11 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			} else {
 				parsedTx := txs.GetTxByMsgIndex(msgIndex)
 				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
 					continue
 				}
 				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
@@ -112,15 +167,10 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+			indexedTxs = append(indexedTxs, indexedTx{hash: txHash, msg: ethMsg, result: &txResult})
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
-	return nil
+	return indexedTxs
 }
 
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
@@ -0,0 +1,331 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxLogTopics is the maximum number of topics of a log.
+const MaxLogTopics = 4
+
+// ErrExceedMaxTopics is returned when a logs query has more than MaxLogTopics
+// topics, same as geth.
+var ErrExceedMaxTopics = errors.New("exceed max topics")
+
+var (
+	logIndexFirstBlockKey = []byte{KeyPrefixLogIndexMeta, 0}
+	logIndexLastBlockKey  = []byte{KeyPrefixLogIndexMeta, 1}
+)
+
+// indexLogs indexes the logs emitted in a block:
+// - every log is stored by `(block number, log position)`
+// - the position of every log is indexed by address and by topic
+//
+// The log index only covers the contiguous range of blocks indexed since it was
+// enabled, see LogIndexRange.
+func (kv *KVIndexer) indexLogs(batch dbm.Batch, height int64, txResults []*abci.ExecTxResult) error {
+	var position uint64
+	for _, result := range txResults {
+		for _, txLog := range parseTxLogs(kv.logger, height, result) {
+			if err := kv.saveLog(batch, height, position, txLog); err != nil {
+				return err
+			}
+			position++
+		}
+	}
+
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return err
+	}
+	if first < 0 || height > last+1 {
+		// the indexed range must be contiguous, so restart it
+		if err := batch.Set(logIndexFirstBlockKey, sdk.Uint64ToBigEndian(uint64(height))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+			return errorsmod.Wrap(err, "set log index first block")
+		}
+	}
+	if height > last {
+		if err := batch.Set(logIndexLastBlockKey, sdk.Uint64ToBigEndian(uint64(height))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+			return errorsmod.Wrap(err, "set log index last block")
+		}
+	}
+	return nil
+}
+
+// parseTxLogs returns the logs emitted by a tx, from its events.
+func parseTxLogs(logger log.Logger, height int64, result *abci.ExecTxResult) []*evmtypes.Log {
+	var txLogs []*evmtypes.Log
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var txLog evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+				logger.Error("Fail to parse tx log", "err", err, "block", height)
+				continue
+			}
+			txLogs = append(txLogs, &txLog)
+		}
+	}
+	return txLogs
+}
+
+// saveLog indexes a log into the kv db batch.
+func (kv *KVIndexer) saveLog(batch dbm.Batch, height int64, position uint64, txLog *evmtypes.Log) error {
+	bz, err := kv.clientCtx.Codec.Marshal(txLog)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal tx log")
+	}
+	if err := batch.Set(LogKey(height, position), bz); err != nil {
+		return errorsmod.Wrap(err, "set log key")
+	}
+
+	address := common.HexToAddress(txLog.Address)
+	if err := batch.Set(LogAddressKey(address, height, position), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set log address key")
+	}
+
+	for i, topic := range txLog.Topics {
+		if i >= MaxLogTopics {
+			break
+		}
+		topicHash := common.HexToHash(topic)
+		if err := batch.Set(LogTopicKey(i, topicHash, height, position), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log topic key")
+		}
+	}
+	return nil
+}
+
+// LogIndexRange returns the first and last blocks covered by the log index,
+// returns -1 for both if no block was log-indexed yet or if the log index is
+// disabled.
+func (kv *KVIndexer) LogIndexRange() (int64, int64, error) {
+	if !kv.logIndex {
+		return -1, -1, nil
+	}
+	first, err := kv.db.Get(logIndexFirstBlockKey)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	last, err := kv.db.Get(logIndexLastBlockKey)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	if len(first) == 0 || len(last) == 0 {
+		return -1, -1, nil
+	}
+	//#nosec G115 -- block number is unlikely to exceed int64
+	return int64(sdk.BigEndianToUint64(first)), int64(sdk.BigEndianToUint64(last)), nil
+}
+
+// GetLogs returns the logs within the [from, to] block range matching the
+// addresses and topics, with the same semantics as eth_getLogs. It returns an
+// error if more than limit logs match, if more than maxScanned indexed logs are
+// read to match them (unless it is 0), if there are more than MaxLogTopics
+// topics, or if the range is not covered by the log index.
+func (kv *KVIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit, maxScanned int) ([]*ethtypes.Log, error) {
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return nil, err
+	}
+	if first < 0 || from < first || to > last {
+		return nil, fmt.Errorf("block range [%d, %d] not covered by the log index [%d, %d]", from, to, first, last)
+	}
+	if len(topics) > MaxLogTopics {
+		return nil, ErrExceedMaxTopics
+	}
+
+	// every criteria matches the positions indexed under one of its prefixes
+	var criteria [][][]byte
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+		}
+		criteria = append(criteria, prefixes)
+	}
+	for i, topicList := range topics {
+		if len(topicList) == 0 {
+			// wildcard
+			continue
+		}
+		prefixes := make([][]byte, len(topicList))
+		for j, topic := range topicList {
+			prefixes[j] = append([]byte{KeyPrefixLogTopic, byte(i)}, topic.Bytes()...)
+		}
+		criteria = append(criteria, prefixes)
+	}
+
+	var positions [][]byte
+	if len(criteria) == 0 {
+		positions, err = kv.scanLogPositions(from, to, limit)
+	} else {
+		positions, err = kv.matchLogPositions(from, to, criteria, limit, maxScanned)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) > limit {
+		return nil, fmt.Errorf("query returned more than %d results", limit)
+	}
+
+	logs := make([]*ethtypes.Log, 0, len(positions))
+	for _, position := range positions {
+		bz, err := kv.db.Get(append([]byte{KeyPrefixLog}, position...))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		var txLog evmtypes.Log
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &txLog); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		logs = append(logs, txLog.ToEthereum())
+	}
+	return logs, nil
+}
+
+// scanLogPositions returns the positions of all the logs within the block
+// range, stopping once more than limit are found.
+func (kv *KVIndexer) scanLogPositions(from, to int64, limit int) ([][]byte, error) {
+	it, err := kv.db.Iterator(LogKey(from, 0), LogKey(to+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogs")
+	}
+	defer it.Close()
+
+	var positions [][]byte
+	for ; it.Valid() && len(positions) <= limit; it.Next() {
+		positions = append(positions, bytes.Clone(it.Key()[1:]))
+	}
+	return positions, it.Error()
+}
+
+// matchLogPositions returns the sorted positions of the logs within the block
+// range matching all the criteria, where a criteria matches the logs indexed
+// under any of its prefixes, stopping once more than limit are found. The
+// positions of the first criteria are iterated in order and looked up in the
+// other criteria, and it fails once more than maxScanned of them are read,
+// unless it is 0.
+func (kv *KVIndexer) matchLogPositions(from, to int64, criteria [][][]byte, limit, maxScanned int) ([][]byte, error) {
+	iterators := make([]dbm.Iterator, 0, len(criteria[0]))
+	defer func() {
+		for _, it := range iterators {
+			it.Close()
+		}
+	}()
+	for _, prefix := range criteria[0] {
+		start := append(bytes.Clone(prefix), LogKey(from, 0)[1:]...)
+		end := append(bytes.Clone(prefix), LogKey(to+1, 0)[1:]...)
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		iterators = append(iterators, it)
+	}
+
+	var (
+		positions [][]byte
+		last      []byte
+		scanned   int
+	)
+	for len(positions) <= limit {
+		// lowest position among the prefixes of the first criteria
+		next := -1
+		var position []byte
+		for i, it := range iterators {
+			if !it.Valid() {
+				continue
+			}
+			if candidate := it.Key()[len(criteria[0][i]):]; next < 0 || bytes.Compare(candidate, position) < 0 {
+				next, position = i, candidate
+			}
+		}
+		if next < 0 {
+			break
+		}
+		position = bytes.Clone(position)
+		iterators[next].Next()
+		if bytes.Equal(position, last) {
+			continue
+		}
+		last = position
+
+		if scanned++; maxScanned > 0 && scanned > maxScanned {
+			return nil, fmt.Errorf("query scanned more than %d indexed logs, narrow the block range or the filter criteria", maxScanned)
+		}
+
+		matched, err := kv.matchesLogCriteria(position, criteria[1:])
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			positions = append(positions, position)
+		}
+	}
+
+	for _, it := range iterators {
+		if err := it.Error(); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+	}
+	return positions, nil
+}
+
+// matchesLogCriteria returns true if the log at the position is indexed under
+// one of the prefixes of every criteria.
+func (kv *KVIndexer) matchesLogCriteria(position []byte, criteria [][][]byte) (bool, error) {
+	for _, prefixes := range criteria {
+		found := false
+		for _, prefix := range prefixes {
+			has, err := kv.db.Has(append(bytes.Clone(prefix), position...))
+			if err != nil {
+				return false, errorsmod.Wrap(err, "GetLogs")
+			}
+			if has {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// LogKey returns the key for db entry: `(block number, log position) -> log`
+func LogKey(blockNumber int64, position uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append(append([]byte{KeyPrefixLog}, bz...), sdk.Uint64ToBigEndian(position)...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log position) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, position uint64) []byte {
+	return append(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), LogKey(blockNumber, position)[1:]...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic index, topic, block number, log position) -> nil`
+func LogTopicKey(index int, topic common.Hash, blockNumber int64, position uint64) []byte {
+	return append(append([]byte{KeyPrefixLogTopic, byte(index)}, topic.Bytes()...), LogKey(blockNumber, position)[1:]...)
+}
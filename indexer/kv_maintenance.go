@@ -0,0 +1,318 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pruneBatchSize is the maximum number of entries deleted in a single batch.
+const pruneBatchSize = 10_000
+
+// IndexMismatch describes an entry of the indexer that doesn't agree with the
+// block results.
+type IndexMismatch struct {
+	Height int64
+	TxHash common.Hash
+	Reason string
+}
+
+func (m IndexMismatch) String() string {
+	return fmt.Sprintf("height %d, tx %s: %s", m.Height, m.TxHash.Hex(), m.Reason)
+}
+
+// ReindexBlock deletes the indexed entries of a block and indexes it again, e.g.
+// to fix the entries written by a faulty version. Both are written in a single
+// batch, so the block is never left without its entries.
+func (kv *KVIndexer) ReindexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for _, r := range kv.blockRanges(height, height+1) {
+		// the entries of a single block are bounded by the block gas limit
+		keys, values, err := kv.readRange(r.start, r.end, 0)
+		if err != nil {
+			return errorsmod.Wrapf(err, "ReindexBlock %d", height)
+		}
+		if _, err := batchDeleteEntries(batch, keys, values, r.onDelete); err != nil {
+			return errorsmod.Wrapf(err, "ReindexBlock %d", height)
+		}
+	}
+	if err := kv.indexBlock(batch, block, txResults); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "ReindexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// VerifyBlock checks that the indexed eth txs of a block agree with the block
+// results: every eth tx must be indexed by hash and by eth tx index with the
+// same position and gas used, and no other tx must be indexed at the block
+// height. It returns the mismatches found.
+func (kv *KVIndexer) VerifyBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) ([]IndexMismatch, error) {
+	height := block.Height
+	expected := parseBlock(kv.clientCtx.TxConfig.TxDecoder(), kv.logger, block, txResults)
+
+	var mismatches []IndexMismatch
+	addMismatch := func(txHash common.Hash, format string, args ...interface{}) {
+		mismatches = append(mismatches, IndexMismatch{Height: height, TxHash: txHash, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	for _, tx := range expected {
+		bz, err := kv.db.Get(TxHashKey(tx.hash))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		if len(bz) == 0 {
+			addMismatch(tx.hash, "tx not indexed")
+			continue
+		}
+		var res cosmosevmtypes.TxResult
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &res); err != nil {
+			return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+
+		switch exp := tx.result; {
+		case res.Height != exp.Height || res.TxIndex != exp.TxIndex || res.MsgIndex != exp.MsgIndex || res.EthTxIndex != exp.EthTxIndex:
+			addMismatch(tx.hash, "indexed at height %d, tx index %d, msg index %d, eth tx index %d, expected height %d, tx index %d, msg index %d, eth tx index %d",
+				res.Height, res.TxIndex, res.MsgIndex, res.EthTxIndex, exp.Height, exp.TxIndex, exp.MsgIndex, exp.EthTxIndex)
+		case res.GasUsed != exp.GasUsed || res.CumulativeGasUsed != exp.CumulativeGasUsed:
+			addMismatch(tx.hash, "indexed gas used %d, cumulative %d, expected %d, cumulative %d",
+				res.GasUsed, res.CumulativeGasUsed, exp.GasUsed, exp.CumulativeGasUsed)
+		case res.Failed != exp.Failed:
+			addMismatch(tx.hash, "indexed failed %t, expected %t", res.Failed, exp.Failed)
+		}
+
+		bz, err = kv.db.Get(TxIndexKey(height, tx.result.EthTxIndex))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		if !bytes.Equal(bz, tx.hash.Bytes()) {
+			addMismatch(tx.hash, "eth tx index %d points to tx %s", tx.result.EthTxIndex, common.BytesToHash(bz).Hex())
+		}
+	}
+
+	// the eth tx indexes are contiguous, so any index above is unexpected
+	it, err := kv.db.Iterator(TxIndexKey(height, int32(len(expected))), TxIndexKey(height+1, 0)) //nolint:gosec // G115 // number of txs won't exceed int32
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		ethTxIndex := int32(sdk.BigEndianToUint64(it.Key()[9:])) //nolint:gosec // G115 // index won't exceed int32
+		addMismatch(common.BytesToHash(it.Value()), "unexpected tx indexed at eth tx index %d", ethTxIndex)
+	}
+	return mismatches, it.Error()
+}
+
+// PruneBlocks deletes the indexed entries of the blocks below retainHeight, e.g.
+// to follow the CometBFT block store pruning. It returns the number of deleted entries.
+func (kv *KVIndexer) PruneBlocks(retainHeight int64) (int, error) {
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return 0, err
+	}
+
+	pruned, err := kv.deleteBlocks(0, retainHeight)
+	if err != nil {
+		return pruned, errorsmod.Wrapf(err, "PruneBlocks %d", retainHeight)
+	}
+	if first < 0 || first >= retainHeight {
+		return pruned, nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+	if last < retainHeight {
+		// no log indexed block is left
+		if err := batch.Delete(logIndexFirstBlockKey); err != nil {
+			return pruned, errorsmod.Wrap(err, "delete log index first block")
+		}
+		if err := batch.Delete(logIndexLastBlockKey); err != nil {
+			return pruned, errorsmod.Wrap(err, "delete log index last block")
+		}
+	} else if err := batch.Set(logIndexFirstBlockKey, sdk.Uint64ToBigEndian(uint64(retainHeight))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+		return pruned, errorsmod.Wrap(err, "set log index first block")
+	}
+	if err := batch.Write(); err != nil {
+		return pruned, errorsmod.Wrapf(err, "PruneBlocks %d, write batch", retainHeight)
+	}
+	return pruned, nil
+}
+
+// deleteBlocks deletes the txs and logs indexed within the [from, to) block
+// range. It returns the number of deleted entries.
+func (kv *KVIndexer) deleteBlocks(from, to int64) (int, error) {
+	var deleted int
+	for _, r := range kv.blockRanges(from, to) {
+		n, err := kv.deleteRange(r.start, r.end, r.onDelete)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// entryRange is a range of entries [start, end), onDelete deletes the entries
+// derived from one of them and returns their number.
+type entryRange struct {
+	start, end []byte
+	onDelete   func(batch dbm.Batch, key, value []byte) (int, error)
+}
+
+// blockRanges returns the ranges of the txs and logs indexed within the
+// [from, to) block range, the tx hashes and the log addresses and topics are
+// derived from them.
+func (kv *KVIndexer) blockRanges(from, to int64) []entryRange {
+	return []entryRange{
+		{TxIndexKey(from, 0), TxIndexKey(to, 0), kv.deleteTxHash},
+		{LogKey(from, 0), LogKey(to, 0), kv.deleteLogKeys},
+	}
+}
+
+// deleteTxHash deletes the tx hash entry of a tx index entry. It's only deleted
+// if it's indexed at the same height, in case the same tx was included again in
+// a later block.
+func (kv *KVIndexer) deleteTxHash(batch dbm.Batch, key, value []byte) (int, error) {
+	txHash := common.BytesToHash(value)
+	bz, err := kv.db.Get(TxHashKey(txHash))
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	var res cosmosevmtypes.TxResult
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &res); err != nil {
+		return 0, errorsmod.Wrapf(err, "unmarshal tx result %s", txHash.Hex())
+	}
+	height, err := parseBlockNumberFromKey(key)
+	if err != nil || res.Height != height {
+		return 0, err
+	}
+	return 1, batch.Delete(TxHashKey(txHash))
+}
+
+// deleteLogKeys deletes the address and topic entries of a log entry.
+func (kv *KVIndexer) deleteLogKeys(batch dbm.Batch, key, value []byte) (int, error) {
+	var txLog evmtypes.Log
+	if err := kv.clientCtx.Codec.Unmarshal(value, &txLog); err != nil {
+		return 0, errorsmod.Wrap(err, "unmarshal tx log")
+	}
+	height, err := parseBlockNumberFromKey(key)
+	if err != nil {
+		return 0, err
+	}
+	position := sdk.BigEndianToUint64(key[9:])
+
+	if err := batch.Delete(LogAddressKey(common.HexToAddress(txLog.Address), height, position)); err != nil {
+		return 0, err
+	}
+	deleted := 1
+	for i, topic := range txLog.Topics {
+		if i >= MaxLogTopics {
+			break
+		}
+		if err := batch.Delete(LogTopicKey(i, common.HexToHash(topic), height, position)); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// deleteRange deletes the entries within [start, end) by batches of
+// pruneBatchSize, onDelete is called on every entry to delete the entries
+// derived from it and returns their number. It returns the number of deleted
+// entries.
+//
+// The entries are read before being deleted as some dbs don't support being
+// written while iterated.
+func (kv *KVIndexer) deleteRange(start, end []byte, onDelete func(batch dbm.Batch, key, value []byte) (int, error)) (int, error) {
+	var deleted int
+	for {
+		keys, values, err := kv.readRange(start, end, pruneBatchSize)
+		if err != nil {
+			return deleted, err
+		}
+		if len(keys) == 0 {
+			return deleted, nil
+		}
+
+		n, err := kv.deleteEntries(keys, values, onDelete)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+
+		// continue right after the last deleted key
+		start = append(keys[len(keys)-1], 0)
+	}
+}
+
+// deleteEntries deletes the entries, and the ones derived from them, in a
+// single batch.
+func (kv *KVIndexer) deleteEntries(keys, values [][]byte, onDelete func(batch dbm.Batch, key, value []byte) (int, error)) (int, error) {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	deleted, err := batchDeleteEntries(batch, keys, values, onDelete)
+	if err != nil {
+		return 0, err
+	}
+	if err := batch.Write(); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// batchDeleteEntries deletes the entries, and the ones derived from them, into
+// the kv db batch. It returns the number of deleted entries.
+func batchDeleteEntries(batch dbm.Batch, keys, values [][]byte, onDelete func(batch dbm.Batch, key, value []byte) (int, error)) (int, error) {
+	var deleted int
+	for i, key := range keys {
+		if onDelete != nil {
+			n, err := onDelete(batch, key, values[i])
+			if err != nil {
+				return 0, err
+			}
+			deleted += n
+		}
+		if err := batch.Delete(key); err != nil {
+			return 0, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// readRange returns up to limit entries within [start, end), or all of them if
+// limit is 0.
+func (kv *KVIndexer) readRange(start, end []byte, limit int) ([][]byte, [][]byte, error) {
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var keys, values [][]byte
+	for ; it.Valid() && (limit == 0 || len(keys) < limit); it.Next() {
+		keys = append(keys, bytes.Clone(it.Key()))
+		values = append(values, bytes.Clone(it.Value()))
+	}
+	return keys, values, it.Error()
+}
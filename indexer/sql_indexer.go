@@ -0,0 +1,373 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	_ "github.com/lib/pq"  // postgres driver
+	_ "modernc.org/sqlite" // sqlite driver
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	// SQLDriverSQLite is the driver of the embedded SQLite databases.
+	SQLDriverSQLite = "sqlite"
+	// SQLDriverPostgres is the driver of the Postgres databases.
+	SQLDriverPostgres = "postgres"
+)
+
+// ErrUnknownSchemaVersion is returned when the database schema is more recent
+// than the SQL indexer.
+var ErrUnknownSchemaVersion = errors.New("unknown schema version")
+
+// erc20TransferTopic is the topic of the ERC20 `Transfer(address,address,uint256)` event.
+var erc20TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+var _ cosmosevmtypes.EVMTxIndexer = &SQLIndexer{}
+
+// SQLIndexer implements an eth tx indexer on a SQL database, which stores the
+// blocks, transactions, receipts, logs and ERC20 transfers in normalized
+// tables to be queried by external tools, see sqlMigrations for the schema.
+//
+// The addresses are stored as lower case hex strings, the 256 bits numbers as
+// NUMERIC in Postgres and as decimal strings in SQLite.
+type SQLIndexer struct {
+	db        *sql.DB
+	driver    string
+	numType   string
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSQLIndexer opens the SQL database of the given driver, SQLDriverSQLite or
+// SQLDriverPostgres, and creates the SQLIndexer. The schema migrations must be
+// applied with MigrateUp before indexing blocks.
+func NewSQLIndexer(driver, dsn string, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	var numType string
+	switch driver {
+	case SQLDriverSQLite:
+		numType = "TEXT"
+	case SQLDriverPostgres:
+		numType = "NUMERIC(78, 0)"
+	default:
+		return nil, fmt.Errorf("unknown sql driver, expect: %s|%s, got: %s", SQLDriverSQLite, SQLDriverPostgres, driver)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "open %s database", driver)
+	}
+	if driver == SQLDriverSQLite {
+		// SQLite only supports a single writer
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, errorsmod.Wrapf(err, "connect to %s database", driver)
+	}
+
+	return &SQLIndexer{
+		db:        db,
+		driver:    driver,
+		numType:   numType,
+		logger:    logger,
+		clientCtx: clientCtx,
+	}, nil
+}
+
+// Close closes the database.
+func (idx *SQLIndexer) Close() error {
+	return idx.db.Close()
+}
+
+// IndexBlock indexes a block, its eth txs with their receipts, and the logs and
+// ERC20 transfers they emitted, in a single database transaction. The existing
+// rows of the block are replaced, so that a block can be indexed again.
+func (idx *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+	txs := parseBlock(idx.clientCtx.TxConfig.TxDecoder(), idx.logger, block, txResults)
+
+	var txLogs []*evmtypes.Log
+	for _, result := range txResults {
+		txLogs = append(txLogs, parseTxLogs(idx.logger, height, result)...)
+	}
+	logCounts := make(map[common.Hash]int)
+	for _, txLog := range txLogs {
+		logCounts[common.HexToHash(txLog.TxHash)]++
+	}
+
+	dbTx, err := idx.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op once committed
+
+	if err := idx.deleteBlock(dbTx, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	var gasUsed uint64
+	for _, tx := range txs {
+		if err := idx.saveTx(dbTx, tx, logCounts[tx.hash]); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, tx %s", height, tx.hash.Hex())
+		}
+		gasUsed += tx.result.GasUsed
+	}
+
+	for position, txLog := range txLogs {
+		if err := idx.saveLog(dbTx, height, position, txLog); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+
+	_, err = dbTx.Exec(idx.rebind(`INSERT INTO blocks (height, hash, parent_hash, timestamp, proposer, tx_count, gas_used)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		height,
+		common.BytesToHash(block.Hash()).Hex(),
+		common.BytesToHash(block.LastBlockID.Hash).Hex(),
+		block.Time.Unix(),
+		hexutil.Encode(block.ProposerAddress),
+		len(txs),
+		gasUsed,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (idx *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return idx.queryHeight(`SELECT MAX(height) FROM blocks`)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (idx *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return idx.queryHeight(`SELECT MIN(height) FROM blocks`)
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (idx *SQLIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, error) {
+	res, err := idx.queryTxResult(`WHERE t.hash = ?`, hash.Hex())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (idx *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosmosevmtypes.TxResult, error) {
+	res, err := idx.queryTxResult(`WHERE t.height = ? AND t.eth_tx_index = ?`, blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// queryTxResult returns the result of the eth tx matching the where clause.
+func (idx *SQLIndexer) queryTxResult(where string, args ...interface{}) (*cosmosevmtypes.TxResult, error) {
+	query := `SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.status, r.gas_used, r.cumulative_gas_used
+		FROM transactions t JOIN receipts r ON r.tx_hash = t.hash ` + where
+
+	var (
+		res    cosmosevmtypes.TxResult
+		status int
+	)
+	err := idx.db.QueryRow(idx.rebind(query), args...).Scan(
+		&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &status, &res.GasUsed, &res.CumulativeGasUsed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	res.Failed = status != int(ethReceiptStatus(true))
+	return &res, nil
+}
+
+// queryHeight returns the height selected by the query, -1 if there is none.
+func (idx *SQLIndexer) queryHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := idx.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, err
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// deleteBlock deletes the rows of a block.
+func (idx *SQLIndexer) deleteBlock(dbTx *sql.Tx, height int64) error {
+	for _, table := range []string{"erc20_transfers", "logs", "receipts", "transactions", "blocks"} {
+		if _, err := dbTx.Exec(idx.rebind(`DELETE FROM `+table+` WHERE height = ?`), height); err != nil {
+			return errorsmod.Wrapf(err, "delete %s", table)
+		}
+	}
+	return nil
+}
+
+// saveTx inserts an eth tx and its receipt, replacing the ones with the same
+// hash, in case the same tx was included again in a later block.
+func (idx *SQLIndexer) saveTx(dbTx *sql.Tx, tx indexedTx, logCount int) error {
+	txHash := tx.hash.Hex()
+	for _, stmt := range []string{`DELETE FROM receipts WHERE tx_hash = ?`, `DELETE FROM transactions WHERE hash = ?`} {
+		if _, err := dbTx.Exec(idx.rebind(stmt), txHash); err != nil {
+			return err
+		}
+	}
+
+	ethTx := tx.msg.AsTransaction()
+	from := tx.msg.GetSender()
+	var to, contractAddress *string
+	if ethTx.To() != nil {
+		to = hexAddressPtr(*ethTx.To())
+	} else if !tx.result.Failed {
+		contractAddress = hexAddressPtr(crypto.CreateAddress(from, ethTx.Nonce()))
+	}
+
+	_, err := dbTx.Exec(idx.rebind(`INSERT INTO transactions (hash, height, tx_index, msg_index, eth_tx_index, type,
+		from_address, to_address, nonce, value, gas_limit, gas_price, gas_fee_cap, gas_tip_cap, input)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		txHash,
+		tx.result.Height,
+		tx.result.TxIndex,
+		tx.result.MsgIndex,
+		tx.result.EthTxIndex,
+		ethTx.Type(),
+		hexAddress(from),
+		to,
+		ethTx.Nonce(),
+		bigString(ethTx.Value()),
+		ethTx.Gas(),
+		bigString(ethTx.GasPrice()),
+		bigString(ethTx.GasFeeCap()),
+		bigString(ethTx.GasTipCap()),
+		hexutil.Encode(ethTx.Data()),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert transaction")
+	}
+
+	_, err = dbTx.Exec(idx.rebind(`INSERT INTO receipts (tx_hash, height, status, gas_used, cumulative_gas_used, contract_address, log_count)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		txHash,
+		tx.result.Height,
+		ethReceiptStatus(!tx.result.Failed),
+		tx.result.GasUsed,
+		tx.result.CumulativeGasUsed,
+		contractAddress,
+		logCount,
+	)
+	return errorsmod.Wrap(err, "insert receipt")
+}
+
+// saveLog inserts a log at its position in the block, and the ERC20 transfer
+// it represents if any.
+func (idx *SQLIndexer) saveLog(dbTx *sql.Tx, height int64, position int, txLog *evmtypes.Log) error {
+	address := common.HexToAddress(txLog.Address)
+	txHash := common.HexToHash(txLog.TxHash).Hex()
+
+	topics := make([]*string, MaxLogTopics)
+	for i, topic := range txLog.Topics {
+		if i >= MaxLogTopics {
+			break
+		}
+		topicHex := common.HexToHash(topic).Hex()
+		topics[i] = &topicHex
+	}
+
+	_, err := dbTx.Exec(idx.rebind(`INSERT INTO logs (height, log_index, tx_hash, address, topic0, topic1, topic2, topic3, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		height, position, txHash, hexAddress(address), topics[0], topics[1], topics[2], topics[3], hexutil.Encode(txLog.Data),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert log")
+	}
+
+	// ERC721 transfers have the same signature with an indexed token id
+	if len(txLog.Topics) != 3 || common.HexToHash(txLog.Topics[0]) != erc20TransferTopic || len(txLog.Data) != common.HashLength {
+		return nil
+	}
+	_, err = dbTx.Exec(idx.rebind(`INSERT INTO erc20_transfers (height, log_index, tx_hash, token_address, from_address, to_address, value)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		height,
+		position,
+		txHash,
+		hexAddress(address),
+		hexAddress(common.HexToAddress(txLog.Topics[1])),
+		hexAddress(common.HexToAddress(txLog.Topics[2])),
+		new(big.Int).SetBytes(txLog.Data).String(),
+	)
+	return errorsmod.Wrap(err, "insert erc20 transfer")
+}
+
+// rebind replaces the `?` placeholders of a query by the ones of the driver.
+func (idx *SQLIndexer) rebind(query string) string {
+	if idx.driver != SQLDriverPostgres {
+		return query
+	}
+
+	var (
+		sb strings.Builder
+		n  int
+	)
+	for _, r := range query {
+		if r != '?' {
+			sb.WriteRune(r)
+			continue
+		}
+		n++
+		sb.WriteString("$" + strconv.Itoa(n))
+	}
+	return sb.String()
+}
+
+// ethReceiptStatus returns the status of the receipt of an eth tx.
+func ethReceiptStatus(success bool) uint64 {
+	if success {
+		return 1
+	}
+	return 0
+}
+
+func hexAddress(address common.Address) string {
+	return strings.ToLower(address.Hex())
+}
+
+func hexAddressPtr(address common.Address) *string {
+	hex := hexAddress(address)
+	return &hex
+}
+
+func bigString(i *big.Int) string {
+	if i == nil {
+		return "0"
+	}
+	return i.String()
+}
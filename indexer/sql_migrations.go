@@ -0,0 +1,182 @@
+package indexer
+
+import (
+	"database/sql"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// sqlMigration is a schema migration of the SQL indexer. The `{{num}}`
+// placeholder of the statements is replaced by the column type of the driver
+// for the 256 bits numbers.
+type sqlMigration struct {
+	up   []string
+	down []string
+}
+
+// sqlMigrations are the schema migrations of the SQL indexer, the schema
+// version is the number of applied migrations.
+var sqlMigrations = []sqlMigration{
+	{
+		up: []string{
+			`CREATE TABLE blocks (
+				height BIGINT PRIMARY KEY,
+				hash TEXT NOT NULL,
+				parent_hash TEXT NOT NULL,
+				timestamp BIGINT NOT NULL,
+				proposer TEXT NOT NULL,
+				tx_count INTEGER NOT NULL,
+				gas_used BIGINT NOT NULL
+			)`,
+			`CREATE TABLE transactions (
+				hash TEXT PRIMARY KEY,
+				height BIGINT NOT NULL,
+				tx_index INTEGER NOT NULL,
+				msg_index INTEGER NOT NULL,
+				eth_tx_index INTEGER NOT NULL,
+				type INTEGER NOT NULL,
+				from_address TEXT NOT NULL,
+				to_address TEXT,
+				nonce BIGINT NOT NULL,
+				value {{num}} NOT NULL,
+				gas_limit BIGINT NOT NULL,
+				gas_price {{num}} NOT NULL,
+				gas_fee_cap {{num}} NOT NULL,
+				gas_tip_cap {{num}} NOT NULL,
+				input TEXT NOT NULL
+			)`,
+			`CREATE UNIQUE INDEX transactions_height_eth_tx_index ON transactions (height, eth_tx_index)`,
+			`CREATE INDEX transactions_from_address ON transactions (from_address)`,
+			`CREATE INDEX transactions_to_address ON transactions (to_address)`,
+			`CREATE TABLE receipts (
+				tx_hash TEXT PRIMARY KEY,
+				height BIGINT NOT NULL,
+				status INTEGER NOT NULL,
+				gas_used BIGINT NOT NULL,
+				cumulative_gas_used BIGINT NOT NULL,
+				contract_address TEXT,
+				log_count INTEGER NOT NULL
+			)`,
+			`CREATE INDEX receipts_height ON receipts (height)`,
+			`CREATE TABLE logs (
+				height BIGINT NOT NULL,
+				log_index INTEGER NOT NULL,
+				tx_hash TEXT NOT NULL,
+				address TEXT NOT NULL,
+				topic0 TEXT,
+				topic1 TEXT,
+				topic2 TEXT,
+				topic3 TEXT,
+				data TEXT NOT NULL,
+				PRIMARY KEY (height, log_index)
+			)`,
+			`CREATE INDEX logs_tx_hash ON logs (tx_hash)`,
+			`CREATE INDEX logs_address ON logs (address)`,
+			`CREATE INDEX logs_topic0 ON logs (topic0)`,
+			`CREATE TABLE erc20_transfers (
+				height BIGINT NOT NULL,
+				log_index INTEGER NOT NULL,
+				tx_hash TEXT NOT NULL,
+				token_address TEXT NOT NULL,
+				from_address TEXT NOT NULL,
+				to_address TEXT NOT NULL,
+				value {{num}} NOT NULL,
+				PRIMARY KEY (height, log_index)
+			)`,
+			`CREATE INDEX erc20_transfers_token_address ON erc20_transfers (token_address)`,
+			`CREATE INDEX erc20_transfers_from_address ON erc20_transfers (from_address)`,
+			`CREATE INDEX erc20_transfers_to_address ON erc20_transfers (to_address)`,
+		},
+		down: []string{
+			`DROP TABLE erc20_transfers`,
+			`DROP TABLE logs`,
+			`DROP TABLE receipts`,
+			`DROP TABLE transactions`,
+			`DROP TABLE blocks`,
+		},
+	},
+}
+
+// SQLSchemaVersion is the schema version of the SQL indexer of this version.
+var SQLSchemaVersion = len(sqlMigrations)
+
+// SchemaVersion returns the number of migrations applied to the database.
+func (idx *SQLIndexer) SchemaVersion() (int, error) {
+	if err := idx.createMigrationsTable(); err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	if err := idx.db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return 0, errorsmod.Wrap(err, "SchemaVersion")
+	}
+	return int(version.Int64), nil
+}
+
+// MigrateUp applies the pending migrations, each one in its own database
+// transaction. It returns the resulting schema version.
+func (idx *SQLIndexer) MigrateUp() (int, error) {
+	version, err := idx.SchemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	if version > len(sqlMigrations) {
+		return version, errorsmod.Wrapf(ErrUnknownSchemaVersion, "version %d, latest known %d", version, len(sqlMigrations))
+	}
+
+	for ; version < len(sqlMigrations); version++ {
+		err := idx.applyMigration(sqlMigrations[version].up,
+			idx.rebind(`INSERT INTO schema_migrations (version) VALUES (?)`), version+1)
+		if err != nil {
+			return version, errorsmod.Wrapf(err, "migrate up to version %d", version+1)
+		}
+	}
+	return version, nil
+}
+
+// MigrateDown reverts the last applied migration, dropping the data of its
+// tables. It returns the resulting schema version.
+func (idx *SQLIndexer) MigrateDown() (int, error) {
+	version, err := idx.SchemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	if version == 0 {
+		return 0, nil
+	}
+	if version > len(sqlMigrations) {
+		return version, errorsmod.Wrapf(ErrUnknownSchemaVersion, "version %d, latest known %d", version, len(sqlMigrations))
+	}
+
+	err = idx.applyMigration(sqlMigrations[version-1].down,
+		idx.rebind(`DELETE FROM schema_migrations WHERE version = ?`), version)
+	if err != nil {
+		return version, errorsmod.Wrapf(err, "migrate down from version %d", version)
+	}
+	return version - 1, nil
+}
+
+// applyMigration executes the statements of a migration and records the
+// version change in a single database transaction.
+func (idx *SQLIndexer) applyMigration(stmts []string, versionStmt string, version int) error {
+	dbTx, err := idx.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op once committed
+
+	for _, stmt := range stmts {
+		if _, err := dbTx.Exec(strings.ReplaceAll(stmt, "{{num}}", idx.numType)); err != nil {
+			return err
+		}
+	}
+	if _, err := dbTx.Exec(versionStmt, version); err != nil {
+		return err
+	}
+	return dbTx.Commit()
+}
+
+func (idx *SQLIndexer) createMigrationsTable() error {
+	_, err := idx.db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`)
+	return errorsmod.Wrap(err, "create schema_migrations table")
+}
@@ -0,0 +1,81 @@
+package mempool
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// NewCheckTxHandler returns a CheckTx handler that extends the default one
+// with the EVM mempool semantics:
+//   - EVM transactions with a nonce gap pass CheckTx and are queued in the
+//     mempool until the gap is filled,
+//   - EVM transactions with a pending nonce replace the pooled one if they
+//     bump its fees enough,
+//   - replaced EVM transactions fail on recheck so that CometBFT evicts them.
+//
+// The ante handler is expected to return evmtypes.ErrNonceGap and evmtypes.ErrNonceLow on nonce
+// mismatches, after all its other checks. The sender balance is checked against all its pooled
+// transactions with the check state context returned by getCtx.
+func NewCheckTxHandler(mp *EVMMempool, txDecoder sdk.TxDecoder, getCtx func(txBytes []byte) sdk.Context, trace bool) sdk.CheckTxHandler {
+	return func(runTx sdk.RunTx, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+		tx, err := txDecoder(req.Tx)
+		if err != nil {
+			return errortypes.ResponseCheckTxWithEvents(err, 0, 0, nil, trace), nil
+		}
+
+		if req.Type == abci.CheckTxType_Recheck && mp.IsReplaced(tx) {
+			return errortypes.ResponseCheckTxWithEvents(ErrTxReplaced, 0, 0, nil, trace), nil
+		}
+
+		gInfo, result, anteEvents, err := runTx(req.Tx, tx)
+		switch {
+		case err == nil:
+			return &abci.ResponseCheckTx{
+				GasWanted: int64(gInfo.GasWanted), //nolint:gosec // G115 // gas is bounded by the block gas limit
+				GasUsed:   int64(gInfo.GasUsed),   //nolint:gosec // G115 // gas is bounded by the block gas limit
+				Log:       result.Log,
+				Data:      result.Data,
+				Events:    result.Events,
+			}, nil
+		case wraps(err, evmtypes.ErrNonceGap):
+			err = mp.QueueTx(getCtx(req.Tx), tx)
+		case wraps(err, evmtypes.ErrNonceLow) && req.Type == abci.CheckTxType_New:
+			if replaceErr := mp.ReplaceTx(getCtx(req.Tx), tx); !errors.Is(replaceErr, sdkmempool.ErrTxNotFound) {
+				err = replaceErr
+			}
+		}
+		if err != nil {
+			return errortypes.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, trace), nil
+		}
+
+		// the gas wanted is only reported by runTx if the ante handler succeeds
+		gasWanted := gInfo.GasWanted
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			gasWanted = feeTx.GetGas()
+		}
+
+		return &abci.ResponseCheckTx{
+			GasWanted: int64(gasWanted),     //nolint:gosec // G115 // gas is bounded by the block gas limit
+			GasUsed:   int64(gInfo.GasUsed), //nolint:gosec // G115 // gas is bounded by the block gas limit
+		}, nil
+	}
+}
+
+// wraps returns true if target is in the wrapping chain of err. Unlike
+// errors.Is, it does not match other errors wrapping the same registered
+// error, such as evmtypes.ErrNonceGap and evmtypes.ErrNonceLow.
+func wraps(err, target error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if err == target { //nolint:errorlint // identity comparison is intended
+			return true
+		}
+	}
+	return false
+}
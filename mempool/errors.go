@@ -0,0 +1,29 @@
+package mempool
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrReplaceUnderpriced is returned when a tx replaces another one with the
+	// same nonce without bumping its fees enough.
+	ErrReplaceUnderpriced = errorsmod.Wrap(errortypes.ErrInsufficientFee, "replacement transaction underpriced")
+
+	// ErrTxReplaced is returned on recheck for a tx that was replaced in the
+	// mempool by another one with the same sender and nonce.
+	ErrTxReplaced = errorsmod.Wrap(errortypes.ErrInvalidRequest, "transaction replaced by a higher priced one")
+
+	// ErrAccountSlotsFull is returned when a sender has too many transactions
+	// in the mempool.
+	ErrAccountSlotsFull = errorsmod.Wrap(errortypes.ErrMempoolIsFull, "account has too many transactions in the mempool")
+
+	// ErrInsufficientFunds is returned when the sender balance doesn't cover
+	// the cost of all its transactions in the mempool.
+	ErrInsufficientFunds = errorsmod.Wrap(errortypes.ErrInsufficientFunds, "insufficient funds for the pooled transactions of the sender")
+
+	// ErrUnderpriced is returned when the mempool is full and the tx does not
+	// pay more than the cheapest one that could be evicted.
+	ErrUnderpriced = errorsmod.Wrap(errortypes.ErrMempoolIsFull, "transaction underpriced for a full mempool")
+)
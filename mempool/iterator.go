@@ -0,0 +1,159 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"math/big"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = &iterator{}
+
+// senderTxs holds the executable transactions of a sender, ordered by nonce,
+// along with the effective tip and priority of the first one.
+type senderTxs struct {
+	txs      []*evmTx
+	tip      *big.Int
+	priority int64
+}
+
+// setHead updates the effective tip and priority given the first transaction.
+func (s *senderTxs) setHead(baseFee *big.Int) {
+	head := s.txs[0].txData
+	s.tip = head.EffectiveGasPrice(baseFee)
+	if baseFee != nil {
+		s.tip = new(big.Int).Sub(s.tip, baseFee)
+	}
+	s.priority = evmtypes.GetTxPriority(head, baseFee)
+}
+
+// txHeap is a max-heap of senders ordered by the effective tip of their next
+// executable transaction, breaking ties by arrival order.
+type txHeap []*senderTxs
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	if c := h[i].tip.Cmp(h[j].tip); c != 0 {
+		return c > 0
+	}
+	return h[i].txs[0].seq < h[j].txs[0].seq
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x any) { *h = append(*h, x.(*senderTxs)) }
+
+func (h *txHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// iterator merges the executable EVM transactions with the Cosmos ones,
+// yielding the highest priority transaction first.
+type iterator struct {
+	baseFee *big.Int
+
+	evmTxs *txHeap
+
+	cosmosIter     sdkmempool.Iterator
+	cosmosPriority func(sdk.Tx) int64
+
+	tx      sdk.Tx
+	fromEVM bool
+}
+
+// newIterator builds an iterator over the executable transactions given the
+// state of the context. Stale EVM transactions, whose nonce is lower than the
+// account nonce, are removed from the mempool. It must be called with the lock
+// held.
+func (m *EVMMempool) newIterator(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	baseFee := m.vmKeeper.GetBaseFee(sdkCtx)
+	m.removeStaleTxs(sdkCtx)
+
+	evmTxs := &txHeap{}
+	for sender, pooled := range m.senders {
+		nonce := m.vmKeeper.GetNonce(sdkCtx, sender)
+		var executable []*evmTx
+		for etx, ok := pooled[nonce]; ok; etx, ok = pooled[nonce] {
+			// skip the remaining txs of the sender until the base fee decreases
+			if baseFee != nil && etx.txData.GetGasFeeCap().Cmp(baseFee) < 0 {
+				break
+			}
+			executable = append(executable, etx)
+			nonce++
+		}
+
+		if len(executable) > 0 {
+			head := &senderTxs{txs: executable}
+			head.setHead(baseFee)
+			*evmTxs = append(*evmTxs, head)
+		}
+	}
+	heap.Init(evmTxs)
+
+	it := &iterator{
+		baseFee:    baseFee,
+		evmTxs:     evmTxs,
+		cosmosIter: m.cosmosPool.Select(ctx, txs),
+		cosmosPriority: func(tx sdk.Tx) int64 {
+			key, err := m.cosmosTxKey(tx)
+			if err != nil {
+				return 0
+			}
+			return m.cosmosPriorities[key]
+		},
+	}
+	return it.pick()
+}
+
+// Tx returns the transaction at the current position of the iterator.
+func (i *iterator) Tx() sdk.Tx {
+	return i.tx
+}
+
+// Next returns the iterator positioned at the next transaction, or nil if
+// there are no more transactions.
+func (i *iterator) Next() sdkmempool.Iterator {
+	if !i.fromEVM {
+		i.cosmosIter = i.cosmosIter.Next()
+		return i.pick()
+	}
+
+	head := (*i.evmTxs)[0]
+	head.txs = head.txs[1:]
+	if len(head.txs) == 0 {
+		heap.Pop(i.evmTxs)
+	} else {
+		head.setHead(i.baseFee)
+		heap.Fix(i.evmTxs, 0)
+	}
+	return i.pick()
+}
+
+// pick positions the iterator on the highest priority transaction among the
+// next EVM and Cosmos ones. EVM transactions win ties.
+func (i *iterator) pick() sdkmempool.Iterator {
+	hasEVM := i.evmTxs.Len() > 0
+	hasCosmos := i.cosmosIter != nil
+
+	switch {
+	case !hasEVM && !hasCosmos:
+		return nil
+	case hasEVM && (!hasCosmos || (*i.evmTxs)[0].priority >= i.cosmosPriority(i.cosmosIter.Tx())):
+		i.tx = (*i.evmTxs)[0].txs[0].tx
+		i.fromEVM = true
+	default:
+		i.tx = i.cosmosIter.Tx()
+		i.fromEVM = false
+	}
+	return i
+}
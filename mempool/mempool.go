@@ -0,0 +1,503 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.ExtMempool = &EVMMempool{}
+
+// VMKeeper defines the EVM keeper methods required by the mempool.
+type VMKeeper interface {
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+	GetBaseFee(ctx sdk.Context) *big.Int
+	GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int
+}
+
+// Config defines the limits and replacement rules of the EVMMempool.
+type Config struct {
+	// PriceBump is the minimum fee increase, in percent, required to replace
+	// an EVM transaction with the same sender and nonce.
+	PriceBump uint64
+	// AccountSlots is the maximum number of EVM transactions kept per sender.
+	AccountSlots int
+	// MaxTxs is the maximum number of EVM transactions kept in the mempool.
+	// Once reached, new transactions evict the cheapest ones.
+	MaxTxs int
+	// MaxCosmosTxs is the maximum number of Cosmos transactions kept in the
+	// mempool, with the semantics of the SDK PriorityNonceMempool.
+	MaxCosmosTxs int
+}
+
+// DefaultConfig returns the default mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriceBump:    10,
+		AccountSlots: 64,
+		MaxTxs:       5000,
+		MaxCosmosTxs: 5000,
+	}
+}
+
+// evmTx is an Ethereum transaction held by the mempool.
+type evmTx struct {
+	tx     sdk.Tx
+	txData evmtypes.TxData
+	hash   common.Hash
+	sender common.Address
+	nonce  uint64
+	// seq is the arrival order, used to break priority ties
+	seq uint64
+}
+
+// cosmosTxKey identifies a Cosmos transaction by its first signer and nonce,
+// as the PriorityNonceMempool does.
+type cosmosTxKey struct {
+	signer string
+	nonce  uint64
+}
+
+// EVMMempool is an application-side mempool aware of Ethereum transactions.
+// EVM transactions are kept per sender and nonce, which allows:
+//   - queueing transactions with a future nonce until the gap is filled,
+//   - replacing a transaction by resubmitting the same nonce with a fee bump,
+//   - evicting the cheapest transactions once the mempool is full.
+//
+// Cosmos transactions are delegated to the SDK PriorityNonceMempool. Block
+// proposals interleave both kinds of transactions by priority, where the
+// priority of EVM transactions is given by their effective tip.
+type EVMMempool struct {
+	mtx sync.Mutex
+
+	config   Config
+	vmKeeper VMKeeper
+
+	cosmosPool       *sdkmempool.PriorityNonceMempool[int64]
+	cosmosPriorities map[cosmosTxKey]int64
+	signerExtractor  sdkmempool.SignerExtractionAdapter
+
+	senders map[common.Address]map[uint64]*evmTx
+	txs     map[common.Hash]*evmTx
+	seq     uint64
+}
+
+// NewEVMMempool creates a new EVMMempool.
+func NewEVMMempool(vmKeeper VMKeeper, config Config) *EVMMempool {
+	cosmosConfig := sdkmempool.DefaultPriorityNonceMempoolConfig()
+	cosmosConfig.MaxTx = config.MaxCosmosTxs
+
+	return &EVMMempool{
+		config:           config,
+		vmKeeper:         vmKeeper,
+		cosmosPool:       sdkmempool.NewPriorityMempool(cosmosConfig),
+		cosmosPriorities: make(map[cosmosTxKey]int64),
+		signerExtractor:  sdkmempool.NewDefaultSignerExtractionAdapter(),
+		senders:          make(map[common.Address]map[uint64]*evmTx),
+		txs:              make(map[common.Hash]*evmTx),
+	}
+}
+
+// Insert adds a transaction to the mempool. EVM transactions are stored
+// regardless of their nonce, so that the ones with a future nonce are queued.
+func (m *EVMMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx != nil {
+		return m.insertEVMTx(etx)
+	}
+
+	if err := m.cosmosPool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	key, err := m.cosmosTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	// a tx replacing a pooled one with the same signer and nonce has the same
+	// key, so the priority of the replaced tx is overwritten
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.cosmosPriorities[key] = sdk.UnwrapSDKContext(ctx).Priority()
+	return nil
+}
+
+// Remove removes a transaction from the mempool.
+func (m *EVMMempool) Remove(tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if etx != nil {
+		pooled, ok := m.txs[etx.hash]
+		if !ok {
+			return sdkmempool.ErrTxNotFound
+		}
+		m.removeEVMTx(pooled)
+		return nil
+	}
+
+	// the priority is dropped even if the tx is not in the Cosmos pool, which
+	// doesn't store the txs it receives when it is disabled
+	if key, err := m.cosmosTxKey(tx); err == nil {
+		delete(m.cosmosPriorities, key)
+	}
+	return m.cosmosPool.Remove(tx)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (m *EVMMempool) CountTx() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return len(m.txs) + m.cosmosPool.CountTx()
+}
+
+// Select returns an iterator over the executable transactions of the mempool
+// ordered by priority. EVM transactions are executable when their nonce
+// follows the account nonce with no gaps and their fee cap covers the base fee.
+func (m *EVMMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.newIterator(ctx, txs)
+}
+
+// SelectBy iterates over the executable transactions of the mempool in the
+// same order as Select, until the callback returns false.
+func (m *EVMMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for iter := m.newIterator(ctx, txs); iter != nil && callback(iter.Tx()); {
+		iter = iter.Next()
+	}
+}
+
+// RemoveStaleTxs removes the EVM transactions whose nonce is lower than the
+// account nonce of their sender, which are already executed. It is meant to be
+// run with the check state once a block is committed, so that the executed
+// transactions don't count against the mempool limits until the node builds a
+// proposal.
+func (m *EVMMempool) RemoveStaleTxs(ctx sdk.Context) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.removeStaleTxs(ctx)
+}
+
+// IsReplaced returns true if the given EVM transaction has been replaced in
+// the mempool by another one with the same sender and nonce.
+func (m *EVMMempool) IsReplaced(tx sdk.Tx) bool {
+	etx, err := newEVMTx(tx)
+	if err != nil || etx == nil {
+		return false
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	pooled, ok := m.senders[etx.sender][etx.nonce]
+	return ok && pooled.hash != etx.hash
+}
+
+// Content returns the pooled EVM transactions grouped by sender and sorted
+// by nonce. The transactions of a sender following its account nonce with no
+// gap are pending, the ones after the first gap are queued. Transactions with
+// a nonce lower than the account nonce are already executed and skipped.
+func (m *EVMMempool) Content(ctx sdk.Context) (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	pending = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	queued = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for sender, senderTxs := range m.senders {
+		nonces := make([]uint64, 0, len(senderTxs))
+		for nonce := range senderTxs {
+			nonces = append(nonces, nonce)
+		}
+		slices.Sort(nonces)
+
+		next := m.vmKeeper.GetNonce(ctx, sender)
+		gapped := false
+		for _, nonce := range nonces {
+			if nonce < next {
+				continue
+			}
+			msg := senderTxs[nonce].tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+			if !gapped && nonce == next {
+				pending[sender] = append(pending[sender], msg)
+				next++
+				continue
+			}
+			gapped = true
+			queued[sender] = append(queued[sender], msg)
+		}
+	}
+	return pending, queued
+}
+
+// QueueTx adds an EVM transaction with a future nonce to the mempool. It
+// fails if the sender balance doesn't cover the cost of all its pooled
+// transactions along with the queued one.
+func (m *EVMMempool) QueueTx(ctx sdk.Context, tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidType, "expected an Ethereum transaction, got %T", tx)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if err := m.checkSenderBalance(ctx, etx); err != nil {
+		return err
+	}
+	return m.addEVMTx(etx)
+}
+
+// ReplaceTx replaces a pooled EVM transaction with the same sender and nonce
+// as the given one. It fails if no such transaction is in the mempool, or if
+// the sender balance doesn't cover the cost of all its pooled transactions
+// with the replacement.
+func (m *EVMMempool) ReplaceTx(ctx sdk.Context, tx sdk.Tx) error {
+	etx, err := newEVMTx(tx)
+	if err != nil {
+		return err
+	}
+	if etx == nil {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if _, ok := m.senders[etx.sender][etx.nonce]; !ok {
+		return sdkmempool.ErrTxNotFound
+	}
+	if err := m.checkSenderBalance(ctx, etx); err != nil {
+		return err
+	}
+	return m.addEVMTx(etx)
+}
+
+// checkSenderBalance checks that the sender balance covers the cost of its
+// pooled transactions that are not yet executed along with the given one,
+// which takes the place of the pooled one with the same nonce if any. It must
+// be called with the lock held.
+func (m *EVMMempool) checkSenderBalance(ctx sdk.Context, etx *evmTx) error {
+	nonce := m.vmKeeper.GetNonce(ctx, etx.sender)
+
+	cost := etx.cost()
+	for _, pooled := range m.senders[etx.sender] {
+		if pooled.nonce < nonce || pooled.nonce == etx.nonce {
+			continue
+		}
+		cost.Add(cost, pooled.cost())
+	}
+
+	balance := m.vmKeeper.GetBalance(ctx, etx.sender).ToBig()
+	if cost.Cmp(balance) > 0 {
+		return errorsmod.Wrapf(ErrInsufficientFunds, "balance %s, cost %s", balance, cost)
+	}
+	return nil
+}
+
+// insertEVMTx adds an EVM transaction to the mempool.
+func (m *EVMMempool) insertEVMTx(etx *evmTx) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.addEVMTx(etx)
+}
+
+// addEVMTx adds an EVM transaction to the mempool, replacing any other
+// transaction with the same sender and nonce if the fee bump is enough. It
+// must be called with the lock held.
+func (m *EVMMempool) addEVMTx(etx *evmTx) error {
+	if _, ok := m.txs[etx.hash]; ok {
+		return nil
+	}
+
+	senderTxs := m.senders[etx.sender]
+	if old, ok := senderTxs[etx.nonce]; ok {
+		if !m.isPriceBumped(old.txData, etx.txData) {
+			return errorsmod.Wrapf(
+				ErrReplaceUnderpriced,
+				"fee cap and tip cap must be bumped by at least %d%%", m.config.PriceBump,
+			)
+		}
+		m.removeEVMTx(old)
+	} else {
+		if m.config.AccountSlots > 0 && len(senderTxs) >= m.config.AccountSlots {
+			return errorsmod.Wrapf(ErrAccountSlotsFull, "sender %s, limit %d", etx.sender, m.config.AccountSlots)
+		}
+		if m.config.MaxTxs > 0 && len(m.txs) >= m.config.MaxTxs {
+			if err := m.evictCheaperThan(etx); err != nil {
+				return err
+			}
+		}
+	}
+
+	m.seq++
+	etx.seq = m.seq
+	if m.senders[etx.sender] == nil {
+		m.senders[etx.sender] = make(map[uint64]*evmTx)
+	}
+	m.senders[etx.sender][etx.nonce] = etx
+	m.txs[etx.hash] = etx
+	return nil
+}
+
+// removeEVMTx removes a pooled EVM transaction. It must be called with the
+// lock held.
+func (m *EVMMempool) removeEVMTx(etx *evmTx) {
+	delete(m.txs, etx.hash)
+
+	senderTxs := m.senders[etx.sender]
+	delete(senderTxs, etx.nonce)
+	if len(senderTxs) == 0 {
+		delete(m.senders, etx.sender)
+	}
+}
+
+// removeStaleTxs removes the EVM transactions whose nonce is lower than the
+// account nonce of their sender. It must be called with the lock held.
+func (m *EVMMempool) removeStaleTxs(ctx sdk.Context) {
+	for sender, pooled := range m.senders {
+		nonce := m.vmKeeper.GetNonce(ctx, sender)
+		for _, etx := range pooled {
+			if etx.nonce < nonce {
+				m.removeEVMTx(etx)
+			}
+		}
+	}
+}
+
+// evictCheaperThan evicts the cheapest transaction that is the last one of its
+// sender, so that no nonce gaps are introduced. It fails if that transaction
+// is not cheaper than the incoming one. It must be called with the lock held.
+func (m *EVMMempool) evictCheaperThan(etx *evmTx) error {
+	var cheapest *evmTx
+	for _, senderTxs := range m.senders {
+		var last *evmTx
+		for _, tx := range senderTxs {
+			if last == nil || tx.nonce > last.nonce {
+				last = tx
+			}
+		}
+		if cheapest == nil || compareFees(last.txData, cheapest.txData) < 0 {
+			cheapest = last
+		}
+	}
+
+	if cheapest == nil || compareFees(cheapest.txData, etx.txData) >= 0 {
+		return ErrUnderpriced
+	}
+
+	m.removeEVMTx(cheapest)
+	return nil
+}
+
+// isPriceBumped returns true if both the fee cap and the tip cap of the new
+// transaction exceed the old ones by at least the configured price bump.
+func (m *EVMMempool) isPriceBumped(old, replacement evmtypes.TxData) bool {
+	bump := new(big.Int).SetUint64(100 + m.config.PriceBump)
+	hundred := big.NewInt(100)
+
+	minFeeCap := new(big.Int).Mul(old.GetGasFeeCap(), bump)
+	minFeeCap.Quo(minFeeCap, hundred)
+	minTipCap := new(big.Int).Mul(old.GetGasTipCap(), bump)
+	minTipCap.Quo(minTipCap, hundred)
+
+	return replacement.GetGasFeeCap().Cmp(minFeeCap) >= 0 &&
+		replacement.GetGasTipCap().Cmp(minTipCap) >= 0
+}
+
+// cosmosTxKey returns the key of a Cosmos transaction in the priorities map,
+// which is the key of the transaction in the PriorityNonceMempool, so that both
+// hold the same transactions. Unordered transactions are keyed by their
+// timeout instead of their sequence.
+func (m *EVMMempool) cosmosTxKey(tx sdk.Tx) (cosmosTxKey, error) {
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return cosmosTxKey{}, err
+	}
+	if len(signers) == 0 {
+		return cosmosTxKey{}, nil
+	}
+	nonce, err := sdkmempool.ChooseNonce(signers[0].Sequence, tx)
+	if err != nil {
+		return cosmosTxKey{}, err
+	}
+	return cosmosTxKey{signer: signers[0].Signer.String(), nonce: nonce}, nil
+}
+
+// newEVMTx returns the pooled representation of the transaction if it wraps
+// an Ethereum transaction, or nil otherwise.
+func newEVMTx(tx sdk.Tx) (*evmTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, nil
+	}
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); !ok {
+		return nil, nil
+	}
+
+	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &evmTx{
+		tx:     tx,
+		txData: txData,
+		hash:   ethMsg.AsTransaction().Hash(),
+		sender: ethMsg.GetSender(),
+		nonce:  txData.GetNonce(),
+	}, nil
+}
+
+// cost returns the amount of EVM coins the transaction can spend from the
+// sender balance, which is its value along with its fees unless they are paid
+// by a fee granter or in a fee token.
+func (etx *evmTx) cost() *big.Int {
+	// the access list was validated by the ante handler
+	accessList := etx.txData.GetAccessList()
+	feeGranter, _ := evmtypes.GetFeeGranter(accessList)
+	_, feeToken, _ := evmtypes.GetFeeTokenStorageKey(accessList)
+	if feeGranter != nil || feeToken {
+		return new(big.Int).Set(etx.txData.GetValue())
+	}
+	return new(big.Int).Set(etx.txData.Cost())
+}
+
+// compareFees compares two transactions by fee cap and then by tip cap.
+func compareFees(a, b evmtypes.TxData) int {
+	if c := a.GetGasFeeCap().Cmp(b.GetGasFeeCap()); c != 0 {
+		return c
+	}
+	return a.GetGasTipCap().Cmp(b.GetGasTipCap())
+}
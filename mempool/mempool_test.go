@@ -0,0 +1,341 @@
+package mempool_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/testutil/config"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockVMKeeper struct {
+	nonces   map[common.Address]uint64
+	baseFee  *big.Int
+	balances map[common.Address]*uint256.Int
+}
+
+func (k *mockVMKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 { return k.nonces[addr] }
+func (k *mockVMKeeper) GetBaseFee(_ sdk.Context) *big.Int                  { return k.baseFee }
+
+// GetBalance returns the balance set for the account, or a balance covering
+// any tx if none is set.
+func (k *mockVMKeeper) GetBalance(_ sdk.Context, addr common.Address) *uint256.Int {
+	if balance, ok := k.balances[addr]; ok {
+		return balance
+	}
+	return uint256.NewInt(1e18)
+}
+
+func newTxConfig(t *testing.T) sdkclient.TxConfig {
+	t.Helper()
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	encodingConfig := encoding.MakeConfig(chainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return encodingConfig.TxConfig
+}
+
+func newEthTx(t *testing.T, txConfig sdkclient.TxConfig, from common.Address, nonce uint64, gasPrice int64) sdk.Tx {
+	t.Helper()
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		Nonce:    nonce,
+		GasLimit: 21000,
+		GasPrice: big.NewInt(gasPrice),
+		To:       &common.Address{},
+	})
+	msg.From = from.Bytes()
+
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	require.NoError(t, err)
+	return tx
+}
+
+func newCosmosTx(t *testing.T, txConfig sdkclient.TxConfig, priv *secp256k1.PrivKey, sequence uint64, amount int64) sdk.Tx {
+	t.Helper()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))))
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	return builder.GetTx()
+}
+
+func selectTxs(mp *mempool.EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for iter := mp.Select(sdk.Context{}, nil); iter != nil; iter = iter.Next() {
+		txs = append(txs, iter.Tx())
+	}
+	return txs
+}
+
+func TestEVMMempoolNonceGap(t *testing.T) {
+	txConfig := newTxConfig(t)
+	sender := utiltx.GenerateAddress()
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{sender: 0}}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	tx1 := newEthTx(t, txConfig, sender, 1, 100)
+	require.NoError(t, mp.Insert(sdk.Context{}, tx1))
+	require.Equal(t, 1, mp.CountTx())
+	require.Empty(t, selectTxs(mp), "queued tx should not be selected")
+
+	tx0 := newEthTx(t, txConfig, sender, 0, 100)
+	require.NoError(t, mp.Insert(sdk.Context{}, tx0))
+	require.Equal(t, []sdk.Tx{tx0, tx1}, selectTxs(mp))
+
+	// once the first tx is included, it becomes stale and is pruned
+	keeper.nonces[sender] = 1
+	require.Equal(t, []sdk.Tx{tx1}, selectTxs(mp))
+	require.Equal(t, 1, mp.CountTx())
+
+	require.NoError(t, mp.Remove(tx1))
+	require.ErrorIs(t, mp.Remove(tx1), sdkmempool.ErrTxNotFound)
+	require.Zero(t, mp.CountTx())
+}
+
+func TestEVMMempoolRemoveStaleTxs(t *testing.T) {
+	txConfig := newTxConfig(t)
+	sender, other := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	for nonce := range uint64(3) {
+		require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, sender, nonce, 100)))
+	}
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, other, 0, 100)))
+
+	// the executed txs are removed once committed, without building a proposal
+	keeper.nonces[sender] = 2
+	keeper.nonces[other] = 1
+	mp.RemoveStaleTxs(sdk.Context{})
+	require.Equal(t, 1, mp.CountTx())
+	pending, queued := mp.Content(sdk.Context{})
+	require.Len(t, pending[sender], 1)
+	require.Empty(t, pending[other])
+	require.Empty(t, queued)
+}
+
+func TestEVMMempoolReplacement(t *testing.T) {
+	txConfig := newTxConfig(t)
+	sender := utiltx.GenerateAddress()
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	original := newEthTx(t, txConfig, sender, 0, 100)
+	require.NoError(t, mp.Insert(sdk.Context{}, original))
+
+	underpriced := newEthTx(t, txConfig, sender, 0, 109)
+	require.ErrorIs(t, mp.ReplaceTx(sdk.Context{}, underpriced), mempool.ErrReplaceUnderpriced)
+	require.False(t, mp.IsReplaced(original))
+
+	replacement := newEthTx(t, txConfig, sender, 0, 110)
+	require.NoError(t, mp.ReplaceTx(sdk.Context{}, replacement))
+	require.True(t, mp.IsReplaced(original))
+	require.False(t, mp.IsReplaced(replacement))
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+
+	require.ErrorIs(t, mp.ReplaceTx(sdk.Context{}, newEthTx(t, txConfig, sender, 1, 200)), sdkmempool.ErrTxNotFound)
+}
+
+func TestEVMMempoolContent(t *testing.T) {
+	txConfig := newTxConfig(t)
+	sender := utiltx.GenerateAddress()
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{sender: 1}}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	for _, nonce := range []uint64{5, 0, 2, 1, 4} {
+		require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, sender, nonce, 100)))
+	}
+	replacement := newEthTx(t, txConfig, sender, 2, 200)
+	require.NoError(t, mp.ReplaceTx(sdk.Context{}, replacement))
+
+	nonces := func(msgs []*evmtypes.MsgEthereumTx) []uint64 {
+		var nonces []uint64
+		for _, msg := range msgs {
+			nonces = append(nonces, msg.AsTransaction().Nonce())
+		}
+		return nonces
+	}
+
+	// the stale tx is skipped and the txs after the gap are queued
+	pending, queued := mp.Content(sdk.Context{})
+	require.Equal(t, []uint64{1, 2}, nonces(pending[sender]))
+	require.Equal(t, []uint64{4, 5}, nonces(queued[sender]))
+	require.Equal(t, replacement.GetMsgs()[0], pending[sender][1])
+
+	// once the gap is filled, all the txs are pending
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, sender, 3, 100)))
+	pending, queued = mp.Content(sdk.Context{})
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, nonces(pending[sender]))
+	require.Empty(t, queued)
+}
+
+func TestEVMMempoolSenderBalance(t *testing.T) {
+	txConfig := newTxConfig(t)
+	sender := utiltx.GenerateAddress()
+	// the balance covers two txs of 21000 gas at a gas price of 100
+	keeper := &mockVMKeeper{
+		nonces:   map[common.Address]uint64{sender: 0},
+		balances: map[common.Address]*uint256.Int{sender: uint256.NewInt(2 * 21000 * 100)},
+	}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	require.NoError(t, mp.QueueTx(sdk.Context{}, newEthTx(t, txConfig, sender, 1, 100)))
+	require.NoError(t, mp.QueueTx(sdk.Context{}, newEthTx(t, txConfig, sender, 2, 100)))
+	require.ErrorIs(t, mp.QueueTx(sdk.Context{}, newEthTx(t, txConfig, sender, 3, 100)), mempool.ErrInsufficientFunds)
+
+	// the replaced tx is not counted, but the replacement costs more
+	require.ErrorIs(t, mp.ReplaceTx(sdk.Context{}, newEthTx(t, txConfig, sender, 2, 110)), mempool.ErrInsufficientFunds)
+
+	// the executed txs are not counted
+	keeper.nonces[sender] = 2
+	require.NoError(t, mp.QueueTx(sdk.Context{}, newEthTx(t, txConfig, sender, 3, 100)))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestEVMMempoolOrdering(t *testing.T) {
+	txConfig := newTxConfig(t)
+	cheap, pricey, underpriced := utiltx.GenerateAddress(), utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}, baseFee: big.NewInt(50)}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	cheap0 := newEthTx(t, txConfig, cheap, 0, 100)
+	cheap1 := newEthTx(t, txConfig, cheap, 1, 300)
+	pricey0 := newEthTx(t, txConfig, pricey, 0, 200)
+	underpriced0 := newEthTx(t, txConfig, underpriced, 0, 40)
+	for _, tx := range []sdk.Tx{cheap0, cheap1, pricey0, underpriced0} {
+		require.NoError(t, mp.Insert(sdk.Context{}, tx))
+	}
+
+	// txs are ordered by effective tip while respecting the sender nonces, and
+	// txs with a fee cap below the base fee are skipped
+	require.Equal(t, []sdk.Tx{pricey0, cheap0, cheap1}, selectTxs(mp))
+}
+
+func TestEVMMempoolEviction(t *testing.T) {
+	txConfig := newTxConfig(t)
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	cfg := mempool.DefaultConfig()
+	cfg.MaxTxs = 2
+	cfg.AccountSlots = 1
+	mp := mempool.NewEVMMempool(keeper, cfg)
+
+	sender := utiltx.GenerateAddress()
+	require.NoError(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, sender, 0, 100)))
+	require.ErrorIs(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, sender, 1, 100)), mempool.ErrAccountSlotsFull)
+
+	mid := newEthTx(t, txConfig, utiltx.GenerateAddress(), 0, 200)
+	require.NoError(t, mp.Insert(sdk.Context{}, mid))
+
+	require.ErrorIs(t, mp.Insert(sdk.Context{}, newEthTx(t, txConfig, utiltx.GenerateAddress(), 0, 50)), mempool.ErrUnderpriced)
+
+	high := newEthTx(t, txConfig, utiltx.GenerateAddress(), 0, 300)
+	require.NoError(t, mp.Insert(sdk.Context{}, high))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{high, mid}, selectTxs(mp))
+}
+
+func TestEVMMempoolCosmosTxs(t *testing.T) {
+	txConfig := newTxConfig(t)
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+
+	ethTx := newEthTx(t, txConfig, utiltx.GenerateAddress(), 0, 100)
+	require.NoError(t, mp.Insert(sdk.Context{}, ethTx))
+
+	priv := secp256k1.GenPrivKey()
+	cosmosTx := newCosmosTx(t, txConfig, priv, 0, 1)
+	require.NoError(t, mp.Insert(sdk.Context{}.WithPriority(math.MaxInt64), cosmosTx))
+	require.Equal(t, []sdk.Tx{cosmosTx, ethTx}, selectTxs(mp))
+
+	// the replacement takes the priority of the replaced tx
+	replacement := newCosmosTx(t, txConfig, priv, 0, 2)
+	require.NoError(t, mp.Insert(sdk.Context{}.WithPriority(0), replacement))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{ethTx, replacement}, selectTxs(mp))
+
+	require.NoError(t, mp.Remove(replacement))
+	require.ErrorIs(t, mp.Remove(cosmosTx), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{ethTx}, selectTxs(mp))
+}
+
+func TestCheckTxHandler(t *testing.T) {
+	txConfig := newTxConfig(t)
+	sender := utiltx.GenerateAddress()
+	keeper := &mockVMKeeper{nonces: map[common.Address]uint64{}}
+	mp := mempool.NewEVMMempool(keeper, mempool.DefaultConfig())
+	getCtx := func([]byte) sdk.Context { return sdk.Context{} }
+	handler := mempool.NewCheckTxHandler(mp, txConfig.TxDecoder(), getCtx, false)
+
+	encode := func(tx sdk.Tx) []byte {
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	failingRunTx := func(err error) sdk.RunTx {
+		return func(_ []byte, _ sdk.Tx) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+			return sdk.GasInfo{GasWanted: 21000}, nil, nil, err
+		}
+	}
+
+	// a tx with a nonce gap is queued
+	queued := newEthTx(t, txConfig, sender, 1, 100)
+	res, err := handler(
+		failingRunTx(errorsmod.Wrap(evmtypes.ErrNonceGap, "invalid nonce")),
+		&abci.RequestCheckTx{Tx: encode(queued), Type: abci.CheckTxType_New},
+	)
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(21000), res.GasWanted)
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx with a low nonce is rejected unless it replaces a pooled one
+	res, err = handler(
+		failingRunTx(errorsmod.Wrap(evmtypes.ErrNonceLow, "invalid nonce")),
+		&abci.RequestCheckTx{Tx: encode(newEthTx(t, txConfig, sender, 0, 100)), Type: abci.CheckTxType_New},
+	)
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+
+	replacement := newEthTx(t, txConfig, sender, 1, 200)
+	res, err = handler(
+		failingRunTx(errorsmod.Wrap(evmtypes.ErrNonceLow, "invalid nonce")),
+		&abci.RequestCheckTx{Tx: encode(replacement), Type: abci.CheckTxType_New},
+	)
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, mp.IsReplaced(queued))
+
+	// the replaced tx fails on recheck without running the ante handler
+	res, err = handler(
+		func(_ []byte, _ sdk.Tx) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+			t.Fatal("runTx should not be called for a replaced tx")
+			return sdk.GasInfo{}, nil, nil, nil
+		},
+		&abci.RequestCheckTx{Tx: encode(queued), Type: abci.CheckTxType_Recheck},
+	)
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev StakeAuthorizationType defines the type of staking message a
+/// StakeAuthorization grants, as in the x/staking AuthorizationType enum.
+enum StakeAuthorizationType {
+    Unspecified,
+    Delegate,
+    Undelegate,
+    Redelegate,
+    CancelUnbondingDelegation
+}
+
+/// @dev StakeAuthorization defines an authorization to delegate, undelegate
+/// or redelegate tokens on behalf of the granter.
+struct StakeAuthorization {
+    /// authorizationType defines the type of staking message authorized.
+    StakeAuthorizationType authorizationType;
+    /// allowList defines the validator operator addresses the grantee can
+    /// stake with. It can't be set along with the denyList.
+    string[] allowList;
+    /// denyList defines the validator operator addresses the grantee can't
+    /// stake with. It can't be set along with the allowList.
+    string[] denyList;
+    /// maxTokens defines the maximum amount of tokens the grantee can stake.
+    /// A zero amount means no limit.
+    Coin maxTokens;
+}
+
+/// @dev Grant defines an authorization granted by a granter to a grantee.
+struct Grant {
+    /// granter is the address of the account granting the authorization.
+    address granter;
+    /// grantee is the address of the account receiving the authorization.
+    address grantee;
+    /// authorizationType is the type URL of the authorization.
+    string authorizationType;
+    /// msgTypeUrl is the type URL of the message the authorization allows.
+    string msgTypeUrl;
+    /// stakeAuthorization holds the authorization fields of a stake authorization.
+    /// It is empty for the other authorization types.
+    StakeAuthorization stakeAuthorization;
+    /// expiration is the unix timestamp in seconds at which the authorization
+    /// expires. It is zero if the authorization doesn't expire.
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK x/authz module.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message authorized
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message no longer authorized
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Emitted for each message executed by a grantee on behalf of a granter.
+    /// @param granter the address of the signer of the message
+    /// @param grantee the address of the grantee executing the message
+    /// @param msgTypeUrl the type URL of the message executed
+    event Exec(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Grants a generic authorization, allowing the grantee to execute
+    /// any message of the given type on behalf of the granter.
+    /// @param granter the address of the granter, which must be the caller
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message to authorize
+    /// @param expiration the unix timestamp in seconds at which the
+    /// authorization expires, or zero for no expiration
+    /// @return success true if the authorization was granted
+    function grantGenericAuthorization(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a stake authorization, allowing the grantee to delegate,
+    /// undelegate or redelegate on behalf of the granter.
+    /// @param granter the address of the granter, which must be the caller
+    /// @param grantee the address of the grantee
+    /// @param authorization the stake authorization
+    /// @param expiration the unix timestamp in seconds at which the
+    /// authorization expires, or zero for no expiration
+    /// @return success true if the authorization was granted
+    function grantStakeAuthorization(
+        address granter,
+        address grantee,
+        StakeAuthorization calldata authorization,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the authorization of a grantee for a message type.
+    /// @param granter the address of the granter, which must be the caller
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message to revoke the authorization of
+    /// @return success true if the authorization was revoked
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes messages on behalf of their signers, using the
+    /// authorizations granted to the grantee.
+    /// @param grantee the address of the grantee, which must be the caller
+    /// @param msgs the JSON encoded Cosmos SDK messages, with their "@type"
+    /// @return results the results of the messages executed
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev Queries the authorizations of a grantee from a granter.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the message type URL to filter the authorizations by,
+    /// or an empty string for all the authorizations
+    /// @param pageRequest the pagination of the query
+    /// @return grants the authorizations
+    /// @return pageResponse the pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries the authorizations granted by a granter.
+    /// @param granter the address of the granter
+    /// @param pageRequest the pagination of the query
+    /// @return grants the authorizations
+    /// @return pageResponse the pagination response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries the authorizations granted to a grantee.
+    /// @param grantee the address of the grantee
+    /// @param pageRequest the pagination of the query
+    /// @return grants the authorizations
+    /// @return pageResponse the pagination response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+}
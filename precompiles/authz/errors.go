@@ -0,0 +1,20 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidExpiration is raised when the expiration is not a valid unix timestamp.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidStakeAuthorization is raised when the stake authorization is not valid.
+	ErrInvalidStakeAuthorization = "invalid stake authorization: %s"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrDisabledMsgType is raised when a message type can't be granted or executed through the precompile.
+	ErrDisabledMsgType = "found disabled msg type: %s"
+	// ErrTooManyNestedMsgs is raised when the messages to execute are nested deeper than permitted.
+	ErrTooManyNestedMsgs = "found more nested msgs than permitted; got: %d, expected: <%d"
+)
@@ -0,0 +1,92 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantsMethod defines the method name for the grants precompile request.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the method name for the granter grants precompile request.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the method name for the grantee grants precompile request.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants implements the query logic for getting the grants of a grantee from a granter.
+func (p *Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, granter, grantee, err := ParseGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantsResponse(res, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranterGrants implements the query logic for getting the grants of a granter.
+func (p *Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranteeGrants implements the query logic for getting the grants of a grantee.
+func (p *Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
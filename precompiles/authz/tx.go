@@ -0,0 +1,165 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantGenericAuthorizationMethod defines the ABI method name for the authz GrantGenericAuthorization transaction.
+	GrantGenericAuthorizationMethod = "grantGenericAuthorization"
+	// GrantStakeAuthorizationMethod defines the ABI method name for the authz GrantStakeAuthorization transaction.
+	GrantStakeAuthorizationMethod = "grantStakeAuthorization"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// GrantGenericAuthorization defines a method to grant a GenericAuthorization,
+// allowing the grantee to execute any message of the given type on behalf of the granter.
+func (p *Precompile) GrantGenericAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantGenericAuthorization(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantStakeAuthorization defines a method to grant a StakeAuthorization,
+// allowing the grantee to delegate, undelegate or redelegate on behalf of the granter.
+func (p *Precompile) GrantStakeAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantStakeAuthorization(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// Revoke defines a method to revoke the authorization of a grantee for a message type.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if _, err = p.authzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec defines a method to execute messages on behalf of their signers,
+// using the authorizations granted to the grantee.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != grantee {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), grantee.String())
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	res, err := p.authzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range msgs {
+		signers, _, err := p.codec.GetMsgV1Signers(m)
+		if err != nil {
+			return nil, err
+		}
+		// NOTE: the keeper only executes messages with a single signer
+		granter := common.BytesToAddress(signers[0])
+		if err = p.EmitExecEvent(ctx, stateDB, granter, grantee, sdk.MsgTypeURL(m)); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// grant executes the given MsgGrant, after checking the granter is the caller.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if _, err = p.authzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,626 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// maxNestedMsgs defines a cap for the number of nested messages on a MsgExec message.
+const maxNestedMsgs = 7
+
+// allowedMsgTypes defines the message types that can be granted or executed
+// through the precompile. Any other message type is disabled, in particular the
+// ones that call back into the EVM, such as MsgEthereumTx or MsgConvertERC20,
+// since the precompile runs within an EVM transaction.
+var allowedMsgTypes = map[string]bool{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}):                                true,
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):                           true,
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):                         true,
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):                       true,
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):                  true,
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}):        true,
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}):     true,
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawValidatorCommission{}): true,
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}):          true,
+	sdk.MsgTypeURL(&govv1.MsgVote{}):                                    true,
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}):                            true,
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}):                                 true,
+	sdk.MsgTypeURL(&authz.MsgRevoke{}):                                  true,
+}
+
+// EventGrant defines the event data for the authz grant transactions.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventRevoke defines the event data for the authz Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventExec defines the event data for each message executed on the authz Exec transaction.
+type EventExec struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// StakeAuthorization defines the ABI representation of a staking StakeAuthorization.
+type StakeAuthorization struct {
+	AuthorizationType uint8
+	AllowList         []string
+	DenyList          []string
+	MaxTokens         cmn.Coin
+}
+
+// Grant defines the ABI representation of an authz grant.
+type Grant struct {
+	Granter            common.Address
+	Grantee            common.Address
+	AuthorizationType  string
+	MsgTypeUrl         string //nolint:revive
+	StakeAuthorization StakeAuthorization
+	Expiration         int64
+}
+
+// GrantStakeAuthorizationInput defines the input for the GrantStakeAuthorization transaction.
+type GrantStakeAuthorizationInput struct {
+	Granter       common.Address
+	Grantee       common.Address
+	Authorization StakeAuthorization
+	Expiration    int64
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgTypeUrl  string //nolint:revive
+	PageRequest query.PageRequest
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// GrantsOutput defines the output for the grants queries.
+type GrantsOutput struct {
+	Grants       []Grant
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantGenericAuthorization creates a new MsgGrant instance with a
+// GenericAuthorization and does sanity checks on the given arguments.
+func NewMsgGrantGenericAuthorization(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantStakeAuthorization creates a new MsgGrant instance with a
+// StakeAuthorization and does sanity checks on the given arguments.
+func NewMsgGrantStakeAuthorization(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantStakeAuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantStakeAuthorizationInput struct: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	authorization, err := input.Authorization.ToStakeAuthorization()
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authorization, input.Expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance and does sanity checks
+// on the given arguments.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance from the JSON encoded messages
+// and does sanity checks on the given arguments.
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authz.MsgExec, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, args[1])
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	for i, bz := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+			return nil, common.Address{}, sdkerrors.Wrapf(err, "message %d", i)
+		}
+		msgs[i] = msg
+	}
+
+	if err := checkDisabledMsgs(msgs, 1); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anyVal, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		anys[i] = anyVal
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authz.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    anys,
+	}
+
+	return msg, grantee, nil
+}
+
+// ParseGrantsArgs parses the arguments for the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGrantsRequest, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	req := &authz.QueryGrantsRequest{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: parsePageRequest(input.PageRequest),
+	}
+
+	return req, granter, grantee, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granterAddr,
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    granteeAddr,
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse,
+// which doesn't include the granter and grantee of the grants.
+func (o *GrantsOutput) FromGrantsResponse(res *authz.QueryGrantsResponse, granter, grantee common.Address) (*GrantsOutput, error) {
+	o.Grants = make([]Grant, len(res.Grants))
+	for i, g := range res.Grants {
+		grant, err := NewGrant(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(res.Pagination)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants returned
+// by the GranterGrants and GranteeGrants queries.
+func (o *GrantsOutput) FromGrantAuthorizations(grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]Grant, len(grants))
+	for i, g := range grants {
+		granter, err := utils.HexAddressFromBech32String(g.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := utils.HexAddressFromBech32String(g.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		grant, err := NewGrant(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// NewGrant creates the ABI representation of a grant from its packed
+// authorization and expiration.
+func NewGrant(granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time) (Grant, error) {
+	if authorizationAny == nil {
+		return Grant{}, fmt.Errorf("empty authorization")
+	}
+
+	authorization, ok := authorizationAny.GetCachedValue().(authz.Authorization)
+	if !ok {
+		return Grant{}, fmt.Errorf("unexpected authorization type %s", authorizationAny.TypeUrl)
+	}
+
+	grant := Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		StakeAuthorization: StakeAuthorization{
+			MaxTokens: cmn.Coin{Amount: big.NewInt(0)},
+		},
+	}
+
+	if stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization); ok {
+		grant.StakeAuthorization = NewStakeAuthorization(stakeAuthorization)
+	}
+
+	if expiration != nil {
+		grant.Expiration = expiration.Unix()
+	}
+
+	return grant, nil
+}
+
+// NewStakeAuthorization creates the ABI representation of a StakeAuthorization.
+func NewStakeAuthorization(a *stakingtypes.StakeAuthorization) StakeAuthorization {
+	stakeAuthorization := StakeAuthorization{
+		AuthorizationType: uint8(a.AuthorizationType), //nolint:gosec // G115
+		AllowList:         []string{},
+		DenyList:          []string{},
+		MaxTokens:         cmn.Coin{Amount: big.NewInt(0)},
+	}
+
+	if allowList := a.GetAllowList(); allowList != nil {
+		stakeAuthorization.AllowList = allowList.Address
+	}
+	if denyList := a.GetDenyList(); denyList != nil {
+		stakeAuthorization.DenyList = denyList.Address
+	}
+	if a.MaxTokens != nil {
+		stakeAuthorization.MaxTokens = cmn.Coin{
+			Denom:  a.MaxTokens.Denom,
+			Amount: a.MaxTokens.Amount.BigInt(),
+		}
+	}
+
+	return stakeAuthorization
+}
+
+// ToStakeAuthorization converts the ABI representation of a StakeAuthorization
+// into the staking StakeAuthorization. A zero max tokens amount means no limit.
+func (a StakeAuthorization) ToStakeAuthorization() (*stakingtypes.StakeAuthorization, error) {
+	authzType := stakingtypes.AuthorizationType(a.AuthorizationType)
+	if _, ok := stakingtypes.AuthorizationType_name[int32(authzType)]; !ok ||
+		authzType == stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return nil, fmt.Errorf(ErrInvalidStakeAuthorization, fmt.Sprintf("unknown authorization type %d", a.AuthorizationType))
+	}
+
+	allowList, err := parseValAddresses(a.AllowList)
+	if err != nil {
+		return nil, err
+	}
+
+	denyList, err := parseValAddresses(a.DenyList)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxTokens *sdk.Coin
+	if a.MaxTokens.Amount != nil && a.MaxTokens.Amount.Sign() != 0 {
+		coin := sdk.Coin{Denom: a.MaxTokens.Denom, Amount: math.NewIntFromBigInt(a.MaxTokens.Amount)}
+		if err := coin.Validate(); err != nil {
+			return nil, fmt.Errorf(ErrInvalidStakeAuthorization, err.Error())
+		}
+		maxTokens = &coin
+	}
+
+	authorization, err := stakingtypes.NewStakeAuthorization(allowList, denyList, authzType, maxTokens)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidStakeAuthorization, err.Error())
+	}
+
+	return authorization, nil
+}
+
+// newMsgGrant creates a new MsgGrant instance for the given authorization.
+// An expiration of zero means the authorization doesn't expire.
+func newMsgGrant(
+	granter, grantee common.Address,
+	authorization authz.Authorization,
+	expiration int64,
+	addrCdc address.Codec,
+) (*authz.MsgGrant, error) {
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	if err := checkDisabledMsgType(authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &authz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		msg.Grant.Expiration = &expirationTime
+	}
+
+	if err := msg.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// checkDisabledMsgs iterates through the msgs and returns an error if it finds
+// any msg type that is not allowed, either executed directly or granted or
+// executed within nested authz messages.
+//
+// This method is recursive as MsgExec's can wrap other MsgExecs. The check for
+// nested messages is performed up to the maxNestedMsgs threshold.
+func checkDisabledMsgs(msgs []sdk.Msg, nestedLvl int) error {
+	if nestedLvl >= maxNestedMsgs {
+		return fmt.Errorf(ErrTooManyNestedMsgs, nestedLvl, maxNestedMsgs)
+	}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := checkDisabledMsgs(innerMsgs, nestedLvl+1); err != nil {
+				return err
+			}
+		case *authz.MsgGrant:
+			authorization, err := msg.GetAuthorization()
+			if err != nil {
+				return err
+			}
+			if err := checkDisabledMsgType(authorization.MsgTypeURL()); err != nil {
+				return err
+			}
+		default:
+			if err := checkDisabledMsgType(sdk.MsgTypeURL(msg)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkDisabledMsgType returns an error if the given message type is not in
+// the allowed message types of the precompile.
+func checkDisabledMsgType(msgTypeURL string) error {
+	if !allowedMsgTypes[msgTypeURL] {
+		return fmt.Errorf(ErrDisabledMsgType, msgTypeURL)
+	}
+	return nil
+}
+
+// parseGranterGrantee parses and checks the granter and grantee addresses.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// encodeGranterGrantee encodes the granter and grantee addresses with the given address codec.
+func encodeGranterGrantee(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
+
+// parseValAddresses parses the bech32 validator operator addresses of a StakeAuthorization.
+func parseValAddresses(addresses []string) ([]sdk.ValAddress, error) {
+	valAddrs := make([]sdk.ValAddress, len(addresses))
+	for i, addr := range addresses {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidStakeAuthorization, err.Error())
+		}
+		valAddrs[i] = valAddr
+	}
+	return valAddrs, nil
+}
+
+// parsePageRequest returns the page request to use in the authz queries.
+func parsePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
@@ -0,0 +1,183 @@
+package authz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewMsgGrantGenericAuthorization(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		args           []interface{}
+		wantErr        bool
+		errMsg         string
+		wantExpiration *time.Time
+	}{
+		{
+			name: "valid without expiration",
+			args: []interface{}{granterAddr, granteeAddr, msgTypeURL, int64(0)},
+		},
+		{
+			name:           "valid with expiration",
+			args:           []interface{}{granterAddr, granteeAddr, msgTypeURL, expiration.Unix()},
+			wantExpiration: &expiration,
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "invalid granter type",
+			args:    []interface{}{"not-an-address", granteeAddr, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, "not-an-address"),
+		},
+		{
+			name:    "empty grantee address",
+			args:    []interface{}{granterAddr, common.Address{}, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:    "invalid expiration type",
+			args:    []interface{}{granterAddr, granteeAddr, msgTypeURL, uint64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, uint64(0)),
+		},
+		{
+			name:    "disabled msg type",
+			args:    []interface{}{granterAddr, granteeAddr, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granter, grantee, err := NewMsgGrantGenericAuthorization(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.EqualError(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granterAddr, granter)
+			require.Equal(t, granteeAddr, grantee)
+			require.Equal(t, expectedGranter, msg.Granter)
+			require.Equal(t, expectedGrantee, msg.Grantee)
+			require.Equal(t, tt.wantExpiration, msg.Grant.Expiration)
+
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, msgTypeURL, authorization.MsgTypeURL())
+		})
+	}
+}
+
+func TestCheckDisabledMsgs(t *testing.T) {
+	granter := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	grantee := sdk.AccAddress(common.HexToAddress("0x0987654321098765432109876543210987654321").Bytes())
+
+	sendMsg := banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	vestingMsg := sdkvesting.NewMsgCreateVestingAccount(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 1, false)
+	convertMsg := &erc20types.MsgConvertERC20{Sender: granter.String(), Receiver: granter.String()}
+
+	nestedExec := func(msg sdk.Msg, depth int) sdk.Msg {
+		for i := 0; i < depth; i++ {
+			exec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+			msg = &exec
+		}
+		return msg
+	}
+
+	disabledGrant, err := authz.NewMsgGrant(granter, grantee, authz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})), nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		msgs    []sdk.Msg
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "allowed msg",
+			msgs: []sdk.Msg{sendMsg},
+		},
+		{
+			name: "allowed nested msg",
+			msgs: []sdk.Msg{nestedExec(sendMsg, 2)},
+		},
+		{
+			name:    "disabled msg",
+			msgs:    []sdk.Msg{sendMsg, vestingMsg},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(vestingMsg)),
+		},
+		{
+			name:    "disabled nested msg",
+			msgs:    []sdk.Msg{nestedExec(vestingMsg, 2)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(vestingMsg)),
+		},
+		{
+			name:    "msg calling back into the EVM",
+			msgs:    []sdk.Msg{convertMsg},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(convertMsg)),
+		},
+		{
+			name:    "disabled msg type granted",
+			msgs:    []sdk.Msg{disabledGrant},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			name:    "too many nested msgs",
+			msgs:    []sdk.Msg{nestedExec(sendMsg, maxNestedMsgs)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrTooManyNestedMsgs, maxNestedMsgs, maxNestedMsgs),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDisabledMsgs(tt.msgs, 1)
+			if tt.wantErr {
+				require.EqualError(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,34 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the coins of a multiSend transfer.
+struct Output {
+    /// toAddress defines the address receiving the coins.
+    address toAddress;
+    /// amount of coins to send.
+    Coin[] amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module
+ * and for sending native coins.
  */
 interface IBank {
+    /// @dev Send defines an Event emitted for each coin sent with the send
+    /// and multiSend transactions.
+    /// @param from the address of the sender
+    /// @param to the address of the recipient
+    /// @param denom the denomination of the coin
+    /// @param amount the amount of the coin
+    event Send(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -39,4 +63,22 @@ interface IBank {
     function supplyOf(
         address erc20Address
     ) external view returns (uint256 totalSupply);
+
+    /// @dev send defines a method for sending native coins of any
+    /// denomination from the caller to another account.
+    /// @param toAddress the address of the recipient.
+    /// @param amount the coins to send.
+    /// @return success true if the transfer was successful.
+    function send(
+        address toAddress,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins of any
+    /// denomination from the caller to multiple accounts.
+    /// @param outputs the recipients and the coins they receive.
+    /// @return success true if the transfers were successful.
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
 }
@@ -0,0 +1,119 @@
+package bank
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends coins of any denomination from the caller to the given address.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	toAddress, amount, err := ParseSendArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+	msg := NewMsgSend(from, toAddress, amount)
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if err := p.send(ctx, from, toAddress, amount); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSendEvent(ctx, stateDB, from, toAddress, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends coins of any denomination from the caller to multiple
+// addresses. The caller is charged the Send gas for each output after the
+// first one.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	outputs, err := ParseMultiSendArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	from := contract.Caller()
+	msg, err := NewMsgMultiSend(from, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	for i, output := range outputs {
+		// NOTE: we already charged for a single send so we don't need to
+		// charge on the first output
+		if i > 0 {
+			ctx.GasMeter().ConsumeGas(GasSend, "bank extension multiSend method")
+		}
+
+		amount := msg.Outputs[i].Coins
+		if err := p.send(ctx, from, output.ToAddress, amount); err != nil {
+			return nil, err
+		}
+
+		if err := p.EmitSendEvent(ctx, stateDB, from, output.ToAddress, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// send transfers the coins with the same checks as the x/bank Send message:
+// the coins must be send enabled and the recipient must not be a blocked address.
+// The EVM balances are synced by the balance handler from the emitted bank events.
+func (p Precompile) send(ctx sdk.Context, from, to common.Address, amount sdk.Coins) error {
+	// the balance handler only syncs the integer EVM coin, the fractional
+	// amounts are sent with native EVM transfers
+	if extendedDenom := evmtypes.GetEVMCoinExtendedDenom(); extendedDenom != evmtypes.GetEVMCoinDenom() && amount.AmountOf(extendedDenom).IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "%s can't be sent by the bank precompile, use a native transfer", extendedDenom)
+	}
+
+	for _, coin := range amount {
+		if !p.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return banktypes.ErrSendDisabled.Wrapf("%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	toAddr := sdk.AccAddress(to.Bytes())
+	if p.bankKeeper.BlockedAddr(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	return p.bankKeeper.SendCoins(ctx, from.Bytes(), toAddr, amount)
+}
@@ -3,12 +3,14 @@ package bank
 import (
 	"fmt"
 	"math/big"
+	"reflect"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Balance contains the amount for a corresponding ERC-20 contract address.
@@ -17,6 +19,14 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// EventSend defines the event data for the Send and MultiSend transactions.
+type EventSend struct {
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +54,128 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// Output defines the recipient and the coins of a multiSend transfer.
+type Output struct {
+	ToAddress common.Address
+	Amount    []cmn.Coin
+}
+
+// ParseSendArgs parses the call arguments for the bank Send transaction.
+func ParseSendArgs(args []interface{}) (common.Address, sdk.Coins, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	toAddress, ok := args[0].(common.Address)
+	if !ok || toAddress == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+
+	amount, err := parseCoins(args[1])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return toAddress, amount, nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+func ParseMultiSendArgs(args []interface{}) ([]Output, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Fast-path: if ABI already returned []Output (e.g. in tests) just cast.
+	if outputs, ok := args[0].([]Output); ok {
+		return outputs, nil
+	}
+
+	// Slow-path: reflect over anonymous struct slice.
+	rv := reflect.ValueOf(args[0])
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "outputs", []Output{}, args[0])
+	}
+
+	outputs := make([]Output, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		item := rv.Index(i)
+		toAddressField := item.FieldByName("ToAddress")
+		amountField := item.FieldByName("Amount")
+		if !toAddressField.IsValid() || !amountField.IsValid() {
+			return nil, fmt.Errorf("output tuple does not have expected fields")
+		}
+
+		toAddress, ok := toAddressField.Interface().(common.Address)
+		if !ok {
+			return nil, fmt.Errorf("invalid output at index %d", i)
+		}
+
+		amount, err := cmn.ToCoins(amountField.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("invalid output at index %d: %w", i, err)
+		}
+
+		outputs[i] = Output{ToAddress: toAddress, Amount: amount}
+	}
+
+	return outputs, nil
+}
+
+// NewMsgSend creates a new bank MsgSend message, used to trace the Send transaction.
+func NewMsgSend(from, to common.Address, amount sdk.Coins) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(from.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(to.Bytes()).String(),
+		Amount:      amount,
+	}
+}
+
+// NewMsgMultiSend creates a new bank MsgMultiSend message with the sender as
+// single input, used to trace the MultiSend transaction. It returns an error if
+// any of the outputs is invalid.
+func NewMsgMultiSend(from common.Address, outputs []Output) (*banktypes.MsgMultiSend, error) {
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no outputs to send to")
+	}
+
+	total := sdk.NewCoins()
+	bankOutputs := make([]banktypes.Output, len(outputs))
+	for i, output := range outputs {
+		if output.ToAddress == (common.Address{}) {
+			return nil, fmt.Errorf(cmn.ErrInvalidHexAddress, output.ToAddress)
+		}
+
+		amount, err := parseCoins(output.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(amount...)
+		bankOutputs[i] = banktypes.NewOutput(output.ToAddress.Bytes(), amount)
+	}
+
+	return &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(from.Bytes(), total)},
+		Outputs: bankOutputs,
+	}, nil
+}
+
+// parseCoins converts the coins of a call argument into valid and positive sdk.Coins.
+func parseCoins(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, amount)
+	}
+
+	return amount, nil
+}
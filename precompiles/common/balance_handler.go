@@ -39,6 +39,10 @@ func (bh *BalanceHandler) BeforeBalanceChange(ctx sdk.Context) {
 // of the spender and receiver addresses respectively.
 func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.StateDB) error {
 	events := ctx.EventManager().Events()
+	tracer := PrecompileTracerFromContext(ctx)
+	if tracer != nil {
+		tracer.OnCosmosEvents(events[bh.prevEventsLen:])
+	}
 
 	for _, event := range events[bh.prevEventsLen:] {
 		switch event.Type {
@@ -54,6 +58,9 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 			}
 
 			stateDB.SubBalance(spenderHexAddr, amount, tracing.BalanceChangeUnspecified)
+			if tracer != nil {
+				tracer.OnBalanceChange(NewBalanceChangeEntry(spenderHexAddr, amount, Sub))
+			}
 
 		case banktypes.EventTypeCoinReceived:
 			receiverHexAddr, err := parseHexAddress(event, banktypes.AttributeKeyReceiver)
@@ -67,6 +74,9 @@ func (bh *BalanceHandler) AfterBalanceChange(ctx sdk.Context, stateDB *statedb.S
 			}
 
 			stateDB.AddBalance(receiverHexAddr, amount, tracing.BalanceChangeUnspecified)
+			if tracer != nil {
+				tracer.OnBalanceChange(NewBalanceChangeEntry(receiverHexAddr, amount, Add))
+			}
 		}
 	}
 
@@ -217,3 +217,41 @@ func TestAfterBalanceChangeErrors(t *testing.T) {
 	err = bh.AfterBalanceChange(ctx, stateDB)
 	require.Error(t, err)
 }
+
+func TestAfterBalanceChangeTracer(t *testing.T) {
+	setupBalanceHandlerTest(t)
+
+	storeKey := storetypes.NewKVStoreKey("test")
+	tKey := storetypes.NewTransientStoreKey("test_t")
+	ctx := sdktestutil.DefaultContext(storeKey, tKey)
+	stateDB := statedb.New(ctx, mocks.NewEVMKeeper(), statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	_, addrs, err := testutil.GeneratePrivKeyAddressPairs(2)
+	require.NoError(t, err)
+	spender := common.BytesToAddress(addrs[0])
+	receiver := common.BytesToAddress(addrs[1])
+	stateDB.AddBalance(spender, uint256.NewInt(5), tracing.BalanceChangeUnspecified)
+
+	tracer := &testPrecompileTracer{}
+	ctx = WithPrecompileTracer(ctx, tracer)
+
+	// events emitted before the balance change are not reported
+	ctx.EventManager().EmitEvent(sdk.NewEvent("previous"))
+
+	bh := NewBalanceHandler()
+	bh.BeforeBalanceChange(ctx)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 3))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		banktypes.NewCoinSpentEvent(addrs[0], coins),
+		banktypes.NewCoinReceivedEvent(addrs[1], coins),
+	})
+
+	require.NoError(t, bh.AfterBalanceChange(ctx, stateDB))
+	require.Len(t, tracer.events, 2)
+	require.Equal(t, banktypes.EventTypeCoinSpent, tracer.events[0].Type)
+	require.Equal(t, []BalanceChangeEntry{
+		NewBalanceChangeEntry(spender, uint256.NewInt(3), Sub),
+		NewBalanceChangeEntry(receiver, uint256.NewInt(3), Add),
+	}, tracer.balanceChanges)
+}
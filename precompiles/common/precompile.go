@@ -135,6 +135,11 @@ func (p Precompile) RunSetup(
 		}
 	}
 
+	// notify the precompile tracer carried by the context, if any
+	if tracer := PrecompileTracerFromContext(ctx); tracer != nil {
+		tracer.OnPrecompileCall(p.Address(), method, args)
+	}
+
 	initialGas := ctx.GasMeter().GasConsumed()
 
 	defer HandleGasError(ctx, contract, initialGas, &err)()
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrecompileTracer is implemented by the EVM tracers that annotate the
+// precompile calls with the Cosmos SDK side of their execution. All the
+// callbacks refer to the precompile call currently being executed.
+type PrecompileTracer interface {
+	// OnPrecompileCall is called once the method and arguments of the call
+	// are decoded.
+	OnPrecompileCall(address common.Address, method *abi.Method, args []interface{})
+	// OnCosmosMsg is called with each sdk.Msg executed by the precompile.
+	OnCosmosMsg(msg sdk.Msg)
+	// OnCosmosEvents is called with the Cosmos events emitted by the precompile.
+	OnCosmosEvents(events sdk.Events)
+	// OnBalanceChange is called with each EVM balance change of the precompile.
+	OnBalanceChange(entry BalanceChangeEntry)
+}
+
+type precompileTracerKey struct{}
+
+// WithPrecompileTracer returns a copy of the context carrying the precompile
+// tracer to notify on the precompile calls executed on top of it.
+func WithPrecompileTracer(ctx sdk.Context, tracer PrecompileTracer) sdk.Context {
+	return ctx.WithValue(precompileTracerKey{}, tracer)
+}
+
+// PrecompileTracerFromContext returns the precompile tracer set on the context
+// by WithPrecompileTracer, if any.
+func PrecompileTracerFromContext(ctx sdk.Context) PrecompileTracer {
+	tracer, _ := ctx.Value(precompileTracerKey{}).(PrecompileTracer)
+	return tracer
+}
+
+// TraceCosmosMsg notifies the precompile tracer, if any, of an executed sdk.Msg.
+func TraceCosmosMsg(ctx sdk.Context, msg sdk.Msg) {
+	if tracer := PrecompileTracerFromContext(ctx); tracer != nil {
+		tracer.OnCosmosMsg(msg)
+	}
+}
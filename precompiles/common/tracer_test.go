@@ -0,0 +1,54 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testPrecompileTracer records the precompile tracer callbacks.
+type testPrecompileTracer struct {
+	methods        []string
+	msgs           []sdk.Msg
+	events         sdk.Events
+	balanceChanges []BalanceChangeEntry
+}
+
+func (t *testPrecompileTracer) OnPrecompileCall(_ common.Address, method *abi.Method, _ []interface{}) {
+	t.methods = append(t.methods, method.Name)
+}
+
+func (t *testPrecompileTracer) OnCosmosMsg(msg sdk.Msg) {
+	t.msgs = append(t.msgs, msg)
+}
+
+func (t *testPrecompileTracer) OnCosmosEvents(events sdk.Events) {
+	t.events = append(t.events, events...)
+}
+
+func (t *testPrecompileTracer) OnBalanceChange(entry BalanceChangeEntry) {
+	t.balanceChanges = append(t.balanceChanges, entry)
+}
+
+func TestTraceCosmosMsg(t *testing.T) {
+	ctx := sdktestutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("test_t"))
+	msg := &banktypes.MsgSend{FromAddress: "from", ToAddress: "to"}
+
+	// no-op without a tracer
+	require.Nil(t, PrecompileTracerFromContext(ctx))
+	TraceCosmosMsg(ctx, msg)
+
+	tracer := &testPrecompileTracer{}
+	ctx = WithPrecompileTracer(ctx, tracer)
+	require.Equal(t, tracer, PrecompileTracerFromContext(ctx))
+	TraceCosmosMsg(ctx, msg)
+	require.Equal(t, []sdk.Msg{msg}, tracer.msgs)
+}
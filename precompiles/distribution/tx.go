@@ -103,6 +103,7 @@ func (p Precompile) SetWithdrawAddress(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
 	if _, err = msgSrv.SetWithdrawAddress(ctx, msg); err != nil {
 		return nil, err
@@ -133,6 +134,7 @@ func (p *Precompile) WithdrawDelegatorReward(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
 	res, err := msgSrv.WithdrawDelegatorReward(ctx, msg)
 	if err != nil {
@@ -164,6 +166,7 @@ func (p *Precompile) WithdrawValidatorCommission(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), validatorHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
 	res, err := msgSrv.WithdrawValidatorCommission(ctx, msg)
 	if err != nil {
@@ -195,6 +198,7 @@ func (p *Precompile) FundCommunityPool(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
 	_, err = msgSrv.FundCommunityPool(ctx, msg)
 	if err != nil {
@@ -227,6 +231,7 @@ func (p *Precompile) DepositValidatorRewardsPool(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
 	_, err = msgSrv.DepositValidatorRewardsPool(ctx, msg)
 	if err != nil {
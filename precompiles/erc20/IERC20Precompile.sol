@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
+import "./IERC3009.sol";
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Precompile Interface
+ * @dev Interface of the ERC20 precompiles of the native Cosmos coins, with the
+ * metadata, ERC-2612 permit and ERC-3009 transfer with authorization extensions.
+ */
+interface IERC20Precompile is IERC20Metadata, IERC20Permit, IERC3009 {}
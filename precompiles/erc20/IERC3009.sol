@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC-3009 Interface
+ * @dev Interface of the transfers with authorization, as defined in
+ * https://eips.ethereum.org/EIPS/eip-3009[EIP-3009]. The authorizations are
+ * signed with the same EIP-712 domain as the ERC-2612 permits.
+ */
+interface IERC3009 {
+    /// @dev Emitted when an authorization is used.
+    /// @param authorizer The address of the authorizer.
+    /// @param nonce The nonce of the authorization.
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Emitted when an authorization is canceled.
+    /// @param authorizer The address of the authorizer.
+    /// @param nonce The nonce of the authorization.
+    event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Returns the state of an authorization.
+    /// @param authorizer The address of the authorizer.
+    /// @param nonce The nonce of the authorization.
+    /// @return True if the authorization has been used or canceled.
+    function authorizationState(address authorizer, bytes32 nonce) external view returns (bool);
+
+    /// @dev Executes a transfer with a signed authorization.
+    /// @param from The address of the payer, which signed the authorization.
+    /// @param to The address of the payee.
+    /// @param value The amount to transfer.
+    /// @param validAfter The unix timestamp in seconds after which the authorization is valid.
+    /// @param validBefore The unix timestamp in seconds before which the authorization is valid.
+    /// @param nonce The unique nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The r value of the signature.
+    /// @param s The s value of the signature.
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Receives a transfer with a signed authorization from the payer. The
+    /// caller must be the payee, which prevents front-running attacks.
+    /// @param from The address of the payer, which signed the authorization.
+    /// @param to The address of the payee, which must be the caller.
+    /// @param value The amount to transfer.
+    /// @param validAfter The unix timestamp in seconds after which the authorization is valid.
+    /// @param validBefore The unix timestamp in seconds before which the authorization is valid.
+    /// @param nonce The unique nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The r value of the signature.
+    /// @param s The s value of the signature.
+    function receiveWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Cancels an unused authorization.
+    /// @param authorizer The address of the authorizer, which signed the cancellation.
+    /// @param nonce The nonce of the authorization.
+    /// @param v The recovery byte of the signature.
+    /// @param r The r value of the signature.
+    /// @param s The s value of the signature.
+    function cancelAuthorization(
+        address authorizer,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+}
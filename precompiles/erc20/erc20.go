@@ -37,6 +37,15 @@ const (
 	GasTotalSupply  = 2_480
 	GasBalanceOf    = 2_870
 	GasAllowance    = 3_225
+
+	// ERC-2612 and ERC-3009 methods
+	GasPermit                    = 11_100
+	GasTransferWithAuthorization = 14_000
+	GasReceiveWithAuthorization  = 14_000
+	GasCancelAuthorization       = 8_000
+	GasNonces                    = 2_500
+	GasDomainSeparator           = 3_500
+	GasAuthorizationState        = 2_600
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -122,6 +131,22 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	// ERC-2612 and ERC-3009 transactions
+	case PermitMethod:
+		return GasPermit
+	case TransferWithAuthorizationMethod:
+		return GasTransferWithAuthorization
+	case ReceiveWithAuthorizationMethod:
+		return GasReceiveWithAuthorization
+	case CancelAuthorizationMethod:
+		return GasCancelAuthorization
+	// ERC-2612 and ERC-3009 queries
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
 	default:
 		return 0
 	}
@@ -174,7 +199,11 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
 		TransferFromMethod,
-		ApproveMethod:
+		ApproveMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod,
+		ReceiveWithAuthorizationMethod,
+		CancelAuthorizationMethod:
 		return true
 	default:
 		return false
@@ -210,6 +239,20 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// ERC-2612 and ERC-3009 transactions
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case TransferWithAuthorizationMethod, ReceiveWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
+	case CancelAuthorizationMethod:
+		bz, err = p.CancelAuthorization(ctx, contract, stateDB, method, args)
+	// ERC-2612 and ERC-3009 queries
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
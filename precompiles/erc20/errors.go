@@ -31,6 +31,20 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// ERC20Permit errors
+	ErrPermitExpired          = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSignature = errors.New("ERC20Permit: invalid signature")
+
+	// ERC3009 errors
+	ErrAuthorizationNotYetValid      = errors.New("ERC3009: authorization is not yet valid")
+	ErrAuthorizationExpired          = errors.New("ERC3009: authorization is expired")
+	ErrAuthorizationUsed             = errors.New("ERC3009: authorization is used or canceled")
+	ErrAuthorizationInvalidSignature = errors.New("ERC3009: invalid signature")
+	ErrCallerIsNotPayee              = errors.New("ERC3009: caller must be the payee")
+
+	// ErrInvalidSignature is returned when the v, r and s values of a signature are invalid.
+	ErrInvalidSignature = errors.New("invalid signature values")
 )
 
 // ConvertErrToERC20Error is a helper function which maps errors raised by the Cosmos SDK stack
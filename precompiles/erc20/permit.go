@@ -0,0 +1,339 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the ERC-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// TransferWithAuthorizationMethod defines the ABI method name for the
+	// ERC-3009 transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// ReceiveWithAuthorizationMethod defines the ABI method name for the
+	// ERC-3009 receiveWithAuthorization transaction.
+	ReceiveWithAuthorizationMethod = "receiveWithAuthorization"
+	// CancelAuthorizationMethod defines the ABI method name for the ERC-3009
+	// cancelAuthorization transaction.
+	CancelAuthorizationMethod = "cancelAuthorization"
+	// NoncesMethod defines the ABI method name for the ERC-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the ERC-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+	// AuthorizationStateMethod defines the ABI method name for the ERC-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+
+	// DomainVersion defines the version of the EIP-712 domain of the permits
+	// and authorizations.
+	DomainVersion = "1"
+)
+
+var (
+	// DomainTypeHash is the EIP-712 type hash of the domain.
+	DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// PermitTypeHash is the EIP-712 type hash of the ERC-2612 permits.
+	PermitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// TransferWithAuthorizationTypeHash is the EIP-712 type hash of the
+	// ERC-3009 transferWithAuthorization authorizations.
+	TransferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte(
+		"TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)",
+	))
+	// ReceiveWithAuthorizationTypeHash is the EIP-712 type hash of the
+	// ERC-3009 receiveWithAuthorization authorizations.
+	ReceiveWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte(
+		"ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)",
+	))
+	// CancelAuthorizationTypeHash is the EIP-712 type hash of the ERC-3009
+	// authorization cancellations.
+	CancelAuthorizationTypeHash = crypto.Keccak256Hash([]byte("CancelAuthorization(address authorizer,bytes32 nonce)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signed approval, as defined in ERC-2612.
+// It increments the nonce of the owner and emits the Approval event.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, sig, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(blockTimestamp(ctx)) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	domainSeparator := p.domainSeparator(ctx)
+	nonce := p.erc20Keeper.GetNonce(ctx, p.Address(), owner)
+	structHash := crypto.Keccak256Hash(
+		PermitTypeHash.Bytes(),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(value)),
+		math.U256Bytes(new(big.Int).SetUint64(nonce)),
+		math.U256Bytes(new(big.Int).Set(deadline)),
+	)
+
+	signer, err := recoverSigner(typedDataHash(domainSeparator, structHash), sig)
+	if err != nil || signer != owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	p.erc20Keeper.SetNonce(ctx, p.Address(), owner, nonce+1)
+
+	// NOTE: a zero value deletes the allowance.
+	if err := p.setAllowance(ctx, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// TransferWithAuthorization executes a transfer with the payer's EIP-712
+// signed authorization, as defined in ERC-3009. It also handles the
+// receiveWithAuthorization method, which additionally requires the caller to
+// be the payee. It marks the authorization as used and emits the
+// AuthorizationUsed and Transfer events.
+func (p Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseTransferWithAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	typeHash := TransferWithAuthorizationTypeHash
+	if method.Name == ReceiveWithAuthorizationMethod {
+		if contract.Caller() != input.To {
+			return nil, ErrCallerIsNotPayee
+		}
+		typeHash = ReceiveWithAuthorizationTypeHash
+	}
+
+	now := blockTimestamp(ctx)
+	if now.Cmp(input.ValidAfter) <= 0 {
+		return nil, ErrAuthorizationNotYetValid
+	}
+	if now.Cmp(input.ValidBefore) >= 0 {
+		return nil, ErrAuthorizationExpired
+	}
+
+	structHash := crypto.Keccak256Hash(
+		typeHash.Bytes(),
+		common.LeftPadBytes(input.From.Bytes(), 32),
+		common.LeftPadBytes(input.To.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(input.Value)),
+		math.U256Bytes(new(big.Int).Set(input.ValidAfter)),
+		math.U256Bytes(new(big.Int).Set(input.ValidBefore)),
+		input.Nonce.Bytes(),
+	)
+
+	if err := p.useAuthorization(ctx, input.From, input.Nonce, structHash, input.Signature); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAuthorizationUsedEvent(ctx, stateDB, input.From, input.Nonce); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: sdkmath.NewIntFromBigInt(input.Value)}}
+	msg := banktypes.NewMsgSend(input.From.Bytes(), input.To.Bytes(), coins)
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := p.send(ctx, stateDB, msg, input.From, input.To, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// CancelAuthorization cancels an unused ERC-3009 authorization, given the
+// authorizer's EIP-712 signed cancellation. It emits the AuthorizationCanceled
+// event.
+func (p Precompile) CancelAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, sig, err := ParseCancelAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	structHash := crypto.Keccak256Hash(
+		CancelAuthorizationTypeHash.Bytes(),
+		common.LeftPadBytes(authorizer.Bytes(), 32),
+		nonce.Bytes(),
+	)
+
+	if err := p.useAuthorization(ctx, authorizer, nonce, structHash, sig); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAuthorizationCanceledEvent(ctx, stateDB, authorizer, nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current ERC-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the ERC-2612 permits
+// and ERC-3009 authorizations.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.domainSeparator(ctx))
+}
+
+// AuthorizationState returns true if the ERC-3009 authorization with the
+// given nonce of the given authorizer has been used or canceled.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+
+	return method.Outputs.Pack(used)
+}
+
+// useAuthorization checks that the ERC-3009 authorization with the given nonce
+// is unused and signed by the authorizer, and marks it as used.
+func (p Precompile) useAuthorization(
+	ctx sdk.Context,
+	authorizer common.Address,
+	nonce common.Hash,
+	structHash common.Hash,
+	sig Signature,
+) error {
+	if p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), authorizer, nonce) {
+		return ErrAuthorizationUsed
+	}
+
+	signer, err := recoverSigner(typedDataHash(p.domainSeparator(ctx), structHash), sig)
+	if err != nil || signer != authorizer {
+		return ErrAuthorizationInvalidSignature
+	}
+
+	p.erc20Keeper.SetAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+	return nil
+}
+
+// domainSeparator returns the EIP-712 domain separator of the precompile,
+// using the token name, the domain version, the EVM chain ID and the
+// precompile address. The token denomination is used as name for the tokens
+// without metadata, whose Name query reverts.
+func (p Precompile) domainSeparator(ctx sdk.Context) common.Hash {
+	name, err := p.name(ctx)
+	if err != nil {
+		name = p.tokenPair.Denom
+	}
+
+	return crypto.Keccak256Hash(
+		DomainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(DomainVersion)),
+		math.U256Bytes(new(big.Int).Set(evmtypes.GetEthChainConfig().ChainID)),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	)
+}
+
+// typedDataHash returns the EIP-712 hash of the given struct hash for the
+// given domain separator.
+func typedDataHash(domainSeparator, structHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// recoverSigner returns the address of the signer of the given hash. It only
+// accepts signatures with a recovery byte of 27 or 28 and a lower half s
+// value, as the ecrecover based implementations.
+func recoverSigner(hash common.Hash, sig Signature) (common.Address, error) {
+	if sig.V != 27 && sig.V != 28 {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	r := new(big.Int).SetBytes(sig.R[:])
+	s := new(big.Int).SetBytes(sig.S[:])
+	if !crypto.ValidateSignatureValues(sig.V-27, r, s, true) {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	bz := make([]byte, crypto.SignatureLength)
+	copy(bz[:32], sig.R[:])
+	copy(bz[32:64], sig.S[:])
+	bz[64] = sig.V - 27
+
+	pubKey, err := crypto.SigToPub(hash.Bytes(), bz)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// blockTimestamp returns the unix timestamp in seconds of the current block.
+func blockTimestamp(ctx sdk.Context) *big.Int {
+	return big.NewInt(ctx.BlockTime().Unix())
+}
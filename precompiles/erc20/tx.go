@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/utils"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -121,10 +122,35 @@ func (p *Precompile) transfer(
 		}
 	}
 
+	if err = p.send(ctx, stateDB, msg, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	// NOTE: if it's a direct transfer, we return here but if used through transferFrom,
+	// we need to emit the approval event with the new allowance.
+	if isTransferFrom {
+		if err = p.EmitApprovalEvent(ctx, stateDB, from, spenderAddr, newAllowance); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// send executes the given bank Send message, adjusts the EVM balances of the
+// sender and the recipient for the EVM denomination, and emits the Transfer event.
+func (p *Precompile) send(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *banktypes.MsgSend,
+	from, to common.Address,
+	amount *big.Int,
+) error {
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := NewMsgServerImpl(p.BankKeeper)
-	if err = msgSrv.Send(ctx, msg); err != nil {
+	if err := msgSrv.Send(ctx, msg); err != nil {
 		// This should return an error to avoid the contract from being executed and an event being emitted
-		return nil, ConvertErrToERC20Error(err)
+		return ConvertErrToERC20Error(err)
 	}
 
 	// TODO: Properly handle native balance changes via the balance handler.
@@ -134,24 +160,12 @@ func (p *Precompile) transfer(
 	if p.tokenPair.Denom == evmDenom {
 		convertedAmount, err := utils.Uint256FromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(amount))
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		stateDB.SubBalance(from, convertedAmount, tracing.BalanceChangeUnspecified)
 		stateDB.AddBalance(to, convertedAmount, tracing.BalanceChangeUnspecified)
 	}
 
-	if err = p.EmitTransferEvent(ctx, stateDB, from, to, amount); err != nil {
-		return nil, err
-	}
-
-	// NOTE: if it's a direct transfer, we return here but if used through transferFrom,
-	// we need to emit the approval event with the new allowance.
-	if isTransferFrom {
-		if err = p.EmitApprovalEvent(ctx, stateDB, from, spenderAddr, newAllowance); err != nil {
-			return nil, err
-		}
-	}
-
-	return method.Outputs.Pack(true)
+	return p.EmitTransferEvent(ctx, stateDB, from, to, amount)
 }
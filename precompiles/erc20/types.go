@@ -127,3 +127,207 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 
 	return account, nil
 }
+
+// EventAuthorizationUsed defines the event data for the ERC-3009
+// AuthorizationUsed events.
+type EventAuthorizationUsed struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
+// EventAuthorizationCanceled defines the event data for the ERC-3009
+// AuthorizationCanceled events.
+type EventAuthorizationCanceled struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
+// Signature defines the v, r and s values of an ECDSA signature, as passed
+// to the ERC-2612 and ERC-3009 methods.
+type Signature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// TransferWithAuthorizationInput defines the arguments of the ERC-3009
+// transferWithAuthorization and receiveWithAuthorization methods.
+type TransferWithAuthorizationInput struct {
+	From        common.Address
+	To          common.Address
+	Value       *big.Int
+	ValidAfter  *big.Int
+	ValidBefore *big.Int
+	Nonce       common.Hash
+	Signature   Signature
+}
+
+// ParsePermitArgs parses the arguments from the permit method and returns
+// the owner and spender addresses, the value, the deadline and the signature
+// of the owner.
+func ParsePermitArgs(args []interface{}) (
+	owner, spender common.Address, value, deadline *big.Int, sig Signature, err error,
+) {
+	if len(args) != 7 {
+		return common.Address{}, common.Address{}, nil, nil, Signature{}, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, Signature{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	spender, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, Signature{}, fmt.Errorf("invalid spender address: %v", args[1])
+	}
+
+	value, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, Signature{}, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	deadline, ok = args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, Signature{}, fmt.Errorf("invalid deadline: %v", args[3])
+	}
+
+	sig, err = parseSignature(args[4:])
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, nil, Signature{}, err
+	}
+
+	return owner, spender, value, deadline, sig, nil
+}
+
+// ParseTransferWithAuthorizationArgs parses the arguments from the
+// transferWithAuthorization and receiveWithAuthorization methods.
+func ParseTransferWithAuthorizationArgs(args []interface{}) (*TransferWithAuthorizationInput, error) {
+	if len(args) != 9 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 9; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	value, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	validAfter, ok := args[3].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid validAfter: %v", args[3])
+	}
+
+	validBefore, ok := args[4].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid validBefore: %v", args[4])
+	}
+
+	nonce, ok := args[5].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid nonce: %v", args[5])
+	}
+
+	sig, err := parseSignature(args[6:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferWithAuthorizationInput{
+		From:        from,
+		To:          to,
+		Value:       value,
+		ValidAfter:  validAfter,
+		ValidBefore: validBefore,
+		Nonce:       nonce,
+		Signature:   sig,
+	}, nil
+}
+
+// ParseCancelAuthorizationArgs parses the arguments from the
+// cancelAuthorization method and returns the authorizer address, the nonce
+// of the authorization and the signature of the authorizer.
+func ParseCancelAuthorizationArgs(args []interface{}) (
+	authorizer common.Address, nonce common.Hash, sig Signature, err error,
+) {
+	if len(args) != 5 {
+		return common.Address{}, common.Hash{}, Signature{}, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	authorizer, nonce, err = ParseAuthorizationStateArgs(args[:2])
+	if err != nil {
+		return common.Address{}, common.Hash{}, Signature{}, err
+	}
+
+	sig, err = parseSignature(args[2:])
+	if err != nil {
+		return common.Address{}, common.Hash{}, Signature{}, err
+	}
+
+	return authorizer, nonce, sig, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseAuthorizationStateArgs parses the authorizationState arguments and
+// returns the authorizer address and the nonce of the authorization.
+func ParseAuthorizationStateArgs(args []interface{}) (
+	authorizer common.Address, nonce common.Hash, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	authorizer, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid authorizer address: %v", args[0])
+	}
+
+	nonceBz, ok := args[1].([32]byte)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return authorizer, nonceBz, nil
+}
+
+// parseSignature parses the v, r and s arguments of a signature.
+func parseSignature(args []interface{}) (Signature, error) {
+	v, ok := args[0].(uint8)
+	if !ok {
+		return Signature{}, fmt.Errorf("invalid v: %v", args[0])
+	}
+
+	r, ok := args[1].([32]byte)
+	if !ok {
+		return Signature{}, fmt.Errorf("invalid r: %v", args[1])
+	}
+
+	s, ok := args[2].([32]byte)
+	if !ok {
+		return Signature{}, fmt.Errorf("invalid s: %v", args[2])
+	}
+
+	return Signature{V: v, R: r, S: s}, nil
+}
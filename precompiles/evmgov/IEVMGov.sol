@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IEVMGov contract's address.
+address constant EVM_GOV_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IEVMGov contract's instance.
+IEVMGov constant EVM_GOV_CONTRACT = IEVMGov(EVM_GOV_PRECOMPILE_ADDRESS);
+
+/// @dev AccessControlType defines the permission policy of an EVM operation.
+struct AccessControlType {
+    /// accessType is 0 for permissionless, 1 for restricted and 2 for
+    /// permissioned operations.
+    uint8 accessType;
+    /// accessControlList are the addresses blocked from performing the
+    /// operation if it is permissionless, or allowed to perform it if it is
+    /// permissioned.
+    address[] accessControlList;
+}
+
+/// @dev AccessControl defines the permission policy of the EVM.
+struct AccessControl {
+    /// create is the permission policy for creating contracts.
+    AccessControlType create;
+    /// call is the permission policy for calling contracts.
+    AccessControlType call;
+}
+
+/// @dev EVMParams defines the parameters of the x/vm module.
+struct EVMParams {
+    /// evmDenom is the denomination of the token used to run the EVM.
+    string evmDenom;
+    /// extraEIPs are the additional EIPs activated in the EVM.
+    int64[] extraEIPs;
+    /// allowUnprotectedTxs allows the execution of non EIP-155 signed transactions.
+    bool allowUnprotectedTxs;
+    /// evmChannels are the identifiers of the IBC channels to EVM compatible chains.
+    string[] evmChannels;
+    /// accessControl is the permission policy of the EVM.
+    AccessControl accessControl;
+    /// activeStaticPrecompiles are the addresses of the active static precompiles.
+    address[] activeStaticPrecompiles;
+}
+
+/// @dev ERC20Params defines the parameters of the x/erc20 module.
+struct ERC20Params {
+    /// enableErc20 enables the conversion of Cosmos coins to ERC20 tokens and
+    /// vice versa.
+    bool enableErc20;
+    /// permissionlessRegistration allows any account to register ERC20 tokens.
+    bool permissionlessRegistration;
+}
+
+/// @author Evmos Team
+/// @title EVM Governance Precompiled Contract
+/// @dev The interface through which the authority of the x/vm and x/erc20
+/// modules can update their parameters and token pairs from solidity.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IEVMGov {
+    /// @dev Emitted when the x/vm parameters are updated.
+    /// @param authority the address of the caller
+    event UpdateEVMParams(address indexed authority);
+
+    /// @dev Emitted when the x/erc20 parameters are updated.
+    /// @param authority the address of the caller
+    event UpdateERC20Params(address indexed authority);
+
+    /// @dev Emitted when a token pair is registered for an ERC20 contract.
+    /// @param authority the address of the caller
+    /// @param erc20Address the address of the ERC20 contract
+    event RegisterERC20(address indexed authority, address indexed erc20Address);
+
+    /// @dev Emitted when the conversion of a token pair is toggled.
+    /// @param authority the address of the caller
+    /// @param token the denomination or the ERC20 contract address of the token pair
+    event ToggleConversion(address indexed authority, string token);
+
+    /// @dev Updates the x/vm parameters. The caller must be the x/vm module
+    /// authority or the governance authority of the precompile.
+    /// @param params the new parameters
+    /// @return success true if the parameters were updated
+    function updateEVMParams(
+        EVMParams calldata params
+    ) external returns (bool success);
+
+    /// @dev Updates the x/erc20 parameters. The caller must be the x/erc20
+    /// module authority or the governance authority of the precompile.
+    /// @param params the new parameters
+    /// @return success true if the parameters were updated
+    function updateERC20Params(
+        ERC20Params calldata params
+    ) external returns (bool success);
+
+    /// @dev Registers token pairs for ERC20 contracts. The caller must be the
+    /// x/erc20 module authority or the governance authority of the precompile.
+    /// @param erc20Addresses the addresses of the ERC20 contracts
+    /// @return success true if the token pairs were registered
+    function registerERC20(
+        address[] calldata erc20Addresses
+    ) external returns (bool success);
+
+    /// @dev Enables or disables the conversion of a token pair. The caller must
+    /// be the x/erc20 module authority or the governance authority of the
+    /// precompile.
+    /// @param token the denomination or the ERC20 contract address of the token pair
+    /// @return success true if the conversion was toggled
+    function toggleConversion(
+        string calldata token
+    ) external returns (bool success);
+
+    /// @dev Queries the x/vm parameters.
+    /// @return params the parameters of the x/vm module
+    function evmParams() external view returns (EVMParams memory params);
+
+    /// @dev Queries the x/erc20 parameters.
+    /// @return params the parameters of the x/erc20 module
+    function erc20Params() external view returns (ERC20Params memory params);
+}
@@ -0,0 +1,13 @@
+package evmgov
+
+const (
+	// ErrUnauthorized is raised when the caller is neither the module authority
+	// nor the governance authority of the precompile.
+	ErrUnauthorized = "unauthorized: %s is not the authority %s"
+	// ErrInvalidParams is raised when the module parameters are not valid.
+	ErrInvalidParams = "invalid params: %v"
+	// ErrInvalidERC20Addresses is raised when the ERC20 contract addresses are not valid.
+	ErrInvalidERC20Addresses = "invalid ERC20 addresses: %v"
+	// ErrInvalidToken is raised when the token of a token pair is not valid.
+	ErrInvalidToken = "invalid token: %v"
+)
@@ -0,0 +1,86 @@
+package evmgov
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeUpdateEVMParams defines the event type for the UpdateEVMParams transaction.
+	EventTypeUpdateEVMParams = "UpdateEVMParams"
+	// EventTypeUpdateERC20Params defines the event type for the UpdateERC20Params transaction.
+	EventTypeUpdateERC20Params = "UpdateERC20Params"
+	// EventTypeRegisterERC20 defines the event type for the RegisterERC20 transaction.
+	EventTypeRegisterERC20 = "RegisterERC20"
+	// EventTypeToggleConversion defines the event type for the ToggleConversion transaction.
+	EventTypeToggleConversion = "ToggleConversion"
+)
+
+// EmitUpdateEVMParamsEvent creates a new event emitted on an UpdateEVMParams transaction.
+func (p Precompile) EmitUpdateEVMParamsEvent(ctx sdk.Context, stateDB vm.StateDB, authority common.Address) error {
+	return p.emitEvent(ctx, stateDB, EventTypeUpdateEVMParams, []interface{}{authority}, nil)
+}
+
+// EmitUpdateERC20ParamsEvent creates a new event emitted on an UpdateERC20Params transaction.
+func (p Precompile) EmitUpdateERC20ParamsEvent(ctx sdk.Context, stateDB vm.StateDB, authority common.Address) error {
+	return p.emitEvent(ctx, stateDB, EventTypeUpdateERC20Params, []interface{}{authority}, nil)
+}
+
+// EmitRegisterERC20Event creates a new event emitted for each token pair
+// registered on a RegisterERC20 transaction.
+func (p Precompile) EmitRegisterERC20Event(ctx sdk.Context, stateDB vm.StateDB, authority, erc20Address common.Address) error {
+	return p.emitEvent(ctx, stateDB, EventTypeRegisterERC20, []interface{}{authority, erc20Address}, nil)
+}
+
+// EmitToggleConversionEvent creates a new event emitted on a ToggleConversion transaction.
+func (p Precompile) EmitToggleConversionEvent(ctx sdk.Context, stateDB vm.StateDB, authority common.Address, token string) error {
+	return p.emitEvent(ctx, stateDB, EventTypeToggleConversion, []interface{}{authority}, []interface{}{token})
+}
+
+// emitEvent emits one of the EVM governance events, with the given indexed
+// arguments as topics and the other arguments as data.
+func (p Precompile) emitEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	indexed []interface{},
+	data []interface{},
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, len(indexed)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, arg := range indexed {
+		topics[i+1], err = cmn.MakeTopic(arg)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Pack the non-indexed arguments of the event
+	var packed []byte
+	if len(data) > 0 {
+		packed, err = event.Inputs.NonIndexed().Pack(data...)
+		if err != nil {
+			return err
+		}
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
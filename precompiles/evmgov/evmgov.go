@@ -0,0 +1,170 @@
+package evmgov
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the governance of the
+// x/vm and x/erc20 modules.
+type Precompile struct {
+	cmn.Precompile
+	evmKeeper   *evmkeeper.Keeper
+	erc20Keeper erc20keeper.Keeper
+	// authority is an optional address allowed to govern the modules in
+	// addition to their authorities, e.g. a DAO contract.
+	authority sdk.AccAddress
+}
+
+// LoadABI loads the EVM governance ABI from the embedded abi.json file
+// for the EVM governance precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new EVM governance Precompile instance as a
+// PrecompiledContract interface. The transactions can be executed by the
+// authorities of the x/vm and x/erc20 modules, or by the given authority if
+// it is not empty.
+func NewPrecompile(
+	evmKeeper *evmkeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
+	authority sdk.AccAddress,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		evmKeeper:   evmKeeper,
+		erc20Keeper: erc20Keeper,
+		authority:   authority,
+	}
+
+	// SetAddress defines the address of the EVM governance precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.EVMGovPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract EVM governance methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// EVM governance transactions
+	case UpdateEVMParamsMethod:
+		bz, err = p.UpdateEVMParams(ctx, contract, stateDB, method, args)
+	case UpdateERC20ParamsMethod:
+		bz, err = p.UpdateERC20Params(ctx, contract, stateDB, method, args)
+	case RegisterERC20Method:
+		bz, err = p.RegisterERC20(ctx, contract, stateDB, method, args)
+	case ToggleConversionMethod:
+		bz, err = p.ToggleConversion(ctx, contract, stateDB, method, args)
+
+	// EVM governance queries
+	case EVMParamsMethod:
+		bz, err = p.EVMParams(ctx, method, contract, args)
+	case ERC20ParamsMethod:
+		bz, err = p.ERC20Params(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case UpdateEVMParamsMethod, UpdateERC20ParamsMethod, RegisterERC20Method, ToggleConversionMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "evmgov")
+}
@@ -0,0 +1,49 @@
+package evmgov
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EVMParamsMethod defines the method name for the x/vm params precompile request.
+	EVMParamsMethod = "evmParams"
+	// ERC20ParamsMethod defines the method name for the x/erc20 params precompile request.
+	ERC20ParamsMethod = "erc20Params"
+)
+
+// EVMParams implements the query logic for getting the x/vm parameters.
+func (p *Precompile) EVMParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params := p.evmKeeper.GetParams(ctx)
+	return method.Outputs.Pack(NewEVMParams(params))
+}
+
+// ERC20Params implements the query logic for getting the x/erc20 parameters.
+func (p *Precompile) ERC20Params(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params := p.erc20Keeper.GetParams(ctx)
+	return method.Outputs.Pack(NewERC20Params(params))
+}
@@ -0,0 +1,161 @@
+package evmgov
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// UpdateEVMParamsMethod defines the ABI method name for the x/vm UpdateParams transaction.
+	UpdateEVMParamsMethod = "updateEVMParams"
+	// UpdateERC20ParamsMethod defines the ABI method name for the x/erc20 UpdateParams transaction.
+	UpdateERC20ParamsMethod = "updateERC20Params"
+	// RegisterERC20Method defines the ABI method name for the x/erc20 RegisterERC20 transaction.
+	RegisterERC20Method = "registerERC20"
+	// ToggleConversionMethod defines the ABI method name for the x/erc20 ToggleConversion transaction.
+	ToggleConversionMethod = "toggleConversion"
+)
+
+// UpdateEVMParams defines a method to update the x/vm parameters.
+func (p *Precompile) UpdateEVMParams(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authority := p.evmKeeper.GetAuthority()
+	if err := p.checkAuthority(contract.Caller(), authority); err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMsgUpdateEVMParams(method, args, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if _, err = p.evmKeeper.UpdateParams(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitUpdateEVMParamsEvent(ctx, stateDB, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UpdateERC20Params defines a method to update the x/erc20 parameters.
+func (p *Precompile) UpdateERC20Params(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authority := p.erc20Keeper.GetAuthority()
+	if err := p.checkAuthority(contract.Caller(), authority); err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMsgUpdateERC20Params(method, args, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if _, err = p.erc20Keeper.UpdateParams(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitUpdateERC20ParamsEvent(ctx, stateDB, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RegisterERC20 defines a method to register token pairs for ERC20 contracts.
+func (p *Precompile) RegisterERC20(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authority := p.erc20Keeper.GetAuthority()
+	if err := p.checkAuthority(contract.Caller(), authority); err != nil {
+		return nil, err
+	}
+
+	msg, erc20Addresses, err := NewMsgRegisterERC20(args, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if _, err = p.erc20Keeper.RegisterERC20(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	for _, erc20Address := range erc20Addresses {
+		if err = p.EmitRegisterERC20Event(ctx, stateDB, contract.Caller(), erc20Address); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ToggleConversion defines a method to enable or disable the conversion of a
+// token pair.
+func (p *Precompile) ToggleConversion(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authority := p.erc20Keeper.GetAuthority()
+	if err := p.checkAuthority(contract.Caller(), authority); err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMsgToggleConversion(args, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	if _, err = p.erc20Keeper.ToggleConversion(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitToggleConversionEvent(ctx, stateDB, contract.Caller(), msg.Token); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkAuthority returns an error if the caller is neither the given module
+// authority nor the governance authority of the precompile.
+func (p Precompile) checkAuthority(caller common.Address, moduleAuthority sdk.AccAddress) error {
+	callerAddr := sdk.AccAddress(caller.Bytes())
+	if callerAddr.Equals(moduleAuthority) {
+		return nil
+	}
+	if !p.authority.Empty() && callerAddr.Equals(p.authority) {
+		return nil
+	}
+
+	return fmt.Errorf(ErrUnauthorized, caller, common.BytesToAddress(moduleAuthority))
+}
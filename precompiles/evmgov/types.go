@@ -0,0 +1,246 @@
+package evmgov
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventUpdateParams defines the event data for the UpdateEVMParams and
+// UpdateERC20Params transactions.
+type EventUpdateParams struct {
+	Authority common.Address
+}
+
+// EventRegisterERC20 defines the event data for the RegisterERC20 transaction.
+type EventRegisterERC20 struct {
+	Authority    common.Address
+	Erc20Address common.Address
+}
+
+// EventToggleConversion defines the event data for the ToggleConversion transaction.
+type EventToggleConversion struct {
+	Authority common.Address
+	Token     string
+}
+
+// AccessControlType defines the permission policy of an EVM operation, as
+// used in the EVMParams struct.
+type AccessControlType struct {
+	AccessType        uint8
+	AccessControlList []common.Address
+}
+
+// AccessControl defines the permission policy of the EVM, as used in the
+// EVMParams struct.
+type AccessControl struct {
+	Create AccessControlType
+	Call   AccessControlType
+}
+
+// EVMParams defines the x/vm parameters, as used in the updateEVMParams and
+// evmParams methods.
+type EVMParams struct {
+	EvmDenom                string
+	ExtraEIPs               []int64
+	AllowUnprotectedTxs     bool
+	EvmChannels             []string
+	AccessControl           AccessControl
+	ActiveStaticPrecompiles []common.Address
+}
+
+// ERC20Params defines the x/erc20 parameters, as used in the
+// updateERC20Params and erc20Params methods.
+type ERC20Params struct {
+	EnableErc20                bool
+	PermissionlessRegistration bool
+}
+
+// evmParamsInput is a struct used to parse the params parameter used as
+// input in the updateEVMParams method.
+type evmParamsInput struct {
+	Params EVMParams
+}
+
+// erc20ParamsInput is a struct used to parse the params parameter used as
+// input in the updateERC20Params method.
+type erc20ParamsInput struct {
+	Params ERC20Params
+}
+
+// NewMsgUpdateEVMParams creates a new x/vm MsgUpdateParams instance with the
+// given authority and does sanity checks on the given arguments.
+func NewMsgUpdateEVMParams(method *abi.Method, args []interface{}, authority sdk.AccAddress) (*evmtypes.MsgUpdateParams, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input evmParamsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf(ErrInvalidParams, err)
+	}
+
+	msg := &evmtypes.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    input.Params.ToParams(),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidParams, err)
+	}
+
+	return msg, nil
+}
+
+// NewMsgUpdateERC20Params creates a new x/erc20 MsgUpdateParams instance with
+// the given authority and does sanity checks on the given arguments.
+func NewMsgUpdateERC20Params(method *abi.Method, args []interface{}, authority sdk.AccAddress) (*erc20types.MsgUpdateParams, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input erc20ParamsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf(ErrInvalidParams, err)
+	}
+
+	msg := &erc20types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    erc20types.NewParams(input.Params.EnableErc20, input.Params.PermissionlessRegistration),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidParams, err)
+	}
+
+	return msg, nil
+}
+
+// NewMsgRegisterERC20 creates a new MsgRegisterERC20 instance signed by the
+// given authority and does sanity checks on the given arguments.
+func NewMsgRegisterERC20(args []interface{}, authority sdk.AccAddress) (*erc20types.MsgRegisterERC20, []common.Address, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	erc20Addresses, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidERC20Addresses, args[0])
+	}
+	if len(erc20Addresses) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidERC20Addresses, "no addresses to register")
+	}
+
+	msg := &erc20types.MsgRegisterERC20{
+		Signer:         authority.String(),
+		Erc20Addresses: hexAddresses(erc20Addresses),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	return msg, erc20Addresses, nil
+}
+
+// NewMsgToggleConversion creates a new MsgToggleConversion instance with the
+// given authority and does sanity checks on the given arguments.
+func NewMsgToggleConversion(args []interface{}, authority sdk.AccAddress) (*erc20types.MsgToggleConversion, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(string)
+	if !ok || token == "" {
+		return nil, fmt.Errorf(ErrInvalidToken, args[0])
+	}
+
+	msg := &erc20types.MsgToggleConversion{
+		Authority: authority.String(),
+		Token:     token,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewEVMParams converts the x/vm parameters to the EVMParams struct.
+func NewEVMParams(params evmtypes.Params) EVMParams {
+	return EVMParams{
+		EvmDenom:            params.EvmDenom,
+		ExtraEIPs:           params.ExtraEIPs,
+		AllowUnprotectedTxs: params.AllowUnprotectedTxs,
+		EvmChannels:         params.EVMChannels,
+		AccessControl: AccessControl{
+			Create: newAccessControlType(params.AccessControl.Create),
+			Call:   newAccessControlType(params.AccessControl.Call),
+		},
+		ActiveStaticPrecompiles: params.GetActiveStaticPrecompilesAddrs(),
+	}
+}
+
+// NewERC20Params converts the x/erc20 parameters to the ERC20Params struct.
+func NewERC20Params(params erc20types.Params) ERC20Params {
+	return ERC20Params{
+		EnableErc20:                params.EnableErc20,
+		PermissionlessRegistration: params.PermissionlessRegistration,
+	}
+}
+
+// ToParams converts the EVMParams struct to the x/vm parameters. The active
+// static precompiles are sorted, as required by the parameters validation.
+func (p EVMParams) ToParams() evmtypes.Params {
+	precompiles := hexAddresses(p.ActiveStaticPrecompiles)
+	slices.Sort(precompiles)
+
+	return evmtypes.Params{
+		EvmDenom:            p.EvmDenom,
+		ExtraEIPs:           p.ExtraEIPs,
+		AllowUnprotectedTxs: p.AllowUnprotectedTxs,
+		EVMChannels:         p.EvmChannels,
+		AccessControl: evmtypes.AccessControl{
+			Create: p.AccessControl.Create.toAccessControlType(),
+			Call:   p.AccessControl.Call.toAccessControlType(),
+		},
+		ActiveStaticPrecompiles: precompiles,
+	}
+}
+
+// toAccessControlType converts the AccessControlType struct to the x/vm
+// access control type.
+func (act AccessControlType) toAccessControlType() evmtypes.AccessControlType {
+	return evmtypes.AccessControlType{
+		AccessType:        evmtypes.AccessType(act.AccessType),
+		AccessControlList: hexAddresses(act.AccessControlList),
+	}
+}
+
+// newAccessControlType converts the x/vm access control type to the
+// AccessControlType struct.
+func newAccessControlType(act evmtypes.AccessControlType) AccessControlType {
+	addresses := make([]common.Address, len(act.AccessControlList))
+	for i, addr := range act.AccessControlList {
+		addresses[i] = common.HexToAddress(addr)
+	}
+
+	return AccessControlType{
+		AccessType:        uint8(act.AccessType), //nolint:gosec // G115 // the access types are validated
+		AccessControlList: addresses,
+	}
+}
+
+// hexAddresses returns the hex encoding of the given addresses.
+func hexAddresses(addresses []common.Address) []string {
+	hexAddrs := make([]string, len(addresses))
+	for i, addr := range addresses {
+		hexAddrs[i] = addr.Hex()
+	}
+	return hexAddrs
+}
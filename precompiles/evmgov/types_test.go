@@ -0,0 +1,238 @@
+package evmgov
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewMsgUpdateEVMParams(t *testing.T) {
+	precompileABI, err := LoadABI()
+	require.NoError(t, err)
+	method := precompileABI.Methods[UpdateEVMParamsMethod]
+
+	authority := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+
+	defaultParams := evmtypes.DefaultParams()
+	params := NewEVMParams(defaultParams)
+	// unsorted precompiles are sorted before validation
+	params.ActiveStaticPrecompiles = []common.Address{
+		common.HexToAddress(evmtypes.StakingPrecompileAddress),
+		common.HexToAddress(evmtypes.P256PrecompileAddress),
+	}
+
+	invalidParams := NewEVMParams(defaultParams)
+	invalidParams.AccessControl.Call.AccessType = 3
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{params},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			name:    "invalid params",
+			args:    []interface{}{invalidParams},
+			wantErr: true,
+			errMsg:  "invalid params",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgUpdateEVMParams(&method, tc.args, authority)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, authority.String(), msg.Authority)
+			require.Equal(t, defaultParams.EvmDenom, msg.Params.EvmDenom)
+			require.Equal(t, []string{
+				common.HexToAddress(evmtypes.P256PrecompileAddress).Hex(),
+				common.HexToAddress(evmtypes.StakingPrecompileAddress).Hex(),
+			}, msg.Params.ActiveStaticPrecompiles)
+		})
+	}
+}
+
+func TestNewMsgUpdateERC20Params(t *testing.T) {
+	precompileABI, err := LoadABI()
+	require.NoError(t, err)
+	method := precompileABI.Methods[UpdateERC20ParamsMethod]
+
+	authority := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{ERC20Params{EnableErc20: true, PermissionlessRegistration: false}},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgUpdateERC20Params(&method, tc.args, authority)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, authority.String(), msg.Authority)
+			require.True(t, msg.Params.EnableErc20)
+			require.False(t, msg.Params.PermissionlessRegistration)
+		})
+	}
+}
+
+func TestNewMsgRegisterERC20(t *testing.T) {
+	authority := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	tokens := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{tokens},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			name:    "invalid addresses type",
+			args:    []interface{}{"0x1111111111111111111111111111111111111111"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidERC20Addresses, "0x1111111111111111111111111111111111111111"),
+		},
+		{
+			name:    "no addresses",
+			args:    []interface{}{[]common.Address{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidERC20Addresses, "no addresses to register"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, erc20Addresses, err := NewMsgRegisterERC20(tc.args, authority)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, authority.String(), msg.Signer)
+			require.Equal(t, tokens, erc20Addresses)
+			require.Equal(t, []string{tokens[0].Hex(), tokens[1].Hex()}, msg.Erc20Addresses)
+		})
+	}
+}
+
+func TestNewMsgToggleConversion(t *testing.T) {
+	authority := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{"0x1111111111111111111111111111111111111111"},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			name:    "invalid token type",
+			args:    []interface{}{0},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidToken, 0),
+		},
+		{
+			name:    "empty token",
+			args:    []interface{}{""},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidToken, ""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgToggleConversion(tc.args, authority)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, authority.String(), msg.Authority)
+			require.Equal(t, "0x1111111111111111111111111111111111111111", msg.Token)
+		})
+	}
+}
+
+func TestEVMParamsRoundTrip(t *testing.T) {
+	params := evmtypes.DefaultParams()
+	params.ActiveStaticPrecompiles = []string{
+		common.HexToAddress(evmtypes.P256PrecompileAddress).Hex(),
+		common.HexToAddress(evmtypes.StakingPrecompileAddress).Hex(),
+	}
+	params.AccessControl = evmtypes.AccessControl{
+		Create: evmtypes.AccessControlType{
+			AccessType:        evmtypes.AccessTypeRestricted,
+			AccessControlList: []string{},
+		},
+		Call: evmtypes.AccessControlType{
+			AccessType:        evmtypes.AccessTypePermissioned,
+			AccessControlList: []string{common.HexToAddress("0x1111111111111111111111111111111111111111").Hex()},
+		},
+	}
+
+	require.Equal(t, params.AccessControl, NewEVMParams(params).ToParams().AccessControl)
+	require.Equal(t, params.ActiveStaticPrecompiles, NewEVMParams(params).ToParams().ActiveStaticPrecompiles)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance defines a fee allowance granted by a granter to a grantee.
+struct Allowance {
+    /// granter is the address of the account paying the fees.
+    address granter;
+    /// grantee is the address of the account whose fees are paid.
+    address grantee;
+    /// allowanceType is the type URL of the basic or periodic allowance.
+    string allowanceType;
+    /// spendLimit is the maximum amount of fees the grantee can use.
+    /// It is empty if there is no limit.
+    Coin[] spendLimit;
+    /// expiration is the unix timestamp in seconds at which the allowance
+    /// expires. It is zero if the allowance doesn't expire.
+    int64 expiration;
+    /// period is the duration in seconds of a period of a periodic allowance.
+    /// It is zero for basic allowances.
+    int64 period;
+    /// periodSpendLimit is the maximum amount of fees the grantee can use in a period.
+    Coin[] periodSpendLimit;
+    /// periodCanSpend is the amount of fees left to use in the current period.
+    Coin[] periodCanSpend;
+    /// periodReset is the unix timestamp in seconds at which the current period ends.
+    int64 periodReset;
+    /// allowedMessages are the type URLs of the messages whose fees the
+    /// allowance pays. It is empty if it pays the fees of any message.
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// Cosmos SDK x/feegrant module.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Emitted when a fee allowance is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Grants a basic fee allowance, allowing the grantee to pay fees
+    /// from the granter's balance up to a spend limit.
+    /// @param granter the address of the granter, which must be the caller
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of fees the grantee can use, or
+    /// an empty array for no limit
+    /// @param expiration the unix timestamp in seconds at which the allowance
+    /// expires, or zero for no expiration
+    /// @param allowedMessages the type URLs of the messages whose fees the
+    /// allowance pays, or an empty array for any message
+    /// @return success true if the allowance was granted
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev Grants a periodic fee allowance, whose spend limit is reset every period.
+    /// @param granter the address of the granter, which must be the caller
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of fees the grantee can use over
+    /// all the periods, or an empty array for no limit
+    /// @param expiration the unix timestamp in seconds at which the allowance
+    /// expires, or zero for no expiration
+    /// @param period the duration of a period in seconds
+    /// @param periodSpendLimit the maximum amount of fees the grantee can use in a period
+    /// @param allowedMessages the type URLs of the messages whose fees the
+    /// allowance pays, or an empty array for any message
+    /// @return success true if the allowance was granted
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance of a grantee.
+    /// @param granter the address of the granter, which must be the caller
+    /// @param grantee the address of the grantee
+    /// @return success true if the allowance was revoked
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Queries the fee allowance of a grantee from a granter.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @return allowance the fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev Queries the fee allowances granted to a grantee.
+    /// @param grantee the address of the grantee
+    /// @param pageRequest the pagination of the query
+    /// @return allowances the fee allowances
+    /// @return pageResponse the pagination response
+    function allowances(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev Queries the fee allowances granted by a granter.
+    /// @param granter the address of the granter
+    /// @param pageRequest the pagination of the query
+    /// @return allowances the fee allowances
+    /// @return pageResponse the pagination response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+}
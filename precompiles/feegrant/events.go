@@ -0,0 +1,64 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrantAllowance defines the event type for the feegrant grant transactions.
+	EventTypeGrantAllowance = "GrantAllowance"
+	// EventTypeRevokeAllowance defines the event type for the feegrant RevokeAllowance transaction.
+	EventTypeRevokeAllowance = "RevokeAllowance"
+)
+
+// EmitGrantAllowanceEvent creates a new event emitted on the grant transactions.
+func (p Precompile) EmitGrantAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeGrantAllowance, granter, grantee)
+}
+
+// EmitRevokeAllowanceEvent creates a new event emitted on a RevokeAllowance transaction.
+func (p Precompile) EmitRevokeAllowanceEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeRevokeAllowance, granter, grantee)
+}
+
+// emitAllowanceEvent emits one of the feegrant events, which all have the
+// indexed granter and grantee as their only arguments.
+func (p Precompile) emitAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	granter, grantee common.Address,
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowanceMethod defines the method name for the allowance precompile request.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the method name for the allowances precompile request.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the method name for the allowances by granter precompile request.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance implements the query logic for getting the fee allowance of a grantee from a granter.
+func (p *Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowanceArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := NewAllowance(res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowance)
+}
+
+// Allowances implements the query logic for getting the fee allowances of a grantee.
+func (p *Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
+
+// AllowancesByGranter implements the query logic for getting the fee allowances of a granter.
+func (p *Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesByGranterArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
@@ -0,0 +1,118 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant GrantAllowance transaction.
+	GrantAllowanceMethod = "grantAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant GrantPeriodicAllowance transaction.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance defines a method to grant a BasicAllowance, allowing the
+// grantee to pay fees from the granter's balance up to a spend limit.
+func (p *Precompile) GrantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantAllowance(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// GrantPeriodicAllowance defines a method to grant a PeriodicAllowance, whose
+// spend limit is reset every period.
+func (p *Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantPeriodicAllowance(method, args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granter, grantee)
+}
+
+// RevokeAllowance defines a method to revoke the fee allowance of a grantee.
+func (p *Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantAllowance executes the given MsgGrantAllowance, after checking the
+// granter is the caller.
+func (p *Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegrant.MsgGrantAllowance,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,469 @@
+package feegrant
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// EventGrantAllowance defines the event data for the feegrant grant transactions.
+type EventGrantAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// EventRevokeAllowance defines the event data for the feegrant RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// Allowance defines the ABI representation of a fee allowance.
+type Allowance struct {
+	Granter          common.Address
+	Grantee          common.Address
+	AllowanceType    string
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	PeriodCanSpend   []cmn.Coin
+	PeriodReset      int64
+	AllowedMessages  []string
+}
+
+// GrantAllowanceInput defines the input for the GrantAllowance transaction.
+type GrantAllowanceInput struct {
+	Granter         common.Address
+	Grantee         common.Address
+	SpendLimit      []cmn.Coin
+	Expiration      int64
+	AllowedMessages []string
+}
+
+// GrantPeriodicAllowanceInput defines the input for the GrantPeriodicAllowance transaction.
+type GrantPeriodicAllowanceInput struct {
+	Granter          common.Address
+	Grantee          common.Address
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	AllowedMessages  []string
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesOutput defines the output for the allowances queries.
+type AllowancesOutput struct {
+	Allowances   []Allowance
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance with a
+// BasicAllowance and does sanity checks on the given arguments.
+func NewMsgGrantAllowance(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantAllowanceInput struct: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, basic, input.AllowedMessages, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance instance with a
+// PeriodicAllowance, whose first period starts at the given block time, and
+// does sanity checks on the given arguments.
+func NewMsgGrantPeriodicAllowance(
+	method *abi.Method,
+	args []interface{},
+	blockTime time.Time,
+	addrCdc address.Codec,
+) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	var input GrantPeriodicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantPeriodicAllowanceInput struct: %s", err)
+	}
+
+	granter, grantee, err := parseGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	if input.Period <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	}
+	period := time.Duration(input.Period) * time.Second
+
+	periodSpendLimit, err := parseSpendLimit(input.PeriodSpendLimit)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(period).UTC(),
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, periodic, input.AllowedMessages, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance and does
+// sanity checks on the given arguments.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+func ParseAllowanceArgs(args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    granteeAddr,
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments for the AllowancesByGranter query.
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    granterAddr,
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// FromGrants populates the AllowancesOutput from the grants returned by the
+// Allowances and AllowancesByGranter queries.
+func (o *AllowancesOutput) FromGrants(grants []*feegrant.Grant, pageRes *query.PageResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]Allowance, len(grants))
+	for i, g := range grants {
+		allowance, err := NewAllowance(g)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+// NewAllowance creates the ABI representation of a fee allowance from its grant.
+// The basic or periodic allowance of an AllowedMsgAllowance is unwrapped, along
+// with its allowed messages.
+func NewAllowance(grant *feegrant.Grant) (Allowance, error) {
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	allowanceAny := grant.Allowance
+	if allowanceAny == nil {
+		return Allowance{}, fmt.Errorf("empty allowance")
+	}
+
+	allowance := Allowance{
+		Granter:          common.BytesToAddress(granter),
+		Grantee:          common.BytesToAddress(grantee),
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+
+	if allowedMsgAllowance, ok := allowanceAny.GetCachedValue().(*feegrant.AllowedMsgAllowance); ok {
+		allowance.AllowedMessages = allowedMsgAllowance.AllowedMessages
+		allowanceAny = allowedMsgAllowance.Allowance
+		if allowanceAny == nil {
+			return Allowance{}, fmt.Errorf("empty allowance")
+		}
+	}
+	allowance.AllowanceType = allowanceAny.TypeUrl
+
+	switch a := allowanceAny.GetCachedValue().(type) {
+	case *feegrant.BasicAllowance:
+		allowance.setBasicAllowance(a)
+	case *feegrant.PeriodicAllowance:
+		allowance.setBasicAllowance(&a.Basic)
+		allowance.Period = int64(a.Period / time.Second)
+		allowance.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		allowance.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		allowance.PeriodReset = a.PeriodReset.Unix()
+	default:
+		return Allowance{}, fmt.Errorf("unexpected allowance type %s", allowanceAny.TypeUrl)
+	}
+
+	return allowance, nil
+}
+
+func (a *Allowance) setBasicAllowance(basic *feegrant.BasicAllowance) {
+	a.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		a.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// newBasicAllowance creates a BasicAllowance with the given spend limit and
+// expiration. An expiration of zero means the allowance doesn't expire.
+func newBasicAllowance(spendLimit []cmn.Coin, expiration int64) (*feegrant.BasicAllowance, error) {
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	coins, err := parseSpendLimit(spendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: coins,
+	}
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	return basic, nil
+}
+
+// newMsgGrantAllowance creates a new MsgGrantAllowance instance for the given
+// allowance, wrapped in an AllowedMsgAllowance if the allowed messages are set.
+func newMsgGrantAllowance(
+	granter, grantee common.Address,
+	allowance feegrant.FeeAllowanceI,
+	allowedMessages []string,
+	addrCdc address.Codec,
+) (*feegrant.MsgGrantAllowance, error) {
+	if len(allowedMessages) > 0 {
+		for _, msgTypeURL := range allowedMessages {
+			if msgTypeURL == "" {
+				return nil, fmt.Errorf(ErrInvalidAllowedMessages, allowedMessages)
+			}
+		}
+
+		var err error
+		allowance, err = feegrant.NewAllowedMsgAllowance(allowance, allowedMessages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	protoAllowance, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot proto marshal %T", allowance)
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(protoAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}, nil
+}
+
+// parseSpendLimit converts a spend limit into sdk.Coins. An empty spend limit
+// means no limit.
+func parseSpendLimit(spendLimit []cmn.Coin) (sdk.Coins, error) {
+	if len(spendLimit) == 0 {
+		return nil, nil
+	}
+
+	coins, err := cmn.NewSdkCoinsFromCoins(spendLimit)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err.Error())
+	}
+	return coins, nil
+}
+
+// parseGranterGrantee parses and checks the granter and grantee addresses.
+func parseGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// encodeGranterGrantee encodes the granter and grantee addresses with the given address codec.
+func encodeGranterGrantee(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
+
+// parsePageRequest returns the page request to use in the feegrant queries.
+func parsePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
@@ -0,0 +1,146 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestNewMsgGrantPeriodicAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	precompileABI, err := LoadABI()
+	require.NoError(t, err)
+	method := precompileABI.Methods[GrantPeriodicAllowanceMethod]
+
+	granterAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ethTxTypeURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+	spendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(1000)}}
+	periodSpendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+
+	tests := []struct {
+		name            string
+		args            []interface{}
+		wantErr         bool
+		errMsg          string
+		wantAllowedMsgs []string
+	}{
+		{
+			name: "valid without allowed messages",
+			args: []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(3600), periodSpendLimit, []string{}},
+		},
+		{
+			name:            "valid with allowed messages",
+			args:            []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(3600), periodSpendLimit, []string{ethTxTypeURL}},
+			wantAllowedMsgs: []string{ethTxTypeURL},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			name:    "empty granter address",
+			args:    []interface{}{common.Address{}, granteeAddr, spendLimit, int64(0), int64(3600), periodSpendLimit, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(-1), int64(3600), periodSpendLimit, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, int64(-1)),
+		},
+		{
+			name:    "zero period",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(0), periodSpendLimit, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriod, int64(0)),
+		},
+		{
+			name:    "empty allowed message",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(3600), periodSpendLimit, []string{""}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAllowedMessages, []string{""}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granter, grantee, err := NewMsgGrantPeriodicAllowance(&method, tt.args, blockTime, addrCodec)
+
+			if tt.wantErr {
+				require.EqualError(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granterAddr, granter)
+			require.Equal(t, granteeAddr, grantee)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+
+			if len(tt.wantAllowedMsgs) > 0 {
+				allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance)
+				require.True(t, ok)
+				require.Equal(t, tt.wantAllowedMsgs, allowedMsgAllowance.AllowedMessages)
+				allowance, err = allowedMsgAllowance.GetAllowance()
+				require.NoError(t, err)
+			}
+
+			periodic, ok := allowance.(*feegrant.PeriodicAllowance)
+			require.True(t, ok)
+			require.Equal(t, time.Hour, periodic.Period)
+			require.Equal(t, blockTime.Add(time.Hour), periodic.PeriodReset)
+			require.Equal(t, "100atest", periodic.PeriodSpendLimit.String())
+			require.Equal(t, "100atest", periodic.PeriodCanSpend.String())
+			require.Equal(t, "1000atest", periodic.Basic.SpendLimit.String())
+			require.Nil(t, periodic.Basic.Expiration)
+		})
+	}
+}
+
+func TestNewAllowance(t *testing.T) {
+	granter := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+	grantee := sdk.AccAddress(common.HexToAddress("0x0987654321098765432109876543210987654321").Bytes())
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ethTxTypeURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 1000)),
+		Expiration: &expiration,
+	}
+	allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(basic, []string{ethTxTypeURL})
+	require.NoError(t, err)
+
+	grant, err := feegrant.NewGrant(granter, grantee, allowedMsgAllowance)
+	require.NoError(t, err)
+
+	allowance, err := NewAllowance(&grant)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToAddress(granter), allowance.Granter)
+	require.Equal(t, common.BytesToAddress(grantee), allowance.Grantee)
+	require.Equal(t, sdk.MsgTypeURL(basic), allowance.AllowanceType)
+	require.Equal(t, []cmn.Coin{{Denom: "atest", Amount: big.NewInt(1000)}}, allowance.SpendLimit)
+	require.Equal(t, expiration.Unix(), allowance.Expiration)
+	require.Equal(t, int64(0), allowance.Period)
+	require.Empty(t, allowance.PeriodSpendLimit)
+	require.Equal(t, []string{ethTxTypeURL}, allowance.AllowedMessages)
+}
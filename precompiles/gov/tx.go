@@ -43,6 +43,7 @@ func (p *Precompile) SubmitProposal(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	res, err := govkeeper.NewMsgServerImpl(&p.govKeeper).SubmitProposal(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -73,6 +74,7 @@ func (p *Precompile) Deposit(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	if _, err = govkeeper.NewMsgServerImpl(&p.govKeeper).Deposit(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -102,6 +104,7 @@ func (p *Precompile) CancelProposal(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	if _, err = govkeeper.NewMsgServerImpl(&p.govKeeper).CancelProposal(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -131,6 +134,7 @@ func (p Precompile) Vote(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
 	if _, err = msgSrv.Vote(ctx, msg); err != nil {
 		return nil, err
@@ -161,6 +165,7 @@ func (p Precompile) VoteWeighted(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
 	}
 
+	cmn.TraceCosmosMsg(ctx, msg)
 	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
 	if _, err = msgSrv.VoteWeighted(ctx, msg); err != nil {
 		return nil, err
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IICA contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IICA contract's instance.
+IICA constant ICA_CONTRACT = IICA(ICA_PRECOMPILE_ADDRESS);
+
+/// @dev CosmosMsg defines a protobuf encoded Cosmos SDK message, executed by
+/// an interchain account on the host chain.
+struct CosmosMsg {
+    /// typeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+    string typeUrl;
+    /// value is the protobuf encoding of the message.
+    bytes value;
+}
+
+/// @author Evmos Team
+/// @title Interchain Accounts Controller Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// IBC interchain accounts controller module.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IICA {
+    /// @dev Emitted when the registration of an interchain account is initiated.
+    /// @param owner the address of the owner of the interchain account
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param channelId the identifier of the channel being opened
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string channelId
+    );
+
+    /// @dev Emitted when a transaction is sent to an interchain account.
+    /// @param owner the address of the owner of the interchain account
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param sequence the sequence of the packet sent
+    event SendTx(
+        address indexed owner,
+        string connectionId,
+        uint64 sequence
+    );
+
+    /// @dev Initiates the registration of an interchain account on the host
+    /// chain of a connection, by opening an unordered channel. The account is
+    /// registered once the channel handshake completes.
+    /// @param owner the address of the owner of the interchain account, which
+    /// must be the caller
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param version the JSON encoded channel metadata, or an empty string to
+    /// use the default metadata
+    /// @return channelId the identifier of the channel being opened
+    function registerInterchainAccount(
+        address owner,
+        string calldata connectionId,
+        string calldata version
+    ) external returns (string memory channelId);
+
+    /// @dev Sends a transaction to be executed by an interchain account on the
+    /// host chain. The acknowledgement or the timeout of the packet is delivered
+    /// to the contract set in the "src_callback" of the memo, if any.
+    /// @param owner the address of the owner of the interchain account, which
+    /// must be the caller
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param msgs the messages executed by the interchain account
+    /// @param memo the memo of the packet
+    /// @param relativeTimeout the timeout of the packet in nanoseconds, relative
+    /// to the current block time
+    /// @return sequence the sequence of the packet sent
+    function sendTx(
+        address owner,
+        string calldata connectionId,
+        CosmosMsg[] calldata msgs,
+        string calldata memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev Queries the address of an interchain account on the host chain.
+    /// @param owner the address of the owner of the interchain account
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @return accountAddress the address of the interchain account on the host
+    /// chain, or an empty string if it is not registered
+    function interchainAccount(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
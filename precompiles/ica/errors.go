@@ -0,0 +1,19 @@
+package ica
+
+const (
+	// ErrInvalidOwner is raised when the owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is not a string.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidVersion is raised when the channel version is not a string.
+	ErrInvalidVersion = "invalid version: %v"
+	// ErrInvalidMsgs is raised when the messages of a transaction are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrInvalidMemo is raised when the memo is not a string.
+	ErrInvalidMemo = "invalid memo: %v"
+	// ErrInvalidRelativeTimeout is raised when the relative timeout is not a uint64.
+	ErrInvalidRelativeTimeout = "invalid relative timeout: %v"
+	// ErrUnsupportedEncoding is raised when the interchain account channel
+	// doesn't use the protobuf encoding.
+	ErrUnsupportedEncoding = "unsupported encoding %s, the messages can only be sent to channels with the %s encoding"
+)
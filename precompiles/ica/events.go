@@ -0,0 +1,80 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICA RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICA SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on a RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, channelID string,
+) error {
+	return p.emitOwnerEvent(ctx, stateDB, EventTypeRegisterInterchainAccount, owner, connectionID, channelID)
+}
+
+// EmitSendTxEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID string,
+	sequence uint64,
+) error {
+	return p.emitOwnerEvent(ctx, stateDB, EventTypeSendTx, owner, connectionID, sequence)
+}
+
+// emitOwnerEvent emits one of the ICA events, which all have the indexed owner
+// as their only topic and the connection identifier as their first data
+// argument.
+func (p Precompile) emitOwnerEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	owner common.Address,
+	data ...interface{},
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterchainAccountMethod defines the method name for the interchainAccount precompile request.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount implements the query logic for getting the address of an
+// interchain account on the host chain. It returns an empty string if the
+// interchain account is not registered.
+func (p *Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := ParseInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return nil, err
+	}
+
+	address, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+
+	return method.Outputs.Pack(address)
+}
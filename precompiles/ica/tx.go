@@ -0,0 +1,126 @@
+package ica
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICA RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICA SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount defines a method to initiate the registration of
+// an interchain account on the host chain of a connection. The account is
+// registered once the channel handshake completes.
+func (p *Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgRegisterInterchainAccount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(p.icaControllerKeeper)
+	res, err := msgSrv.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId)
+}
+
+// SendTx defines a method to send a transaction executed by an interchain
+// account on the host chain. The acknowledgement or the timeout of the packet
+// is delivered by the IBC callbacks middleware to the contract set in the
+// "src_callback" of the memo.
+func (p *Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgSendTx(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	if err := p.validateEncoding(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	cmn.TraceCosmosMsg(ctx, msg)
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(p.icaControllerKeeper)
+	res, err := msgSrv.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// validateEncoding checks that the active channel of the interchain account,
+// if any, uses the protobuf encoding of the CosmosTx built by the precompile.
+func (p *Precompile) validateEncoding(ctx sdk.Context, msg *icacontrollertypes.MsgSendTx) error {
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return err
+	}
+
+	channelID, found := p.icaControllerKeeper.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		// the controller returns the error of the missing channel
+		return nil
+	}
+
+	version, found := p.icaControllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Encoding != icatypes.EncodingProtobuf {
+		return fmt.Errorf(ErrUnsupportedEncoding, metadata.Encoding, icatypes.EncodingProtobuf)
+	}
+
+	return nil
+}
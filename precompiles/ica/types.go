@@ -0,0 +1,171 @@
+package ica
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventRegisterInterchainAccount defines the event data for the
+// RegisterInterchainAccount transaction.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	ChannelId    string //nolint:revive
+}
+
+// EventSendTx defines the event data for the SendTx transaction.
+type EventSendTx struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	Sequence     uint64
+}
+
+// CosmosMsg defines a protobuf encoded Cosmos SDK message, as used in the
+// sendTx method.
+type CosmosMsg struct {
+	TypeUrl string //nolint:revive
+	Value   []byte
+}
+
+// msgsInput is a struct used to parse the msgs parameter used as input in the
+// sendTx method.
+type msgsInput struct {
+	Msgs []CosmosMsg
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// instance, opening an unordered channel, and does sanity checks on the given
+// arguments.
+func NewMsgRegisterInterchainAccount(args []interface{}) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, connectionID, err := parseOwnerAndConnectionID(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVersion, args[2])
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(
+		connectionID,
+		sdk.AccAddress(owner.Bytes()).String(),
+		version,
+		channeltypes.UNORDERED,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance, executing the given messages
+// in a protobuf encoded CosmosTx, and does sanity checks on the given
+// arguments.
+func NewMsgSendTx(method *abi.Method, args []interface{}) (*icacontrollertypes.MsgSendTx, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	owner, connectionID, err := parseOwnerAndConnectionID(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	var input msgsInput
+	msgsArg := abi.Arguments{method.Inputs[2]}
+	if err := msgsArg.Copy(&input, []interface{}{args[2]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, err)
+	}
+	if len(input.Msgs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, "no messages to send")
+	}
+
+	memo, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[3])
+	}
+
+	relativeTimeout, ok := args[4].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidRelativeTimeout, args[4])
+	}
+
+	data, err := NewCosmosTx(input.Msgs)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(
+		sdk.AccAddress(owner.Bytes()).String(),
+		connectionID,
+		relativeTimeout,
+		icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: memo,
+		},
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewCosmosTx returns the protobuf encoded CosmosTx executing the given
+// messages. The messages are not decoded, as they may not be registered on
+// the controller chain.
+func NewCosmosTx(msgs []CosmosMsg) ([]byte, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		if msg.TypeUrl == "" {
+			return nil, fmt.Errorf(ErrInvalidMsgs, fmt.Sprintf("empty type URL of message %d", i))
+		}
+		anys[i] = &codectypes.Any{TypeUrl: msg.TypeUrl, Value: msg.Value}
+	}
+
+	cosmosTx := &icatypes.CosmosTx{Messages: anys}
+	return cosmosTx.Marshal()
+}
+
+// ParseInterchainAccountArgs parses the arguments of the interchainAccount
+// query.
+func ParseInterchainAccountArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return parseOwnerAndConnectionID(args)
+}
+
+// parseOwnerAndConnectionID parses the owner and the connection identifier,
+// which are the first arguments of all the ICA methods.
+func parseOwnerAndConnectionID(args []interface{}) (common.Address, string, error) {
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	return owner, connectionID, nil
+}
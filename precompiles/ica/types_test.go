@@ -0,0 +1,167 @@
+package ica
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid with default version",
+			args: []interface{}{owner, "connection-0", ""},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "empty owner address",
+			args:    []interface{}{common.Address{}, "connection-0", ""},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOwner, common.Address{}),
+		},
+		{
+			name:    "invalid connection ID type",
+			args:    []interface{}{owner, 0, ""},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidConnectionID, 0),
+		},
+		{
+			name:    "invalid connection ID",
+			args:    []interface{}{owner, "invalid", ""},
+			wantErr: true,
+			errMsg:  "invalid connection ID",
+		},
+		{
+			name:    "invalid version type",
+			args:    []interface{}{owner, "connection-0", 0},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidVersion, 0),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, gotOwner, err := NewMsgRegisterInterchainAccount(tc.args)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, gotOwner)
+			require.Equal(t, sdk.AccAddress(owner.Bytes()).String(), msg.Owner)
+			require.Equal(t, "connection-0", msg.ConnectionId)
+			require.Equal(t, channeltypes.UNORDERED, msg.Ordering)
+		})
+	}
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	precompileABI, err := LoadABI()
+	require.NoError(t, err)
+	method := precompileABI.Methods[SendTxMethod]
+
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	msgs := []CosmosMsg{
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{1, 2, 3}},
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate", Value: []byte{4, 5, 6}},
+	}
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{owner, "connection-0", msgs, "memo", uint64(600_000_000_000)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "empty owner address",
+			args:    []interface{}{common.Address{}, "connection-0", msgs, "memo", uint64(600_000_000_000)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOwner, common.Address{}),
+		},
+		{
+			name:    "no messages",
+			args:    []interface{}{owner, "connection-0", []CosmosMsg{}, "memo", uint64(600_000_000_000)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgs, "no messages to send"),
+		},
+		{
+			name:    "empty type URL",
+			args:    []interface{}{owner, "connection-0", []CosmosMsg{{Value: []byte{1}}}, "memo", uint64(600_000_000_000)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgs, "empty type URL of message 0"),
+		},
+		{
+			name:    "invalid memo type",
+			args:    []interface{}{owner, "connection-0", msgs, 0, uint64(600_000_000_000)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMemo, 0),
+		},
+		{
+			name:    "invalid relative timeout type",
+			args:    []interface{}{owner, "connection-0", msgs, "memo", int64(1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidRelativeTimeout, int64(1)),
+		},
+		{
+			name:    "zero relative timeout",
+			args:    []interface{}{owner, "connection-0", msgs, "memo", uint64(0)},
+			wantErr: true,
+			errMsg:  "relative timeout cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, gotOwner, err := NewMsgSendTx(&method, tc.args)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, gotOwner)
+			require.Equal(t, sdk.AccAddress(owner.Bytes()).String(), msg.Owner)
+			require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+			require.Equal(t, "memo", msg.PacketData.Memo)
+
+			var cosmosTx icatypes.CosmosTx
+			require.NoError(t, cosmosTx.Unmarshal(msg.PacketData.Data))
+			require.Len(t, cosmosTx.Messages, len(msgs))
+			for i, msgAny := range cosmosTx.Messages {
+				require.Equal(t, msgs[i].TypeUrl, msgAny.TypeUrl)
+				require.Equal(t, msgs[i].Value, msgAny.Value)
+			}
+		})
+	}
+}
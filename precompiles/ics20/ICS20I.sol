@@ -25,6 +25,16 @@ struct Hop {
     string channelId;
 }
 
+/// @dev PacketStatus defines the status of a packet sent by the chain.
+enum PacketStatus {
+    /// the packet has not been sent yet
+    NotSent,
+    /// the packet has been sent and is waiting for an acknowledgement or a timeout
+    Pending,
+    /// the packet has been acknowledged or has timed out
+    Completed
+}
+
 /// @author Evmos Team
 /// @title ICS20 Transfer Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with IBC Transfer (ICS20)
@@ -73,6 +83,35 @@ interface ICS20I {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferV2 defines a method for performing IBC transfers of multiple tokens,
+    /// optionally forwarded through intermediate chains. Each token is sent in its own packet.
+    /// @param sourcePort the port on which the packets will be sent, For v2 packets, leave it empty.
+    /// @param sourceChannel the channel by which the packets will be sent, For v2 packets, set the client ID.
+    /// @param tokens the tokens to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the address of the receiver on the final destination chain
+    /// @param timeoutHeight the timeout height relative to the current block height.
+    /// The timeout is disabled when set to 0. It must be 0 for v2 packets.
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
+    /// The timeout is disabled when set to 0. It must be set for v2 packets.
+    /// @param memo optional memo, which must be a JSON object when forwarding
+    /// @param encoding optional encoding of the v2 packet data, defaults to JSON
+    /// @param forwarding the hops through which the tokens are forwarded from the destination chain
+    /// with the packet forward middleware, empty to not forward them
+    /// @return nextSequences sequence numbers of the transfer packets sent, in the order of the tokens
+    function transferV2(
+        string memory sourcePort,
+        string memory sourceChannel,
+        Coin[] memory tokens,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo,
+        string memory encoding,
+        Hop[] memory forwarding
+    ) external returns (uint64[] memory nextSequences);
+
     /// @dev denoms Defines a method for returning all denoms.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denoms(
@@ -95,4 +134,29 @@ interface ICS20I {
         string memory trace
     ) external view returns (string memory hash);
 
+    /// @dev PacketStatus defines a method for returning the status of a packet sent by the chain.
+    /// @param sourcePort the port on which the packet was sent, For v2 packets, leave it empty.
+    /// @param sourceChannel the channel by which the packet was sent, For v2 packets, set the client ID.
+    /// @param sequence the sequence number of the packet
+    function packetStatus(
+        string memory sourcePort,
+        string memory sourceChannel,
+        uint64 sequence
+    ) external view returns (PacketStatus status);
+
+    /// @dev TotalEscrow defines a method for returning the total amount of a denomination
+    /// escrowed by the chain in all its channels.
+    function totalEscrow(
+        string memory denom
+    ) external view returns (Coin memory amount);
+
+    /// @dev EscrowAddress defines a method for returning the address escrowing the native
+    /// tokens sent through a channel.
+    /// @param sourcePort the port of the channel, For v2 packets, leave it empty.
+    /// @param sourceChannel the channel, For v2 packets, set the client ID.
+    function escrowAddress(
+        string memory sourcePort,
+        string memory sourceChannel
+    ) external view returns (address escrow);
+
 }
@@ -13,6 +13,12 @@ const (
 	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %d"
 	// ErrInvalidMemo is raised when the memo is invalid.
 	ErrInvalidMemo = "invalid memo: %s"
+	// ErrInvalidEncoding is raised when the encoding is invalid.
+	ErrInvalidEncoding = "invalid encoding: %s"
+	// ErrInvalidForwardingMemo is raised when the memo of a forwarded transfer is not a JSON object.
+	ErrInvalidForwardingMemo = "memo must be a JSON object when forwarding: %s"
+	// ErrInvalidSequence is raised when the packet sequence is invalid.
+	ErrInvalidSequence = "invalid sequence: %v"
 	// ErrInvalidHash is raised when the hash is invalid.
 	ErrInvalidHash = "invalid hash: %s"
 	// ErrNoMatchingAllocation is raised when no matching allocation is found.
@@ -14,6 +14,7 @@ import (
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
+	channelkeeperv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/keeper"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -32,11 +33,12 @@ var f embed.FS
 
 type Precompile struct {
 	cmn.Precompile
-	bankKeeper     cmn.BankKeeper
-	stakingKeeper  stakingkeeper.Keeper
-	transferKeeper transferkeeper.Keeper
-	channelKeeper  *channelkeeper.Keeper
-	evmKeeper      *evmkeeper.Keeper
+	bankKeeper      cmn.BankKeeper
+	stakingKeeper   stakingkeeper.Keeper
+	transferKeeper  transferkeeper.Keeper
+	channelKeeper   *channelkeeper.Keeper
+	channelKeeperV2 *channelkeeperv2.Keeper
+	evmKeeper       *evmkeeper.Keeper
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -46,6 +48,7 @@ func NewPrecompile(
 	stakingKeeper stakingkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
+	channelKeeperV2 *channelkeeperv2.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 ) (*Precompile, error) {
 	newAbi, err := cmn.LoadABI(f, "abi.json")
@@ -59,11 +62,12 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 		},
-		bankKeeper:     bankKeeper,
-		transferKeeper: transferKeeper,
-		channelKeeper:  channelKeeper,
-		stakingKeeper:  stakingKeeper,
-		evmKeeper:      evmKeeper,
+		bankKeeper:      bankKeeper,
+		transferKeeper:  transferKeeper,
+		channelKeeper:   channelKeeper,
+		channelKeeperV2: channelKeeperV2,
+		stakingKeeper:   stakingKeeper,
+		evmKeeper:       evmKeeper,
 	}
 
 	// SetAddress defines the address of the ICS-20 compile contract.
@@ -117,6 +121,8 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, contract, stateDB, method, args)
+	case TransferV2Method:
+		bz, err = p.TransferV2(ctx, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomMethod:
 		bz, err = p.Denom(ctx, contract, method, args)
@@ -124,6 +130,12 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Denoms(ctx, contract, method, args)
 	case DenomHashMethod:
 		bz, err = p.DenomHash(ctx, contract, method, args)
+	case PacketStatusMethod:
+		bz, err = p.PacketStatus(ctx, contract, method, args)
+	case TotalEscrowMethod:
+		bz, err = p.TotalEscrow(ctx, contract, method, args)
+	case EscrowAddressMethod:
+		bz, err = p.EscrowAddress(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -150,9 +162,10 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferV2
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case TransferMethod:
+	case TransferMethod, TransferV2Method:
 		return true
 	default:
 		return false
@@ -4,9 +4,12 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,6 +24,30 @@ const (
 	// DenomHashMethod defines the ABI method name for the ICS20 DenomHash
 	// query.
 	DenomHashMethod = "denomHash"
+	// PacketStatusMethod defines the ABI method name for the ICS20 PacketStatus
+	// query.
+	PacketStatusMethod = "packetStatus"
+	// TotalEscrowMethod defines the ABI method name for the ICS20 TotalEscrow
+	// query.
+	TotalEscrowMethod = "totalEscrow"
+	// EscrowAddressMethod defines the ABI method name for the ICS20 EscrowAddress
+	// query.
+	EscrowAddressMethod = "escrowAddress"
+)
+
+// PacketStatus defines the status of a packet sent by the chain, as returned
+// by the packetStatus query.
+type PacketStatus uint8
+
+const (
+	// PacketStatusNotSent is the status of the packets that have not been sent yet.
+	PacketStatusNotSent PacketStatus = iota
+	// PacketStatusPending is the status of the packets waiting for an
+	// acknowledgement or a timeout.
+	PacketStatusPending
+	// PacketStatusCompleted is the status of the packets that have been
+	// acknowledged or have timed out.
+	PacketStatusCompleted
 )
 
 // Denom returns the requested denomination information.
@@ -90,3 +117,76 @@ func (p Precompile) DenomHash(
 
 	return method.Outputs.Pack(res.Hash)
 }
+
+// PacketStatus returns the status of a packet sent through an IBC v1 channel,
+// or an IBC v2 client. The packet commitment is deleted once the packet is
+// acknowledged or has timed out.
+func (p Precompile) PacketStatus(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sourcePort, sourceChannel, sequence, err := NewPacketStatusArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		commitment   []byte
+		nextSequence uint64
+	)
+	if channeltypes.IsChannelIDFormat(sourceChannel) {
+		commitment = p.channelKeeper.GetPacketCommitment(ctx, sourcePort, sourceChannel, sequence)
+		nextSequence, _ = p.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	} else {
+		commitment = p.channelKeeperV2.GetPacketCommitment(ctx, sourceChannel, sequence)
+		nextSequence, _ = p.channelKeeperV2.GetNextSequenceSend(ctx, sourceChannel)
+	}
+
+	status := PacketStatusNotSent
+	switch {
+	case len(commitment) > 0:
+		status = PacketStatusPending
+	case sequence > 0 && sequence < nextSequence:
+		status = PacketStatusCompleted
+	}
+
+	return method.Outputs.Pack(uint8(status))
+}
+
+// TotalEscrow returns the total amount of the given denomination escrowed in
+// all the channels.
+func (p Precompile) TotalEscrow(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := NewTotalEscrowArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := p.transferKeeper.GetTotalEscrowForDenom(ctx, denom)
+
+	return method.Outputs.Pack(cmn.Coin{Denom: amount.Denom, Amount: amount.Amount.BigInt()})
+}
+
+// EscrowAddress returns the address escrowing the native tokens sent through
+// the given channel, or IBC v2 client.
+func (p Precompile) EscrowAddress(
+	_ sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sourcePort, sourceChannel, err := NewEscrowAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow := transfertypes.GetEscrowAddress(sourcePort, sourceChannel)
+
+	return method.Outputs.Pack(common.BytesToAddress(escrow))
+}
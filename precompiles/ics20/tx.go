@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
@@ -23,6 +24,9 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferV2Method defines the ABI method name for the ICS20 TransferV2
+	// transaction.
+	TransferV2Method = "transferV2"
 )
 
 // validateV1TransferChannel does the following validation on an ibc v1 channel specified in a MsgTransfer:
@@ -100,28 +104,91 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
-	// If the channel is in v1 format, check if channel exists and is open
-	if channeltypes.IsChannelIDFormat(msg.SourceChannel) {
-		if err := p.validateV1TransferChannel(ctx, msg); err != nil {
+	if err := p.validateSourceChannel(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != sender {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
+	}
+
+	sequence, err := p.transfer(ctx, stateDB, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// TransferV2 implements the ICS20 transfers of multiple tokens, optionally
+// forwarded through intermediate chains. Each token is sent in its own packet,
+// as ICS20 packets only carry a single token.
+func (p *Precompile) TransferV2(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msgs, sender, err := NewMsgTransfers(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// all the messages share the same source port and channel
+	if err := p.validateSourceChannel(ctx, msgs[0]); err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != sender {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
+	}
+
+	sequences := make([]uint64, len(msgs))
+	for i, msg := range msgs {
+		sequences[i], err = p.transfer(ctx, stateDB, msg, sender)
+		if err != nil {
 			return nil, err
 		}
-		// otherwise, it’s a v2 packet, so perform client ID validation
-	} else if v2ClientIDErr := host.ClientIdentifierValidator(msg.SourceChannel); v2ClientIDErr != nil {
-		return nil, errorsmod.Wrapf(
+	}
+
+	return method.Outputs.Pack(sequences)
+}
+
+// validateSourceChannel checks that the source channel of the given MsgTransfer
+// is an open IBC v1 channel, or a valid client ID for IBC v2 packets.
+func (p *Precompile) validateSourceChannel(ctx sdk.Context, msg *transfertypes.MsgTransfer) error {
+	// If the channel is in v1 format, check if channel exists and is open
+	if channeltypes.IsChannelIDFormat(msg.SourceChannel) {
+		return p.validateV1TransferChannel(ctx, msg)
+	}
+
+	// otherwise, it’s a v2 packet, so perform client ID validation
+	if v2ClientIDErr := host.ClientIdentifierValidator(msg.SourceChannel); v2ClientIDErr != nil {
+		return errorsmod.Wrapf(
 			channeltypes.ErrInvalidChannel,
 			"invalid channel ID (%s) on v2 packet",
 			msg.SourceChannel,
 		)
 	}
 
-	msgSender := contract.Caller()
-	if msgSender != sender {
-		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
-	}
+	return nil
+}
 
+// transfer executes the given MsgTransfer and emits the IBCTransfer event. It
+// returns the sequence of the packet sent.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) (uint64, error) {
+	cmn.TraceCosmosMsg(ctx, msg)
 	res, err := p.transferKeeper.Transfer(ctx, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err = EmitIBCTransferEvent(
@@ -136,8 +203,8 @@ func (p *Precompile) Transfer(
 		msg.Token,
 		msg.Memo,
 	); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.Sequence)
+	return res.Sequence, nil
 }
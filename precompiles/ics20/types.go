@@ -1,6 +1,7 @@
 package ics20
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -68,6 +69,30 @@ type DenomsResponse struct {
 	PageResponse query.PageResponse
 }
 
+// ForwardingReceiver is the receiver set on the intermediate chains of the
+// forwarded transfers, which is ignored by the packet forward middleware.
+const ForwardingReceiver = "pfm"
+
+// forwardMetadata defines the packet forward middleware metadata of a hop.
+type forwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// forwardMemo defines the memo of a transfer forwarded by the packet forward
+// middleware.
+type forwardMemo struct {
+	Forward forwardMetadata `json:"forward"`
+}
+
+// forwarding is a struct used to parse the forwarding hops parameter used as
+// input in the transferV2 method.
+type forwarding struct {
+	Forwarding []transfertypes.Hop
+}
+
 // height is a struct used to parse the TimeoutHeight parameter
 // used as input in the transfer method
 type height struct {
@@ -140,6 +165,159 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// NewMsgTransfers returns the transfer messages of each token from the given
+// transferV2 arguments. When forwarding hops are given, the receiver and the
+// memo are set in the packet forward middleware metadata of the memo.
+func NewMsgTransfers(method *abi.Method, args []interface{}) ([]*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 10 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, errors.New(ErrInvalidSourcePort)
+	}
+
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, errors.New(ErrInvalidSourceChannel)
+	}
+
+	tokens, err := parseTokens(args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	sender, ok := args[3].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[3])
+	}
+
+	receiver, ok := args[4].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[4])
+	}
+
+	var input height
+	heightArg := abi.Arguments{method.Inputs[5]}
+	if err := heightArg.Copy(&input, []interface{}{args[5]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to TransferInput struct: %s", err)
+	}
+
+	timeoutTimestamp, ok := args[6].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[6])
+	}
+
+	memo, ok := args[7].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[7])
+	}
+
+	encoding, ok := args[8].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidEncoding, args[8])
+	}
+
+	var hops forwarding
+	hopsArg := abi.Arguments{method.Inputs[9]}
+	if err := hopsArg.Copy(&hops, []interface{}{args[9]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to Hop struct: %s", err)
+	}
+
+	if len(hops.Forwarding) > 0 {
+		memo, err = NewForwardingMemo(receiver, hops.Forwarding, memo)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		receiver = ForwardingReceiver
+	}
+
+	msgs := make([]*transfertypes.MsgTransfer, len(tokens))
+	for i, token := range tokens {
+		msg, err := CreateAndValidateMsgTransfer(sourcePort, sourceChannel, token, sdk.AccAddress(sender.Bytes()).String(), receiver, input.TimeoutHeight, timeoutTimestamp, memo)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		msg.Encoding = encoding
+		msgs[i] = msg
+	}
+
+	return msgs, sender, nil
+}
+
+// NewForwardingMemo returns the memo forwarding the tokens through the given
+// hops with the packet forward middleware, as described in
+// https://github.com/cosmos/ibc-apps/tree/main/middleware/packet-forward-middleware.
+// The given memo, which must be empty or a JSON object, is passed to the final
+// destination chain.
+func NewForwardingMemo(receiver string, hops []transfertypes.Hop, memo string) (string, error) {
+	var next json.RawMessage
+	if memo != "" {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(memo), &obj); err != nil || obj == nil {
+			return "", fmt.Errorf(ErrInvalidForwardingMemo, memo)
+		}
+		next = json.RawMessage(memo)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		if err := hops[i].Validate(); err != nil {
+			return "", err
+		}
+
+		hopReceiver := ForwardingReceiver
+		if i == len(hops)-1 {
+			hopReceiver = receiver
+		}
+
+		bz, err := json.Marshal(forwardMemo{
+			Forward: forwardMetadata{
+				Receiver: hopReceiver,
+				Port:     hops[i].PortId,
+				Channel:  hops[i].ChannelId,
+				Next:     next,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		next = bz
+	}
+
+	return string(next), nil
+}
+
+// parseTokens parses the tokens of the transferV2 arguments, keeping their
+// order. It returns an error if there are no tokens, or if any of them is
+// invalid or duplicated.
+func parseTokens(arg interface{}) ([]sdk.Coin, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, err)
+	}
+
+	if len(coins) == 0 {
+		return nil, errorsmod.Wrap(transfertypes.ErrInvalidAmount, "no tokens to transfer")
+	}
+
+	sortedCoins, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, err)
+	}
+
+	if !sortedCoins.IsValid() {
+		return nil, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, sortedCoins)
+	}
+
+	tokens := make([]sdk.Coin, len(coins))
+	for i, coin := range coins {
+		tokens[i] = coin.ToSDKType()
+	}
+
+	return tokens, nil
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -202,6 +380,64 @@ func NewDenomsRequest(method *abi.Method, args []interface{}) (*transfertypes.Qu
 	return req, nil
 }
 
+// NewPacketStatusArgs parses the packetStatus arguments and returns the source
+// port, the source channel and the sequence of the packet.
+func NewPacketStatusArgs(args []interface{}) (sourcePort, sourceChannel string, sequence uint64, err error) {
+	if len(args) != 3 {
+		return "", "", 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	sourcePort, sourceChannel, err = NewEscrowAddressArgs(args[:2])
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	sequence, ok := args[2].(uint64)
+	if !ok {
+		return "", "", 0, fmt.Errorf(ErrInvalidSequence, args[2])
+	}
+
+	return sourcePort, sourceChannel, sequence, nil
+}
+
+// NewEscrowAddressArgs parses the escrowAddress arguments and returns the
+// source port and the source channel. The source port defaults to the
+// transfer port.
+func NewEscrowAddressArgs(args []interface{}) (sourcePort, sourceChannel string, err error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return "", "", errors.New(ErrInvalidSourcePort)
+	}
+	if sourcePort == "" {
+		sourcePort = transfertypes.PortID
+	}
+
+	sourceChannel, ok = args[1].(string)
+	if !ok {
+		return "", "", errors.New(ErrInvalidSourceChannel)
+	}
+
+	return sourcePort, sourceChannel, nil
+}
+
+// NewTotalEscrowArgs parses the totalEscrow arguments and returns the denomination.
+func NewTotalEscrowArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidDenom, args[0])
+	}
+
+	return denom, nil
+}
+
 // NewDenomHashRequest returns a new denom hash request from the given arguments.
 func NewDenomHashRequest(args []interface{}) (*transfertypes.QueryDenomHashRequest, error) {
 	if len(args) != 1 {
@@ -0,0 +1,137 @@
+package ics20
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewForwardingMemo(t *testing.T) {
+	hops := []transfertypes.Hop{
+		{PortId: transfertypes.PortID, ChannelId: "channel-1"},
+		{PortId: transfertypes.PortID, ChannelId: "channel-2"},
+	}
+
+	tests := []struct {
+		name     string
+		receiver string
+		hops     []transfertypes.Hop
+		memo     string
+		expMemo  string
+		errMsg   string
+	}{
+		{
+			name:     "single hop",
+			receiver: "receiver",
+			hops:     hops[:1],
+			expMemo:  `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-1"}}`,
+		},
+		{
+			name:     "multiple hops",
+			receiver: "receiver",
+			hops:     hops,
+			expMemo:  `{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-2"}}}}`,
+		},
+		{
+			name:     "memo passed to the destination chain",
+			receiver: "receiver",
+			hops:     hops[:1],
+			memo:     `{"wasm":{}}`,
+			expMemo:  `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-1","next":{"wasm":{}}}}`,
+		},
+		{
+			name:     "fail - memo is not a JSON object",
+			receiver: "receiver",
+			hops:     hops[:1],
+			memo:     "memo",
+			errMsg:   fmt.Sprintf(ErrInvalidForwardingMemo, "memo"),
+		},
+		{
+			name:     "fail - null memo",
+			receiver: "receiver",
+			hops:     hops[:1],
+			memo:     "null",
+			errMsg:   fmt.Sprintf(ErrInvalidForwardingMemo, "null"),
+		},
+		{
+			name:     "fail - invalid hop",
+			receiver: "receiver",
+			hops:     []transfertypes.Hop{{PortId: transfertypes.PortID}},
+			errMsg:   "invalid hop source channel ID",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := NewForwardingMemo(tc.receiver, tc.hops, tc.memo)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expMemo, memo)
+		})
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	tests := []struct {
+		name      string
+		arg       interface{}
+		expTokens []sdk.Coin
+		errMsg    string
+	}{
+		{
+			name: "keeps the order of the tokens",
+			arg: []cmn.Coin{
+				{Denom: "bbb", Amount: big.NewInt(1)},
+				{Denom: "aaa", Amount: big.NewInt(2)},
+			},
+			expTokens: []sdk.Coin{sdk.NewInt64Coin("bbb", 1), sdk.NewInt64Coin("aaa", 2)},
+		},
+		{
+			name:   "fail - no tokens",
+			arg:    []cmn.Coin{},
+			errMsg: "no tokens to transfer",
+		},
+		{
+			name: "fail - duplicated tokens",
+			arg: []cmn.Coin{
+				{Denom: "aaa", Amount: big.NewInt(1)},
+				{Denom: "aaa", Amount: big.NewInt(2)},
+			},
+			errMsg: "invalid amount",
+		},
+		{
+			name:   "fail - zero amount",
+			arg:    []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(0)}},
+			errMsg: "invalid amount",
+		},
+		{
+			name:   "fail - invalid type",
+			arg:    "aaa",
+			errMsg: "invalid amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := parseTokens(tc.arg)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expTokens, tokens)
+		})
+	}
+}
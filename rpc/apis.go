@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
 	"github.com/cosmos/evm/types"
@@ -37,6 +38,7 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
 
 	apiVersion = "1.0"
 )
@@ -48,6 +50,7 @@ type APICreator = func(
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	txPool backend.TxPool,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -60,6 +63,7 @@ func init() {
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			_ backend.TxPool,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -77,7 +81,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer, backend.TxPool) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -87,7 +91,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ backend.TxPool) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -102,6 +106,7 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			_ backend.TxPool,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -118,8 +123,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			txPool backend.TxPool,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend.TxPool = txPool
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -134,6 +141,7 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			_ backend.TxPool,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -145,11 +153,29 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			_ backend.TxPool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend, evmBackend.GetConfig().JSONRPC.TraceFilterBlockRangeCap),
+					Public:    true,
+				},
+			}
+		},
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			_ backend.TxPool,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -170,13 +196,14 @@ func GetRPCAPIs(ctx *server.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	txPool backend.TxPool,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, txPool)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
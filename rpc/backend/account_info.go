@@ -74,19 +74,43 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	clientCtx := b.ClientCtx.WithHeight(height)
 
 	// query storage proofs
+	storageHash := common.Hash{} // NOTE: Cosmos EVM doesn't have a storage hash unless storage proofs are enabled.
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
 
-	for i, key := range storageKeys {
-		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+	if b.Cfg.JSONRPC.EnableStorageProofs {
+		// Merkle-Patricia storage proofs against the storage hash, which is not
+		// committed to by the block header, so the proofs are not verifiable
+		// against the chain state
+		res, err := b.QueryClient.StorageProof(ctx, &evmtypes.QueryStorageProofRequest{
+			Address: address.String(),
+			Keys:    storageKeys,
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		storageProofs[i] = rpctypes.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+		storageHash = common.HexToHash(res.StorageHash)
+		for i, proof := range res.Proofs {
+			storageProofs[i] = rpctypes.StorageResult{
+				Key:   proof.Key,
+				Value: (*hexutil.Big)(common.HexToHash(proof.Value).Big()),
+				Proof: GetHexStorageProof(proof.Proof),
+			}
+		}
+	} else {
+		// IAVL storage proofs
+		for i, key := range storageKeys {
+			hexKey := common.HexToHash(key)
+			valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+			if err != nil {
+				return nil, err
+			}
+
+			storageProofs[i] = rpctypes.StorageResult{
+				Key:   key,
+				Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+				Proof: GetHexProofs(proof),
+			}
 		}
 	}
 
@@ -118,7 +142,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
+		StorageHash:  storageHash,
 		StorageProof: storageProofs,
 	}, nil
 }
@@ -68,6 +68,7 @@ type EVMBackend interface {
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
 	TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error)
+	TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
 	BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error)
 	BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -111,24 +112,28 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber) ([]map[string]interface{}, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
 	Content() (map[string]map[string]map[string]*rpctypes.RPCTransaction, error)
-	ContentFrom(address common.Address) (map[string]map[string]map[string]*rpctypes.RPCTransaction, error)
+	ContentFrom(address common.Address) (map[string]map[string]*rpctypes.RPCTransaction, error)
 	Inspect() (map[string]map[string]map[string]string, error)
 	Status() (map[string]hexutil.Uint, error)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *evmtypes.TraceCallConfig) (interface{}, error)
+	IntermediateRoots(height rpctypes.BlockNumber, block *tmrpctypes.ResultBlock) ([]common.Hash, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -154,6 +159,13 @@ type ProcessBlocker func(
 	targetOneFeeHistory *rpctypes.OneFeeHistory,
 ) error
 
+// TxPool defines the application mempool read by the txpool namespace.
+type TxPool interface {
+	// TxPoolContent returns the pooled Ethereum transactions grouped by
+	// sender and sorted by nonce, split into the pending and queued ones.
+	TxPoolContent() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error)
+}
+
 // Backend implements the BackendI interface
 type Backend struct {
 	Ctx                 context.Context
@@ -166,6 +178,7 @@ type Backend struct {
 	AllowUnprotectedTxs bool
 	Indexer             cosmosevmtypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
+	TxPool              TxPool
 }
 
 func (b *Backend) GetConfig() config.Config {
@@ -6,15 +6,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -235,6 +239,10 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 	}
 
 	if args.To == nil {
+		if args.AuthorizationList != nil {
+			return args, errors.New("set code transaction cannot be used to create contracts")
+		}
+
 		// Contract creation
 		var input []byte
 		if args.Data != nil {
@@ -268,10 +276,11 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 			AccessList:           args.AccessList,
 			ChainID:              args.ChainID,
 			Nonce:                args.Nonce,
+			AuthorizationList:    args.AuthorizationList,
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -287,7 +296,13 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state and block overrides are applied before the estimation.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *evmtypes.StateOverride,
+	blockOverrides *evmtypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -310,6 +325,9 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 	}
+	if req.Overrides, req.BlockOverrides, err = encodeCallOverrides(overrides, blockOverrides); err != nil {
+		return 0, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -326,8 +344,12 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
+// The optional state and block overrides are applied before the call.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *evmtypes.StateOverride,
+	blockOverrides *evmtypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -345,6 +367,9 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 	}
+	if req.Overrides, req.BlockOverrides, err = encodeCallOverrides(overrides, blockOverrides); err != nil {
+		return nil, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -377,6 +402,134 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 simulates the given blocks of calls on top of the state of the
+// requested block, without committing them. The simulated blocks are returned
+// in the same format as the blocks of the chain, with the results of their
+// calls.
+func (b *Backend) SimulateV1(opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber) ([]map[string]interface{}, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QuerySimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	ctx := rpctypes.ContextWithHeight(resBlock.Block.Height)
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var simBlocks []evmtypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &simBlocks); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]map[string]interface{}, 0, len(simBlocks))
+	parent := resBlock.Block.Header
+	for _, simBlock := range simBlocks {
+		block, header, err := b.formatSimulatedBlock(simBlock, parent, opts.ReturnFullTransactions)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+		parent = header
+	}
+	return blocks, nil
+}
+
+// formatSimulatedBlock builds a synthetic header on top of the parent header
+// for a simulated block and formats the block like RPCBlockFromTendermintBlock
+// does, adding the results of its calls.
+func (b *Backend) formatSimulatedBlock(
+	simBlock evmtypes.SimBlockResult,
+	parent cmttypes.Header,
+	fullTx bool,
+) (map[string]interface{}, cmttypes.Header, error) {
+	txs := make(ethtypes.Transactions, len(simBlock.Transactions))
+	msgs := make([]*evmtypes.MsgEthereumTx, len(simBlock.Transactions))
+	for i, args := range simBlock.Transactions {
+		msgs[i] = args.ToTransaction()
+		if msgs[i] == nil {
+			return nil, cmttypes.Header{}, fmt.Errorf("invalid simulated transaction %d", i)
+		}
+		txs[i] = msgs[i].AsTransaction()
+	}
+
+	header := parent
+	header.Height = int64(simBlock.Number)                 //#nosec G115 -- checked for int overflow already
+	header.Time = time.Unix(int64(simBlock.Time), 0).UTC() //#nosec G115 -- checked for int overflow already
+	header.LastBlockID = cmttypes.BlockID{Hash: parent.Hash()}
+	header.DataHash = ethtypes.DeriveSha(txs, trie.NewStackTrie(nil)).Bytes()
+	header.AppHash = nil
+	blockHash := common.BytesToHash(header.Hash())
+
+	var bloom ethtypes.Bloom
+	for _, call := range simBlock.Calls {
+		for _, log := range call.Logs {
+			log.BlockHash = blockHash
+			bloom.Add(log.Address.Bytes())
+			for _, topic := range log.Topics {
+				bloom.Add(topic.Bytes())
+			}
+		}
+	}
+
+	var baseFee *big.Int
+	if simBlock.BaseFee != nil {
+		baseFee = simBlock.BaseFee.ToInt()
+	}
+
+	ethRPCTxs := make([]interface{}, 0, len(txs))
+	for i, tx := range txs {
+		if !fullTx {
+			ethRPCTxs = append(ethRPCTxs, tx.Hash())
+			continue
+		}
+		rpcTx, err := rpctypes.NewRPCTransaction(
+			msgs[i],
+			blockHash,
+			uint64(simBlock.Number),
+			uint64(i), //#nosec G115 -- checked for int overflow already
+			baseFee,
+			b.EvmChainID,
+		)
+		if err != nil {
+			return nil, cmttypes.Header{}, err
+		}
+		ethRPCTxs = append(ethRPCTxs, rpcTx)
+	}
+
+	block := rpctypes.FormatBlock(
+		header, 0,
+		int64(simBlock.GasLimit), //#nosec G115 -- a gas limit above max int64 is formatted back to uint64
+		new(big.Int).SetUint64(uint64(simBlock.GasUsed)),
+		ethRPCTxs, bloom, simBlock.FeeRecipient, baseFee,
+	)
+	block["calls"] = simBlock.Calls
+	return block, header, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -411,6 +564,22 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	return (*hexutil.Big)(result), nil
 }
 
+// encodeCallOverrides returns the json encoded state and block overrides of a
+// call, nil if not set.
+func encodeCallOverrides(overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides) (overridesBz, blockOverridesBz []byte, err error) {
+	if overrides != nil {
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if blockOverridesBz, err = json.Marshal(blockOverrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	return overridesBz, blockOverridesBz, nil
+}
+
 // handleRevertError returns revert related error.
 func handleRevertError(vmError string, ret []byte) error {
 	if len(vmError) > 0 {
@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -29,6 +31,30 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogsFromIndex returns the logs within the block range matching the addresses and
+// topics from the log index of the custom indexer. It returns false if the indexer
+// doesn't index logs or doesn't cover the whole range.
+func (b *Backend) GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error) {
+	logIndexer, ok := b.Indexer.(cosmosevmtypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+
+	first, last, err := logIndexer.LogIndexRange()
+	if err != nil {
+		return nil, false, err
+	}
+	if first < 0 || from < first || to > last {
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.GetLogs(from, to, addresses, topics, limit, int(b.Cfg.JSONRPC.LogIndexScanCap))
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
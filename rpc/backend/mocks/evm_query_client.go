@@ -269,6 +269,36 @@ func (_m *EVMQueryClient) GlobalMinGasPrice(ctx context.Context, in *types.Query
 	return r0, r1
 }
 
+// IntermediateRoots provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) IntermediateRoots(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryIntermediateRootsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryIntermediateRootsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceBlockRequest, ...grpc.CallOption) *types.QueryIntermediateRootsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryIntermediateRootsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceBlockRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Config provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Config(ctx context.Context, in *types.QueryConfigRequest, opts ...grpc.CallOption) (*types.QueryConfigResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -380,6 +410,43 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// SimulateV1 provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateV1(ctx context.Context, in *types.QuerySimulateV1Request, opts ...grpc.CallOption) (*types.QuerySimulateV1Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateV1")
+	}
+
+	var r0 *types.QuerySimulateV1Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySimulateV1Request, ...grpc.CallOption) (*types.QuerySimulateV1Response, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySimulateV1Request, ...grpc.CallOption) *types.QuerySimulateV1Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySimulateV1Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySimulateV1Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -417,6 +484,43 @@ func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageReq
 	return r0, r1
 }
 
+// StorageProof provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageProof(ctx context.Context, in *types.QueryStorageProofRequest, opts ...grpc.CallOption) (*types.QueryStorageProofResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StorageProof")
+	}
+
+	var r0 *types.QueryStorageProofResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageProofRequest, ...grpc.CallOption) (*types.QueryStorageProofResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageProofRequest, ...grpc.CallOption) *types.QueryStorageProofResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageProofResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageProofRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -454,6 +558,43 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBl
 	return r0, r1
 }
 
+// TraceCall provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *types.QueryTraceCallRequest, opts ...grpc.CallOption) (*types.QueryTraceCallResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TraceCall")
+	}
+
+	var r0 *types.QueryTraceCallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) (*types.QueryTraceCallResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) *types.QueryTraceCallResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryTraceCallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceTx provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxRequest, opts ...grpc.CallOption) (*types.QueryTraceTxResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -77,6 +77,66 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// FeeToken provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeToken(ctx context.Context, in *types.QueryFeeTokenRequest, opts ...grpc.CallOption) (*types.QueryFeeTokenResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeTokenRequest, ...grpc.CallOption) *types.QueryFeeTokenResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeTokenResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeTokenRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeTokens provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeTokens(ctx context.Context, in *types.QueryFeeTokensRequest, opts ...grpc.CallOption) (*types.QueryFeeTokensResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeTokensResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeTokensRequest, ...grpc.CallOption) *types.QueryFeeTokensResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeTokensResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeTokensRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
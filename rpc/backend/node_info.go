@@ -69,13 +69,14 @@ func (b *Backend) Syncing() (interface{}, error) {
 		return false, err
 	}
 
-	if !status.SyncInfo.CatchingUp {
+	progress, syncing := rpctypes.SyncProgress(status.SyncInfo)
+	if !syncing {
 		return false, nil
 	}
 
 	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
+		"startingBlock": hexutil.Uint64(progress.StartingBlock),
+		"currentBlock":  hexutil.Uint64(progress.CurrentBlock),
 		// "highestBlock":  nil, // NA
 		// "pulledStates":  nil, // NA
 		// "knownStates":   nil, // NA
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -150,10 +151,64 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
+	ctxWithHeight, traceBlockRequest, err := b.traceBlockRequest(height, config, block)
+	if traceBlockRequest == nil || err != nil {
+		return nil, err
+	}
+
+	res, err := b.QueryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+
+	return decodedResults, nil
+}
+
+// IntermediateRoots replays the Ethereum transactions of the given block and
+// returns the dirty-state digest after each one of them.
+func (b *Backend) IntermediateRoots(height rpctypes.BlockNumber, block *tmrpctypes.ResultBlock) ([]common.Hash, error) {
+	if len(block.Block.Txs) == 0 {
+		return []common.Hash{}, nil
+	}
+
+	ctxWithHeight, traceBlockRequest, err := b.traceBlockRequest(height, nil, block)
+	if err != nil {
+		return nil, err
+	}
+	if traceBlockRequest == nil {
+		return nil, fmt.Errorf("block result not found for height %d", block.Block.Height)
+	}
+
+	res, err := b.QueryClient.IntermediateRoots(ctxWithHeight, traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make([]common.Hash, len(res.Roots))
+	for i, digest := range res.Roots {
+		digests[i] = common.BytesToHash(digest)
+	}
+	return digests, nil
+}
+
+// traceBlockRequest builds the request to replay the Ethereum transactions of
+// the given block, along with the query context at the beginning of the block.
+// The request is nil if the block result is not found.
+func (b *Backend) traceBlockRequest(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+) (context.Context, *evmtypes.QueryTraceBlockRequest, error) {
+	txs := block.Block.Txs
+
 	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		b.Logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
-		return nil, nil
+		return nil, nil, nil
 	}
 	txDecoder := b.ClientCtx.TxConfig.TxDecoder()
 
@@ -189,12 +244,12 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
 	if !ok {
-		return nil, errors.New("invalid rpc client")
+		return nil, nil, errors.New("invalid rpc client")
 	}
 
 	cp, err := nc.ConsensusParams(b.Ctx, &block.Block.Height)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
@@ -208,15 +263,53 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
 	}
 
-	res, err := b.QueryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	return ctxWithHeight, traceBlockRequest, nil
+}
+
+// TraceCall returns the structured logs created during the execution of the given
+// call against the state of the requested block. The optional state and block
+// overrides of the config are applied before the execution.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	config *evmtypes.TraceCallConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	header, err := b.TendermintHeaderByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
 
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+	req := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+	if config != nil {
+		req.TraceConfig = &config.TraceConfig
+		if req.Overrides, req.BlockOverrides, err = encodeCallOverrides(config.StateOverrides, config.BlockOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	traceResult, err := b.QueryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	if err != nil {
 		return nil, err
 	}
 
-	return decodedResults, nil
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
 }
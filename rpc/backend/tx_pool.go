@@ -1,43 +1,154 @@
 package backend
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // Content returns the transactions contained within the transaction pool
 func (b *Backend) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for sender, txs := range pending {
+		dump, err := b.rpcTxsByNonce(txs)
+		if err != nil {
+			return nil, err
+		}
+		content["pending"][sender.Hex()] = dump
+	}
+	for sender, txs := range queued {
+		dump, err := b.rpcTxsByNonce(txs)
+		if err != nil {
+			return nil, err
+		}
+		content["queued"][sender.Hex()] = dump
+	}
 	return content, nil
 }
 
 // ContentFrom returns the transactions contained within the transaction pool
-func (b *Backend) ContentFrom(_ common.Address) (map[string]map[string]map[string]*types.RPCTransaction, error) {
-	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+// that were sent by the given address, keyed by nonce.
+func (b *Backend) ContentFrom(addr common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingDump, err := b.rpcTxsByNonce(pending[addr])
+	if err != nil {
+		return nil, err
+	}
+	queuedDump, err := b.rpcTxsByNonce(queued[addr])
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]*types.RPCTransaction{
+		"pending": pendingDump,
+		"queued":  queuedDump,
 	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an easily inspectable list.
 func (b *Backend) Inspect() (map[string]map[string]map[string]string, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	inspect := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for sender, txs := range pending {
+		inspect["pending"][sender.Hex()] = inspectTxsByNonce(txs)
+	}
+	for sender, txs := range queued {
+		inspect["queued"][sender.Hex()] = inspectTxsByNonce(txs)
+	}
 	return inspect, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (b *Backend) Status() (map[string]hexutil.Uint, error) {
+	pending, queued, err := b.txPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCount, queuedCount int
+	for _, txs := range pending {
+		pendingCount += len(txs)
+	}
+	for _, txs := range queued {
+		queuedCount += len(txs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pendingCount), //nolint:gosec // G115 // won't exceed uint
+		"queued":  hexutil.Uint(queuedCount),  //nolint:gosec // G115 // won't exceed uint
 	}, nil
 }
+
+// txPoolContent returns the pending and queued Ethereum transactions of the
+// application mempool grouped by sender.
+func (b *Backend) txPoolContent() (
+	pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error,
+) {
+	if b.TxPool == nil {
+		return nil, nil, errors.New("the application mempool is not available")
+	}
+	return b.TxPool.TxPoolContent()
+}
+
+// rpcTxsByNonce converts the given transactions into their RPC representation
+// keyed by the decimal nonce, as done by the geth txpool namespace.
+func (b *Backend) rpcTxsByNonce(msgs []*evmtypes.MsgEthereumTx) (map[string]*types.RPCTransaction, error) {
+	dump := make(map[string]*types.RPCTransaction, len(msgs))
+	for _, msg := range msgs {
+		rpcTx, err := types.NewTransactionFromMsg(
+			msg,
+			common.Hash{},
+			uint64(0),
+			uint64(0),
+			nil,
+			b.EvmChainID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		dump[fmt.Sprintf("%d", uint64(rpcTx.Nonce))] = rpcTx
+	}
+	return dump, nil
+}
+
+// inspectTxsByNonce returns a short textual summary of the given transactions
+// keyed by the decimal nonce, as done by the geth txpool namespace.
+func inspectTxsByNonce(msgs []*evmtypes.MsgEthereumTx) map[string]string {
+	dump := make(map[string]string, len(msgs))
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		var summary string
+		if to := tx.To(); to != nil {
+			summary = fmt.Sprintf("%s: %d wei + %d gas × %d wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		} else {
+			summary = fmt.Sprintf("contract creation: %d wei + %d gas × %d wei", tx.Value(), tx.Gas(), tx.GasPrice())
+		}
+		dump[fmt.Sprintf("%d", tx.Nonce())] = summary
+	}
+	return dump
+}
@@ -342,3 +342,13 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// GetHexStorageProof returns the hex encoded trie nodes of a Merkle-Patricia
+// storage proof.
+func GetHexStorageProof(proof [][]byte) []string {
+	proofs := make([]string, len(proof))
+	for i, node := range proof {
+		proofs[i] = hexutil.Encode(node)
+	}
+	return proofs
+}
@@ -0,0 +1,246 @@
+package limiter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// ErrCodeInvalidRequest is the JSON-RPC error code of the calls of a
+	// batch request that are not valid requests.
+	ErrCodeInvalidRequest = -32600
+	// ErrCodeMethodNotAllowed is the JSON-RPC error code of the calls of the
+	// methods that are not allowed, which is the one of the methods that
+	// don't exist.
+	ErrCodeMethodNotAllowed = -32601
+	// ErrCodeLimitExceeded is the JSON-RPC error code of the calls exceeding
+	// the rate limit of the client, as defined in EIP-1474.
+	ErrCodeLimitExceeded = -32005
+
+	// maxRequestContentLength is the maximum size of the requests read by the
+	// handler, which is the one of the go-ethereum RPC server.
+	maxRequestContentLength = 5 * 1024 * 1024
+)
+
+// call is the subset of a JSON-RPC request used to limit a call.
+type call struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+
+	// invalid is true if the call of a batch request is not a valid request.
+	invalid bool
+}
+
+// isNotification returns true if the call has no ID, so that it doesn't
+// expect any response.
+func (c call) isNotification() bool {
+	return len(c.ID) == 0 && !c.invalid
+}
+
+// errorResponse is the JSON-RPC response of a rejected call.
+type errorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   errorMessage    `json:"error"`
+}
+
+// errorMessage is the JSON-RPC error of a rejected call.
+type errorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Handler wraps the given JSON-RPC HTTP handler to reject the calls of the
+// methods that are not allowed and the calls exceeding the rate limit of the
+// client. The other calls of a batch request are still served.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		raws, calls, batch, ok := parseCalls(body)
+		if !ok {
+			// let the server answer the invalid requests
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		client := l.Client(r)
+
+		var (
+			accepted []json.RawMessage
+			rejected []errorResponse
+		)
+		for i, c := range calls {
+			resp, ok := l.check(client, c)
+			switch {
+			case ok:
+				accepted = append(accepted, raws[i])
+			case !c.isNotification():
+				rejected = append(rejected, resp)
+			}
+		}
+
+		switch {
+		case len(accepted) == len(calls):
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		case len(accepted) > 0:
+			l.serveBatch(next, w, r, accepted, rejected)
+		case len(rejected) == 0:
+			// only notifications were rejected, which expect no response
+			w.WriteHeader(http.StatusOK)
+		case !batch:
+			writeJSON(w, rejected[0])
+		default:
+			writeJSON(w, rejected)
+		}
+	})
+}
+
+// check returns whether the given call is accepted, or the error response of
+// the call if it is rejected.
+func (l *Limiter) check(client string, c call) (errorResponse, bool) {
+	if c.invalid {
+		return newErrorResponse(json.RawMessage("null"), ErrCodeInvalidRequest, "invalid request"), false
+	}
+
+	if !l.Allowed(c.Method) {
+		return newErrorResponse(c.ID, ErrCodeMethodNotAllowed, fmt.Sprintf("the method %s is not allowed", c.Method)), false
+	}
+
+	if !l.Allow(client, l.Cost(c.Method)) {
+		return newErrorResponse(c.ID, ErrCodeLimitExceeded, fmt.Sprintf("rate limit exceeded for the method %s", c.Method)), false
+	}
+
+	return errorResponse{}, true
+}
+
+// serveBatch serves the accepted calls of a batch request and adds the error
+// responses of the rejected calls to the responses of the server.
+func (l *Limiter) serveBatch(next http.Handler, w http.ResponseWriter, r *http.Request, accepted []json.RawMessage, rejected []errorResponse) {
+	body, err := json.Marshal(accepted)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+
+	rec := &responseBuffer{header: w.Header()}
+	next.ServeHTTP(rec, r)
+
+	var responses []json.RawMessage
+	// the server answers with an empty body if all the accepted calls are
+	// notifications
+	if len(bytes.TrimSpace(rec.body.Bytes())) > 0 {
+		if err := json.Unmarshal(rec.body.Bytes(), &responses); err != nil {
+			// forward the error of the server, e.g. if the batch is too large
+			w.WriteHeader(rec.statusCode())
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+	}
+
+	for _, resp := range rejected {
+		bz, err := json.Marshal(resp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		responses = append(responses, bz)
+	}
+
+	writeJSON(w, responses)
+}
+
+// responseBuffer is a http.ResponseWriter buffering the response of the
+// server.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+// Header implements http.ResponseWriter.
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+// Write implements http.ResponseWriter.
+func (b *responseBuffer) Write(bz []byte) (int, error) {
+	return b.body.Write(bz)
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (b *responseBuffer) WriteHeader(status int) {
+	b.status = status
+}
+
+// statusCode returns the status code written by the server.
+func (b *responseBuffer) statusCode() int {
+	if b.status == 0 {
+		return http.StatusOK
+	}
+	return b.status
+}
+
+// parseCalls decodes a single JSON-RPC call or a batch of calls, and returns
+// the raw calls, the decoded calls and whether it is a batch. The calls of a
+// batch that can't be decoded are marked as invalid, so that they are
+// rejected instead of being served unchecked. It returns false if the
+// request is not valid.
+func parseCalls(body []byte) ([]json.RawMessage, []call, bool, bool) {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	batch := len(trimmed) > 0 && trimmed[0] == '['
+
+	if !batch {
+		var c call
+		if err := json.Unmarshal(trimmed, &c); err != nil {
+			return nil, nil, batch, false
+		}
+		return []json.RawMessage{trimmed}, []call{c}, batch, true
+	}
+
+	// the raw calls must not share the memory of the body, which is
+	// forwarded as is if all the calls are accepted
+	var raws []json.RawMessage
+	if err := json.Unmarshal(trimmed, &raws); err != nil || len(raws) == 0 {
+		return nil, nil, batch, false
+	}
+
+	calls := make([]call, len(raws))
+	for i, raw := range raws {
+		if err := json.Unmarshal(raw, &calls[i]); err != nil || calls[i].Method == "" {
+			calls[i] = call{invalid: true}
+		}
+	}
+
+	return raws, calls, batch, true
+}
+
+// newErrorResponse returns the JSON-RPC error response of a rejected call.
+func newErrorResponse(id json.RawMessage, code int, msg string) errorResponse {
+	return errorResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error: errorMessage{
+			Code:    code,
+			Message: msg,
+		},
+	}
+}
+
+// writeJSON writes the given JSON-RPC response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
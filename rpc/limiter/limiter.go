@@ -0,0 +1,174 @@
+// Package limiter limits the access of the clients to the JSON-RPC server,
+// with method allow and deny lists and per-client token bucket rate limits
+// weighted by the cost of the methods.
+package limiter
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// ForwardedForHeader is the header with the IP of the client of a request
+	// forwarded by the websocket server or a reverse proxy.
+	ForwardedForHeader = "X-Forwarded-For"
+
+	// pruneInterval is the interval at which the buckets of the idle clients
+	// are removed.
+	pruneInterval = time.Minute
+)
+
+// Limiter limits the JSON-RPC methods the clients can call and the rate at
+// which they can call them.
+type Limiter struct {
+	*MethodFilter
+
+	limit        rate.Limit
+	burst        int
+	costs        map[string]int
+	apiKeyHeader string
+	apiKeys      map[string]struct{}
+
+	mu        sync.Mutex
+	buckets   map[string]*rate.Limiter
+	lastPrune time.Time
+}
+
+// New returns a Limiter configured with the given JSON-RPC configuration.
+func New(cfg config.JSONRPCConfig) (*Limiter, error) {
+	costs, err := config.ParseMethodCosts(cfg.MethodCosts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make(map[string]struct{}, len(cfg.RateLimitAPIKeys))
+	for _, key := range cfg.RateLimitAPIKeys {
+		apiKeys[key] = struct{}{}
+	}
+
+	return &Limiter{
+		MethodFilter: NewMethodFilter(cfg.AllowedMethods, cfg.DeniedMethods),
+		limit:        rate.Limit(cfg.RateLimit),
+		burst:        cfg.RateLimitBurst,
+		costs:        costs,
+		apiKeyHeader: cfg.RateLimitAPIKeyHeader,
+		apiKeys:      apiKeys,
+		buckets:      make(map[string]*rate.Limiter),
+		lastPrune:    time.Now(),
+	}, nil
+}
+
+// Enabled returns true if the limiter restricts any method or rate limits
+// the clients.
+func (l *Limiter) Enabled() bool {
+	return l.MethodFilter.Enabled() || l.RateLimited()
+}
+
+// RateLimited returns true if the clients are rate limited.
+func (l *Limiter) RateLimited() bool {
+	return l.limit > 0
+}
+
+// Cost returns the cost of a call of the given method. The cost of a method
+// takes precedence over the cost of its namespace, and the methods without
+// cost cost 1.
+func (l *Limiter) Cost(method string) int {
+	if cost, found := l.costs[method]; found {
+		return cost
+	}
+
+	namespace, _, _ := strings.Cut(method, "_")
+	if cost, found := l.costs[namespace+"_*"]; found {
+		return cost
+	}
+
+	return 1
+}
+
+// Allow consumes the given cost from the bucket of the client and returns
+// false if the bucket doesn't have enough tokens left.
+func (l *Limiter) Allow(client string, cost int) bool {
+	if !l.RateLimited() {
+		return true
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+
+	bucket, found := l.buckets[client]
+	if !found {
+		bucket = rate.NewLimiter(l.limit, l.burst)
+		l.buckets[client] = bucket
+	}
+
+	return bucket.AllowN(now, cost)
+}
+
+// prune removes the buckets that are full, which are equivalent to the ones
+// of new clients. The lock must be held by the caller.
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	for client, bucket := range l.buckets {
+		if bucket.TokensAt(now) >= float64(l.burst) {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// Client returns the key identifying the client of the given request for the
+// rate limiting, which is its API key if the API key header is configured and
+// set to one of the configured API keys, or its IP otherwise. The unknown API
+// keys are ignored, so that a client can't get new buckets by changing its key.
+func (l *Limiter) Client(r *http.Request) string {
+	if l.apiKeyHeader != "" {
+		if key := r.Header.Get(l.apiKeyHeader); key != "" {
+			if _, found := l.apiKeys[key]; found {
+				return "key:" + key
+			}
+		}
+	}
+
+	return "ip:" + ClientIP(r)
+}
+
+// APIKeyHeader returns the header identifying the clients by API key, if any.
+func (l *Limiter) APIKeyHeader() string {
+	return l.apiKeyHeader
+}
+
+// ClientIP returns the IP of the client of the given request. The
+// X-Forwarded-For header is only trusted for the requests from the loopback
+// interface, e.g. forwarded by the websocket server or a local reverse proxy,
+// so that remote clients can't spoof their IP.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if forwarded := r.Header.Get(ForwardedForHeader); forwarded != "" {
+			// the last address is the one added by the local proxy, the
+			// previous ones can be set by the client
+			addrs := strings.Split(forwarded, ",")
+			return strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+
+	return host
+}
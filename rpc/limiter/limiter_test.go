@@ -0,0 +1,215 @@
+package limiter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestMethodFilter(t *testing.T) {
+	filter := NewMethodFilter([]string{"eth_*", "net_version"}, []string{"eth_sendTransaction"})
+
+	require.True(t, filter.Allowed("eth_call"))
+	require.True(t, filter.Allowed("net_version"))
+	require.False(t, filter.Allowed("eth_sendTransaction"))
+	require.False(t, filter.Allowed("net_listening"))
+	require.False(t, filter.Allowed("debug_traceTransaction"))
+	require.False(t, filter.Allowed("ethx_call"))
+
+	require.True(t, NewMethodFilter(nil, []string{"debug_*"}).Allowed("eth_call"))
+	require.False(t, NewMethodFilter(nil, []string{"debug_*"}).Allowed("debug_traceBlockByNumber"))
+	require.False(t, NewMethodFilter(nil, nil).Enabled())
+}
+
+func TestCost(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.MethodCosts = []string{"debug_*:50", "debug_getRawBlock:2", "eth_getLogs:20"}
+	l, err := New(*cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, 20, l.Cost("eth_getLogs"))
+	require.Equal(t, 50, l.Cost("debug_traceBlockByNumber"))
+	require.Equal(t, 2, l.Cost("debug_getRawBlock"))
+	require.Equal(t, 1, l.Cost("eth_blockNumber"))
+}
+
+func TestClientIP(t *testing.T) {
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		expected   string
+	}{
+		{"remote client", "10.0.0.1:1234", "", "10.0.0.1"},
+		{"remote client spoofing its IP", "10.0.0.1:1234", "10.0.0.2", "10.0.0.1"},
+		{"forwarded by a local proxy", "127.0.0.1:1234", "10.0.0.2", "10.0.0.2"},
+		{"forwarded by a local proxy with a spoofed IP", "127.0.0.1:1234", "10.0.0.3, 10.0.0.2", "10.0.0.2"},
+		{"local client", "127.0.0.1:1234", "", "127.0.0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.RemoteAddr = tc.remoteAddr
+			if tc.forwarded != "" {
+				r.Header.Set(ForwardedForHeader, tc.forwarded)
+			}
+			require.Equal(t, tc.expected, ClientIP(r))
+		})
+	}
+}
+
+// echoHandler answers each call with its method as result.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	_, calls, batch, _ := parseCalls(body)
+
+	var resps []map[string]interface{}
+	for _, c := range calls {
+		resps = append(resps, map[string]interface{}{"jsonrpc": "2.0", "id": c.ID, "result": c.Method})
+	}
+
+	if batch {
+		_ = json.NewEncoder(w).Encode(resps)
+	} else {
+		_ = json.NewEncoder(w).Encode(resps[0])
+	}
+})
+
+type response struct {
+	ID     int    `json:"id"`
+	Result string `json:"result"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+func TestHandler(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"debug_*"}
+	cfg.RateLimit = 1
+	cfg.RateLimitBurst = 10
+	cfg.RateLimitAPIKeyHeader = "X-API-Key"
+	cfg.RateLimitAPIKeys = []string{"key"}
+	cfg.MethodCosts = []string{"eth_getLogs:4"}
+	l, err := New(*cfg)
+	require.NoError(t, err)
+	h := l.Handler(echoHandler)
+
+	serve := func(body, remoteAddr, apiKey string) string {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			r.Header.Set("X-API-Key", apiKey)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		return rec.Body.String()
+	}
+
+	// a denied method doesn't consume the rate limit
+	var resp response
+	require.NoError(t, json.Unmarshal([]byte(serve(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`, "10.0.0.1:1", "")), &resp))
+	require.Equal(t, ErrCodeMethodNotAllowed, resp.Error.Code)
+
+	// the batch calls are served until the bucket of 10 units is empty
+	body := `[{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"},{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"},` +
+		`{"jsonrpc":"2.0","id":3,"method":"eth_getLogs"},{"jsonrpc":"2.0","id":4,"method":"eth_blockNumber"},` +
+		`{"jsonrpc":"2.0","id":5,"method":"debug_getRawBlock"}]`
+	var resps []response
+	require.NoError(t, json.Unmarshal([]byte(serve(body, "10.0.0.1:1", "")), &resps))
+	require.Len(t, resps, 5)
+
+	results := make(map[int]response)
+	for _, r := range resps {
+		results[r.ID] = r
+	}
+	require.Equal(t, "eth_getLogs", results[1].Result)
+	require.Equal(t, "eth_getLogs", results[2].Result)
+	require.Equal(t, ErrCodeLimitExceeded, results[3].Error.Code)
+	require.Equal(t, "eth_blockNumber", results[4].Result)
+	require.Equal(t, ErrCodeMethodNotAllowed, results[5].Error.Code)
+
+	// the bucket of the client is empty
+	resp = response{}
+	require.NoError(t, json.Unmarshal([]byte(serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "10.0.0.1:1", "")), &resp))
+	require.Equal(t, ErrCodeLimitExceeded, resp.Error.Code)
+
+	// other clients are not limited
+	resp = response{}
+	require.NoError(t, json.Unmarshal([]byte(serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "10.0.0.2:1", "")), &resp))
+	require.Nil(t, resp.Error)
+	resp = response{}
+	require.NoError(t, json.Unmarshal([]byte(serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "10.0.0.1:1", "key")), &resp))
+	require.Nil(t, resp.Error)
+	require.Equal(t, "eth_getLogs", resp.Result)
+
+	// an unknown API key uses the bucket of the IP of the client
+	resp = response{}
+	require.NoError(t, json.Unmarshal([]byte(serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "10.0.0.1:1", "other")), &resp))
+	require.Equal(t, ErrCodeLimitExceeded, resp.Error.Code)
+
+	// the invalid requests are forwarded to the server, which answers the
+	// empty batch with no responses
+	require.Equal(t, "null\n", serve(`[]`, "10.0.0.3:1", ""))
+}
+
+func TestHandlerBatch(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.DeniedMethods = []string{"debug_*"}
+	cfg.RateLimit = 1
+	cfg.RateLimitBurst = 10
+	l, err := New(*cfg)
+	require.NoError(t, err)
+
+	var forwarded []string
+	h := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		forwarded = append(forwarded, string(body))
+		r.Body = io.NopCloser(strings.NewReader(string(body)))
+		echoHandler.ServeHTTP(w, r)
+	}))
+
+	serve := func(body string) []response {
+		forwarded = nil
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = "10.0.0.1:1"
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+
+		var resps []response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+		return resps
+	}
+
+	// the accepted batch is forwarded unchanged
+	body := `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`
+	resps := serve(body)
+	require.Equal(t, []string{body}, forwarded)
+	require.Len(t, resps, 2)
+	require.Equal(t, "eth_blockNumber", resps[0].Result)
+	require.Equal(t, "eth_chainId", resps[1].Result)
+
+	// the invalid calls of a batch are rejected and don't let the denied
+	// calls through
+	resps = serve(`[{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber"},1]`)
+	require.Empty(t, forwarded)
+	require.Len(t, resps, 2)
+	require.Equal(t, ErrCodeMethodNotAllowed, resps[0].Error.Code)
+	require.Equal(t, ErrCodeInvalidRequest, resps[1].Error.Code)
+
+	// only the valid calls of a batch are forwarded and rate limited
+	resps = serve(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},1,{"jsonrpc":"2.0","id":2}]`)
+	require.Equal(t, []string{`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}]`}, forwarded)
+	require.Len(t, resps, 3)
+	require.Equal(t, "eth_blockNumber", resps[0].Result)
+	require.Equal(t, ErrCodeInvalidRequest, resps[1].Error.Code)
+	require.Equal(t, ErrCodeInvalidRequest, resps[2].Error.Code)
+}
@@ -0,0 +1,58 @@
+package limiter
+
+import "strings"
+
+// MethodFilter restricts the JSON-RPC methods that can be called with allow
+// and deny lists. The entries of the lists are method names or `namespace_*`
+// patterns matching all the methods of a namespace.
+type MethodFilter struct {
+	allowed []string
+	denied  []string
+}
+
+// NewMethodFilter returns a MethodFilter allowing the given methods, or all
+// the methods if the list is empty, except for the denied ones.
+func NewMethodFilter(allowed, denied []string) *MethodFilter {
+	return &MethodFilter{
+		allowed: allowed,
+		denied:  denied,
+	}
+}
+
+// Enabled returns true if the filter restricts any method.
+func (f *MethodFilter) Enabled() bool {
+	return f != nil && (len(f.allowed) > 0 || len(f.denied) > 0)
+}
+
+// Allowed returns true if the given method can be called. A nil filter
+// allows all the methods.
+func (f *MethodFilter) Allowed(method string) bool {
+	if f == nil {
+		return true
+	}
+
+	if matchAny(f.denied, method) {
+		return false
+	}
+
+	return len(f.allowed) == 0 || matchAny(f.allowed, method)
+}
+
+// matchAny returns true if the method matches any of the given patterns.
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if match(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns true if the method is equal to the given method name or
+// belongs to the namespace of the given `namespace_*` pattern.
+func match(pattern, method string) bool {
+	if namespace, found := strings.CutSuffix(pattern, "_*"); found {
+		return strings.HasPrefix(method, namespace+"_")
+	}
+	return pattern == method
+}
@@ -0,0 +1,241 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// maxRequestContentLength is the maximum size of the requests read by the
+// handler, which is the one of the go-ethereum RPC server.
+const maxRequestContentLength = 5 * 1024 * 1024
+
+// jsonrpcMessage is the subset of a JSON-RPC request used to record the
+// metrics of a call.
+type jsonrpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+}
+
+// handler records the metrics of the calls served by the wrapped JSON-RPC
+// HTTP handler.
+type handler struct {
+	next    http.Handler
+	methods map[string]struct{}
+}
+
+// NewHandler wraps the given JSON-RPC HTTP handler to record the number of
+// calls, serving time and error codes of each method of the given APIs, and
+// the size and serving time of the batch requests.
+func NewHandler(next http.Handler, apis []rpc.API) http.Handler {
+	return &handler{
+		next:    next,
+		methods: methodNames(apis),
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxRequestContentLength {
+		// let the server reject the requests that are too large
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	reqs, batch := parseMessages(body)
+	if len(reqs) == 0 {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	// the error codes are decoded while the response is written, so that it
+	// is not buffered
+	pr, pw := io.Pipe()
+	done := make(chan map[string]*int, 1)
+	go func() {
+		codes := decodeResponses(json.NewDecoder(pr))
+		// unblock the writes of the rest of the response
+		_ = pr.Close()
+		done <- codes
+	}()
+
+	rec := &responseRecorder{ResponseWriter: w, pw: pw}
+	start := time.Now()
+	h.next.ServeHTTP(rec, r)
+	elapsed := time.Since(start)
+
+	_ = pw.Close()
+	codes := <-done
+
+	if batch {
+		RecordBatch(len(reqs), elapsed)
+	}
+
+	for _, req := range reqs {
+		method := req.Method
+		if _, found := h.methods[method]; !found {
+			method = unknownMethod
+		}
+
+		code, found := codes[string(req.ID)]
+		failed := found && code != nil
+		errCode := 0
+		if failed {
+			errCode = *code
+		}
+		if batch {
+			RecordBatchCall(method, failed, errCode)
+		} else {
+			RecordCall(method, elapsed, failed, errCode)
+		}
+	}
+}
+
+// responseRecorder is a http.ResponseWriter that copies the response body to
+// a pipe.
+type responseRecorder struct {
+	http.ResponseWriter
+	pw *io.PipeWriter
+}
+
+// Write implements http.ResponseWriter.
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	// the pipe is closed once the responses are decoded or invalid
+	_, _ = r.pw.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// decodeResponses decodes the error codes of a single JSON-RPC response or a
+// batch of responses, indexed by the response IDs. The codes of successful
+// responses are nil. The other fields of the responses are skipped without
+// being buffered, and the decoding stops at the first invalid response.
+func decodeResponses(dec *json.Decoder) map[string]*int {
+	codes := make(map[string]*int)
+	tok, err := dec.Token()
+	if err != nil {
+		return codes
+	}
+	switch tok {
+	case json.Delim('{'):
+		_ = decodeResponse(dec, codes)
+	case json.Delim('['):
+		// the responses of a batch can be in any order
+		for dec.More() {
+			if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+				return codes
+			}
+			if err := decodeResponse(dec, codes); err != nil {
+				return codes
+			}
+		}
+	}
+	return codes
+}
+
+// decodeResponse decodes the ID and the error code of a JSON-RPC response
+// whose opening brace was read.
+func decodeResponse(dec *json.Decoder, codes map[string]*int) error {
+	var (
+		id   json.RawMessage
+		code *int
+	)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case "id":
+			if err := dec.Decode(&id); err != nil {
+				return err
+			}
+		case "error":
+			var resErr *struct {
+				Code int `json:"code"`
+			}
+			if err := dec.Decode(&resErr); err != nil {
+				return err
+			}
+			if resErr != nil {
+				code = &resErr.Code
+			}
+		default:
+			if err := skipValue(dec); err != nil {
+				return err
+			}
+		}
+	}
+	// closing brace
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	codes[string(id)] = code
+	return nil
+}
+
+// skipValue reads the next JSON value token by token.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// parseMessages decodes a single JSON-RPC request or a batch of requests,
+// and returns whether it is a batch. Invalid messages are ignored.
+func parseMessages(raw []byte) ([]jsonrpcMessage, bool) {
+	raw = bytes.TrimLeft(raw, " \t\r\n")
+	if len(raw) > 0 && raw[0] == '[' {
+		var msgs []jsonrpcMessage
+		if err := json.Unmarshal(raw, &msgs); err != nil {
+			return nil, true
+		}
+		return msgs, true
+	}
+
+	var msg jsonrpcMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil, false
+	}
+	return []jsonrpcMessage{msg}, false
+}
+
+// methodNames returns the names of the JSON-RPC methods of the given APIs,
+// using the naming rules of the go-ethereum RPC server.
+func methodNames(apis []rpc.API) map[string]struct{} {
+	methods := make(map[string]struct{})
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := []rune(typ.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			methods[api.Namespace+"_"+string(name)] = struct{}{}
+		}
+	}
+	return methods
+}
@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (testService) Call() string      { return "" }
+func (testService) GetLogs() []string { return nil }
+
+func counter(name string) int64 {
+	return metrics.GetOrRegisterCounter(name, nil).Snapshot().Count()
+}
+
+// durations returns the number of serving times recorded by the per-method
+// histograms of the given methods since the last call.
+func durations(methods ...string) int64 {
+	var count int64
+	for _, method := range methods {
+		name := fmt.Sprintf("%s/%s", durationPrefix, method)
+		count += metrics.GetOrRegisterHistogramLazy(name, nil, newSample).Snapshot().Count()
+	}
+	return count
+}
+
+func TestHandler(t *testing.T) {
+	metrics.Enable()
+
+	// the wrapped handler answers with the given responses
+	var response string
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(response))
+	})
+	h := NewHandler(next, []rpc.API{{Namespace: "test", Service: testService{}}})
+
+	serve := func(body, resp string) string {
+		response = resp
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		return rec.Body.String()
+	}
+
+	testCases := []struct {
+		name  string
+		body  string
+		resp  string
+		check map[string]int64
+		batch bool
+	}{
+		{
+			name:  "single call",
+			body:  `{"jsonrpc":"2.0","id":1,"method":"test_call","params":[]}`,
+			resp:  `{"jsonrpc":"2.0","id":1,"result":""}`,
+			check: map[string]int64{"rpc/evm/requests/test_call": 1},
+		},
+		{
+			name: "single failed call",
+			body: `{"jsonrpc":"2.0","id":1,"method":"test_call","params":[]}`,
+			resp: `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"failed"}}`,
+			check: map[string]int64{
+				"rpc/evm/requests/test_call":     2,
+				"rpc/evm/errors/test_call/32000": 1,
+			},
+		},
+		{
+			name: "batch with responses out of order",
+			body: `[{"jsonrpc":"2.0","id":1,"method":"test_getLogs"},{"jsonrpc":"2.0","id":2,"method":"test_call"}]`,
+			resp: `[{"jsonrpc":"2.0","id":2,"result":""},{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"limit exceeded"}}]`,
+			check: map[string]int64{
+				"rpc/evm/requests/test_call":        3,
+				"rpc/evm/requests/test_getLogs":     1,
+				"rpc/evm/errors/test_getLogs/32005": 1,
+				"rpc/evm/errors/test_call/32000":    1,
+			},
+			batch: true,
+		},
+		{
+			name: "unknown method",
+			body: `{"jsonrpc":"2.0","id":1,"method":"test_foo"}`,
+			resp: `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"not found"}}`,
+			check: map[string]int64{
+				"rpc/evm/requests/unknown":     1,
+				"rpc/evm/errors/unknown/32601": 1,
+				"rpc/evm/requests/test_foo":    0,
+			},
+		},
+		{
+			name: "call with nested result",
+			body: `{"jsonrpc":"2.0","id":"a","method":"test_getLogs"}`,
+			resp: `{"jsonrpc":"2.0","result":[{"topics":["0x01"],"data":{"code":1}}],"id":"a"}` + "\n",
+			check: map[string]int64{
+				"rpc/evm/requests/test_getLogs":     2,
+				"rpc/evm/errors/test_getLogs/32005": 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the response is forwarded unchanged
+			require.Equal(t, tc.resp, serve(tc.body, tc.resp))
+
+			for name, count := range tc.check {
+				require.Equal(t, count, counter(name), name)
+			}
+			// the histogram samples are reset by each snapshot
+			batches := batchSizeHistogram.Snapshot()
+			calls := durations("test_call", "test_getLogs", unknownMethod)
+			if tc.batch {
+				require.Equal(t, int64(1), batches.Count())
+				require.Equal(t, int64(2), batches.Max())
+				// the calls of a batch share its serving time
+				require.Equal(t, int64(1), batchDurationHistogram.Snapshot().Count())
+				require.Zero(t, calls)
+			} else {
+				require.Zero(t, batches.Count())
+				require.Equal(t, int64(1), calls)
+			}
+		})
+	}
+}
+
+func TestHandlerRequestTooLarge(t *testing.T) {
+	metrics.Enable()
+
+	// the wrapped handler reads the whole request
+	var size int
+	next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		size = len(body)
+	})
+	h := NewHandler(next, []rpc.API{{Namespace: "test", Service: testService{}}})
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"test_call","params":["` + strings.Repeat("a", maxRequestContentLength) + `"]}`
+	before := counter("rpc/evm/requests/test_call")
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	// the request is forwarded unchanged without being recorded
+	require.Equal(t, len(body), size)
+	require.Equal(t, before, counter("rpc/evm/requests/test_call"))
+}
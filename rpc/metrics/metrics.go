@@ -0,0 +1,83 @@
+// Package metrics defines the metrics of the JSON-RPC and websocket servers.
+// They are registered in the go-ethereum default registry, which is exported
+// by the metrics server listening on the JSON-RPC metrics address when the
+// node is started with the --metrics flag.
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// requestsPrefix is the prefix of the per-method request counters.
+	requestsPrefix = "rpc/evm/requests"
+	// durationPrefix is the prefix of the per-method serving time histograms.
+	durationPrefix = "rpc/evm/duration"
+	// errorsPrefix is the prefix of the per-method and per-error code counters.
+	errorsPrefix = "rpc/evm/errors"
+
+	// unknownMethod is the method name under which the calls of methods that
+	// are not registered in the server are recorded, to bound the number of
+	// series.
+	unknownMethod = "unknown"
+)
+
+var (
+	// batchSizeHistogram tracks the number of calls of the batch requests.
+	batchSizeHistogram = metrics.NewRegisteredHistogram("rpc/evm/batch/size", nil, newSample())
+	// batchDurationHistogram tracks the serving time of the batch requests.
+	batchDurationHistogram = metrics.NewRegisteredHistogram("rpc/evm/batch/duration", nil, newSample())
+
+	// WSConnectionsGauge tracks the open websocket connections.
+	WSConnectionsGauge = metrics.NewRegisteredGauge("rpc/evm/ws/connections", nil)
+	// WSSubscriptionsGauge tracks the active eth_subscribe subscriptions of
+	// the websocket connections.
+	WSSubscriptionsGauge = metrics.NewRegisteredGauge("rpc/evm/ws/subscriptions", nil)
+	// FiltersGauge tracks the installed eth_newFilter, eth_newBlockFilter and
+	// eth_newPendingTransactionFilter filters.
+	FiltersGauge = metrics.NewRegisteredGauge("rpc/evm/filters", nil)
+)
+
+// newSample returns the sample used by the histograms, which is the same as
+// the one of the go-ethereum RPC serving time histograms.
+func newSample() metrics.Sample {
+	return metrics.ResettingSample(metrics.NewExpDecaySample(1028, 0.015))
+}
+
+// RecordCall records a call of the given method, its serving time and, if it
+// failed, its JSON-RPC error code.
+func RecordCall(method string, elapsed time.Duration, failed bool, code int) {
+	metrics.GetOrRegisterHistogramLazy(fmt.Sprintf("%s/%s", durationPrefix, method), nil, newSample).Update(elapsed.Nanoseconds())
+	RecordBatchCall(method, failed, code)
+}
+
+// RecordBatchCall records a call of the given method made in a batch request
+// and, if it failed, its JSON-RPC error code. Its serving time is left out of
+// the per-method histograms, since the calls of a batch share the serving time
+// recorded by RecordBatch.
+func RecordBatchCall(method string, failed bool, code int) {
+	metrics.GetOrRegisterCounter(fmt.Sprintf("%s/%s", requestsPrefix, method), nil).Inc(1)
+
+	if failed {
+		metrics.GetOrRegisterCounter(errorName(method, code), nil).Inc(1)
+	}
+}
+
+// RecordBatch records the size and the serving time of a batch request.
+func RecordBatch(size int, elapsed time.Duration) {
+	batchSizeHistogram.Update(int64(size))
+	batchDurationHistogram.Update(elapsed.Nanoseconds())
+}
+
+// errorName returns the name of the counter of the given method and error
+// code. The sign of the code is dropped, as the JSON-RPC error codes are
+// mostly negative and Prometheus metric names can't contain a minus sign.
+func errorName(method string, code int) string {
+	if code < 0 {
+		code = -code
+	}
+	return fmt.Sprintf("%s/%s/%d", errorsPrefix, method, code)
+}
@@ -102,6 +102,19 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceCallConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.TraceCall(args, blockNum, config)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -377,11 +390,28 @@ func (a *API) PrintBlock(number uint64) (string, error) {
 }
 
 // IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
+// of intermediate roots: a dirty-state digest after each transaction.
+// As the EVM state is not stored in a Merkle Patricia Trie, the digests
+// are not state roots but a chained hash of the EVM StateDB changes of the
+// block's transactions, so that two nodes can find the first transaction they
+// disagree on. They don't cover the Cosmos state changes, such as the
+// precompile writes and the ante handler fee and nonce changes.
 func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
 	if !a.profilingEnabled {
 		return nil, rpctypes.ErrProfilingDisabled
 	}
-	return ([]common.Hash)(nil), nil
+
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+
+	return a.backend.IntermediateRoots(rpctypes.BlockNumber(resBlock.Block.Height), resBlock)
 }
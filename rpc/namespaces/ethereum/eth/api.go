@@ -2,7 +2,6 @@ package eth
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -68,14 +67,15 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, override *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides) (hexutil.Bytes, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides) (hexutil.Uint64, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -265,23 +265,20 @@ func (e *PublicAPI) GetProof(address common.Address,
 ///                           EVM/Smart Contract Execution				          ///
 ///////////////////////////////////////////////////////////////////////////////
 
-// Call performs a raw contract call.
+// Call performs a raw contract call. The optional state and block overrides
+// are applied on top of the state of the requested block.
 func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	override *rpctypes.StateOverride,
+	overrides *evmtypes.StateOverride,
+	blockOverrides *evmtypes.BlockOverrides,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
-	if override != nil {
-		e.logger.Debug("eth_call", "error", "overrides are unsupported in call queries")
-		return nil, fmt.Errorf("overrides are unsupported in call queries")
-	}
-
 	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -289,6 +286,23 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of calls on top of the requested block, grouped
+// in simulated blocks with their own block and state overrides, and returns
+// the simulated blocks with the results of their calls. Nothing is committed.
+func (e *PublicAPI) SimulateV1(opts evmtypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	blockNum, err := e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -311,9 +325,15 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state and block overrides are applied before the estimation.
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *evmtypes.StateOverride,
+	blockOverrides *evmtypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides, blockOverrides)
 }
 
 func (e *PublicAPI) FeeHistory(
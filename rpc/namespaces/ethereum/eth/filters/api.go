@@ -16,6 +16,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	rpcmetrics "github.com/cosmos/evm/rpc/metrics"
 	"github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -49,6 +50,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -132,7 +134,7 @@ func (api *PublicFilterAPI) timeoutLoop() {
 			select {
 			case <-f.deadline.C:
 				f.s.Unsubscribe(api.events)
-				delete(api.filters, id)
+				api.deleteFilter(id)
 			default:
 				continue
 			}
@@ -141,6 +143,20 @@ func (api *PublicFilterAPI) timeoutLoop() {
 	}
 }
 
+// addFilter installs the given filter and updates the filters gauge. The
+// filters lock must be held by the caller.
+func (api *PublicFilterAPI) addFilter(id rpc.ID, f *filter) {
+	api.filters[id] = f
+	rpcmetrics.FiltersGauge.Update(int64(len(api.filters)))
+}
+
+// deleteFilter removes the filter with the given id and updates the filters
+// gauge. The filters lock must be held by the caller.
+func (api *PublicFilterAPI) deleteFilter(id rpc.ID) {
+	delete(api.filters, id)
+	rpcmetrics.FiltersGauge.Update(int64(len(api.filters)))
+}
+
 // NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
 // as transactions enter the pending state.
 //
@@ -162,12 +178,12 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	api.filters[pendingTxSub.ID()] = &filter{
+	api.addFilter(pendingTxSub.ID(), &filter{
 		typ:      filters.PendingTransactionsSubscription,
 		deadline: time.NewTimer(api.deadline),
 		hashes:   make([]common.Hash, 0),
 		s:        pendingTxSub,
-	}
+	})
 
 	go func(txsCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -177,7 +193,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 			case ev, ok := <-txsCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
+					api.deleteFilter(pendingTxSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -206,7 +222,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 				api.filtersMu.Unlock()
 			case <-errCh:
 				api.filtersMu.Lock()
-				delete(api.filters, pendingTxSub.ID())
+				api.deleteFilter(pendingTxSub.ID())
 				api.filtersMu.Unlock()
 			}
 		}
@@ -243,7 +259,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 			case ev, ok := <-txsCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
+					api.deleteFilter(pendingTxSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -294,12 +310,12 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating block filter: %s", err.Error()))
 	}
 
-	api.filters[headerSub.ID()] = &filter{
+	api.addFilter(headerSub.ID(), &filter{
 		typ:      filters.BlocksSubscription,
 		deadline: time.NewTimer(api.deadline),
 		hashes:   []common.Hash{},
 		s:        headerSub,
-	}
+	})
 
 	go func(headersCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -309,7 +325,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 			case ev, ok := <-headersCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, headerSub.ID())
+					api.deleteFilter(headerSub.ID())
 					api.filtersMu.Unlock()
 					return
 				}
@@ -327,7 +343,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 				api.filtersMu.Unlock()
 			case <-errCh:
 				api.filtersMu.Lock()
-				delete(api.filters, headerSub.ID())
+				api.deleteFilter(headerSub.ID())
 				api.filtersMu.Unlock()
 				return
 			}
@@ -479,13 +495,13 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 
 	filterID = logsSub.ID()
 
-	api.filters[filterID] = &filter{
+	api.addFilter(filterID, &filter{
 		typ:      filters.LogsSubscription,
 		crit:     criteria,
 		deadline: time.NewTimer(api.deadline),
 		hashes:   []common.Hash{},
 		s:        logsSub,
-	}
+	})
 
 	go func(eventCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
@@ -495,7 +511,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 			case ev, ok := <-eventCh:
 				if !ok {
 					api.filtersMu.Lock()
-					delete(api.filters, filterID)
+					api.deleteFilter(filterID)
 					api.filtersMu.Unlock()
 					return
 				}
@@ -520,7 +536,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 				api.filtersMu.Unlock()
 			case <-logsSub.Err():
 				api.filtersMu.Lock()
-				delete(api.filters, filterID)
+				api.deleteFilter(filterID)
 				api.filtersMu.Unlock()
 				return
 			}
@@ -573,7 +589,7 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	api.filtersMu.Lock()
 	f, found := api.filters[id]
 	if found {
-		delete(api.filters, id)
+		api.deleteFilter(id)
 	}
 	api.filtersMu.Unlock()
 
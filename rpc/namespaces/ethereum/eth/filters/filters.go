@@ -168,6 +168,15 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		return nil, errInvalidBlockRange
 	}
 
+	indexed, ok, err := f.backend.GetLogsFromIndex(int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics, logLimit) //#nosec G115
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch logs from the log index: %w", err)
+	}
+	if ok {
+		return indexed, nil
+	}
+
+	// the block range cap only bounds the fallback that walks every block
 	if blockLimit > 0 && to-from > uint64(blockLimit) {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
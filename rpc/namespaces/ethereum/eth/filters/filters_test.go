@@ -41,6 +41,12 @@ func (m *MockBackend) GetLogsByHeight(i *int64) ([][]*ethtypes.Log, error) {
 	panic("implement me")
 }
 
+func (m *MockBackend) GetLogsFromIndex(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error) {
+	args := m.Called(from, to, addresses, topics, limit)
+	logs, _ := args.Get(0).([]*ethtypes.Log)
+	return logs, args.Bool(1), args.Error(2)
+}
+
 func (m *MockBackend) BloomStatus() (uint64, uint64) {
 	panic("implement me")
 }
@@ -114,6 +120,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *MockBackend {
 				backend := &MockBackend{}
 				backend.On("HeaderByNumber", mock.Anything).Return(fakeHeader, nil)
+				backend.On("GetLogsFromIndex", blockHeight, blockHeight, mock.Anything, mock.Anything, 1000).Return(nil, false, nil)
 				backend.On("TendermintBlockResultByNumber", &blockHeight).Return((*tmrpctypes.ResultBlockResults)(nil), errors.New("block result error"))
 				return backend
 			},
@@ -130,6 +137,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *MockBackend {
 				backend := &MockBackend{}
 				backend.On("HeaderByNumber", mock.Anything).Return(fakeHeader, nil)
+				backend.On("GetLogsFromIndex", blockHeight, blockHeight, mock.Anything, mock.Anything, 1000).Return(nil, false, nil)
 				backend.On("TendermintBlockResultByNumber", &blockHeight).Return(fakeBlockRes, nil)
 				backend.On("BlockBloom", fakeBlockRes).Return(ethtypes.Bloom{}, errors.New("bloom error"))
 				return backend
@@ -141,6 +149,37 @@ func TestLogs(t *testing.T) {
 			expectErr: true,
 			expectMsg: "bloom error",
 		},
+		{
+			name:      "served from the log index",
+			errorStep: "none",
+			prepare: func() *MockBackend {
+				backend := &MockBackend{}
+				backend.On("HeaderByNumber", mock.Anything).Return(fakeHeader, nil)
+				backend.On("GetLogsFromIndex", blockHeight, blockHeight, mock.Anything, mock.Anything, 1000).Return([]*ethtypes.Log{{BlockNumber: uint64(blockHeight)}}, true, nil)
+				return backend
+			},
+			criteria: filters.FilterCriteria{
+				FromBlock: big.NewInt(blockHeight),
+				ToBlock:   big.NewInt(blockHeight),
+			},
+			expectErr: false,
+		},
+		{
+			name:      "log index returns error",
+			errorStep: "GetLogsFromIndex",
+			prepare: func() *MockBackend {
+				backend := &MockBackend{}
+				backend.On("HeaderByNumber", mock.Anything).Return(fakeHeader, nil)
+				backend.On("GetLogsFromIndex", blockHeight, blockHeight, mock.Anything, mock.Anything, 1000).Return(nil, false, errors.New("query returned more than 1000 results"))
+				return backend
+			},
+			criteria: filters.FilterCriteria{
+				FromBlock: big.NewInt(blockHeight),
+				ToBlock:   big.NewInt(blockHeight),
+			},
+			expectErr: true,
+			expectMsg: "query returned more than 1000 results",
+		},
 		{
 			name:      "Single block by BlockHash",
 			errorStep: "none",
@@ -202,6 +241,33 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "range served from the log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(51)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(51), mock.Anything, mock.Anything, 15).Return([]*ethtypes.Log{{BlockNumber: 42}}, true, nil)
+			},
+			expLogs: []*ethtypes.Log{{BlockNumber: 42}},
+		},
+		{
+			name:   "range beyond the block range cap served from the log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(100), mock.Anything, mock.Anything, 15).Return([]*ethtypes.Log{{BlockNumber: 42}}, true, nil)
+			},
+			expLogs: []*ethtypes.Log{{BlockNumber: 42}},
+		},
+		{
+			name:   "range beyond the block range cap",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().GetLogsFromIndex(int64(1), int64(100), mock.Anything, mock.Anything, 15).Return(nil, false, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,283 @@
+package trace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	callTracer     = "callTracer"
+	prestateTracer = "prestateTracer"
+	muxTracer      = "muxTracer"
+)
+
+// API is the collection of Parity (OpenEthereum) style tracing APIs, built on
+// top of the callTracer and prestateTracer outputs of the EVM module.
+type API struct {
+	ctx           *server.Context
+	logger        log.Logger
+	backend       backend.EVMBackend
+	blockRangeCap int32
+}
+
+// NewAPI creates a new API definition for the trace namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+	blockRangeCap int32,
+) *API {
+	return &API{
+		ctx:           ctx,
+		logger:        ctx.Logger.With("module", "trace"),
+		backend:       backend,
+		blockRangeCap: blockRangeCap,
+	}
+}
+
+// Block returns the flat traces of all the transactions of a block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*Trace, error) {
+	a.logger.Debug("trace_block", "height", blockNr)
+	if blockNr == rpctypes.EthLatestBlockNumber || blockNr == rpctypes.EthPendingBlockNumber {
+		latest, err := a.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		blockNr = rpctypes.BlockNumber(latest) //#nosec G115 -- block height fits in int64
+	}
+	return a.blockTraces(blockNr)
+}
+
+// Transaction returns the flat traces of a transaction.
+func (a *API) Transaction(hash common.Hash) ([]*Trace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	tx, err := a.backend.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || tx.BlockNumber == nil || tx.BlockHash == nil || tx.TransactionIndex == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+	}
+
+	traces, err := a.transactionTraces(hash)
+	if err != nil {
+		return nil, err
+	}
+	blockNumber := tx.BlockNumber.ToInt().Uint64()
+	position := uint64(*tx.TransactionIndex)
+	setTraceLocation(traces, *tx.BlockHash, blockNumber, hash, position)
+	return traces, nil
+}
+
+// Filter returns the flat traces of the blocks in the given range whose sender
+// and recipient match the filter addresses. As every block of the range is
+// re-executed, it is disabled unless a block range cap is configured.
+func (a *API) Filter(args FilterArgs) ([]*Trace, error) {
+	a.logger.Debug("trace_filter", "args", args)
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := resolveBlockNumber(args.FromBlock, latest), resolveBlockNumber(args.ToBlock, latest)
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d > to %d", from, to)
+	}
+	if a.blockRangeCap == 0 {
+		return nil, errors.New("trace_filter is disabled, see the trace-filter-block-range-cap option")
+	}
+	if to-from >= int64(a.blockRangeCap) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", a.blockRangeCap)
+	}
+
+	fromAddresses := addressSet(args.FromAddress)
+	toAddresses := addressSet(args.ToAddress)
+
+	var (
+		skipped uint64
+		traces  = []*Trace{}
+	)
+	for height := from; height <= to; height++ {
+		blockTraces, err := a.blockTraces(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			sender, recipient := traceParties(trace)
+			if !matches(fromAddresses, &sender) || !matches(toAddresses, recipient) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// ReplayTransaction replays a transaction and returns the requested trace
+// types. Only the "trace" and "stateDiff" types are supported.
+func (a *API) ReplayTransaction(hash common.Hash, traceTypes []string) (*TraceResults, error) {
+	a.logger.Debug("trace_replayTransaction", "hash", hash, "types", traceTypes)
+	var withTrace, withStateDiff bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVMTrace:
+			return nil, errors.New("vmTrace is not supported")
+		default:
+			return nil, fmt.Errorf("invalid trace type: %s", traceType)
+		}
+	}
+
+	// the state diff is traced along the call frame in a single replay
+	if withStateDiff {
+		result, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{
+			Tracer:           muxTracer,
+			TracerJsonConfig: `{"callTracer":{},"prestateTracer":{"diffMode":true}}`,
+		})
+		if err != nil {
+			return nil, err
+		}
+		frame, diff, err := decodeMuxResult(result)
+		if err != nil {
+			return nil, err
+		}
+		res := &TraceResults{Output: frame.Output, StateDiff: toStateDiff(diff)}
+		if withTrace {
+			res.Trace = flattenCallFrame(frame)
+		}
+		return res, nil
+	}
+
+	result, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+	frame, err := decodeCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+	res := &TraceResults{Output: frame.Output}
+	if withTrace {
+		res.Trace = flattenCallFrame(frame)
+	}
+	return res, nil
+}
+
+// blockTraces returns the flat traces of all the transactions of a block,
+// with their block and transaction location.
+func (a *API) blockTraces(height rpctypes.BlockNumber) ([]*Trace, error) {
+	if height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := a.backend.TendermintBlockByNumber(height)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", height, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	msgs := a.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+
+	results, err := a.backend.TraceBlock(height, &evmtypes.TraceConfig{Tracer: callTracer}, resBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf("block %d traces mismatch: %d traces for %d transactions", height, len(results), len(msgs))
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	blockNumber := uint64(resBlock.Block.Height) //#nosec G115 -- block height is never negative
+
+	traces := []*Trace{}
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msgs[i].Hash, result.Error)
+		}
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		txTraces := flattenCallFrame(frame)
+		setTraceLocation(txTraces, blockHash, blockNumber, common.HexToHash(msgs[i].Hash), uint64(i))
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// transactionTraces returns the flat traces of a transaction.
+func (a *API) transactionTraces(hash common.Hash) ([]*Trace, error) {
+	result, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+	frame, err := decodeCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+	return flattenCallFrame(frame), nil
+}
+
+// setTraceLocation sets the block and transaction fields of the traces of a
+// transaction.
+func setTraceLocation(traces []*Trace, blockHash common.Hash, blockNumber uint64, txHash common.Hash, position uint64) {
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &position
+	}
+}
+
+// resolveBlockNumber returns the height of a filter block number, defaulting to
+// the latest block.
+func resolveBlockNumber(blockNr *rpctypes.BlockNumber, latest hexutil.Uint64) int64 {
+	if blockNr == nil || *blockNr == rpctypes.EthLatestBlockNumber || *blockNr == rpctypes.EthPendingBlockNumber {
+		return int64(latest) //#nosec G115 -- block height fits in int64
+	}
+	return blockNr.Int64()
+}
+
+func addressSet(addresses []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		set[addr] = struct{}{}
+	}
+	return set
+}
+
+// matches returns true if the set is empty or contains the address.
+func matches(set map[common.Address]struct{}, addr *common.Address) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	_, ok := set[*addr]
+	return ok
+}
@@ -0,0 +1,141 @@
+package trace
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// callFrame is a call frame of the callTracer output.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
+
+// parityErrors maps the EVM error messages to the ones reported by Parity.
+var parityErrors = map[string]string{
+	"execution reverted":       "Reverted",
+	"out of gas":               "Out of gas",
+	"invalid jump destination": "Bad jump destination",
+}
+
+// decodeCallFrame decodes the callTracer result returned by the backend.
+func decodeCallFrame(result interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// flattenCallFrame converts a callTracer call frame into the pre-ordered list
+// of Parity flat traces of the frame and its subcalls.
+func flattenCallFrame(frame *callFrame) []*Trace {
+	var traces []*Trace
+	flatten(frame, []int{}, &traces)
+	return traces
+}
+
+func flatten(frame *callFrame, traceAddress []int, traces *[]*Trace) {
+	trace := &Trace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+	if frame.Error != "" {
+		trace.Error = parityError(frame.Error)
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+
+	switch typ := strings.ToUpper(frame.Type); typ {
+	case "CREATE", "CREATE2":
+		trace.Type = TypeCreate
+		trace.Action = &CreateAction{
+			CreationMethod: strings.ToLower(typ),
+			From:           frame.From,
+			Gas:            frame.Gas,
+			Init:           frame.Input,
+			Value:          value,
+		}
+		if frame.Error == "" {
+			trace.Result = &CreateResult{
+				Address: frame.To,
+				Code:    frame.Output,
+				GasUsed: frame.GasUsed,
+			}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = TypeSuicide
+		trace.Action = &SuicideAction{
+			Address:       frame.From,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+	default:
+		trace.Type = TypeCall
+		trace.Action = &CallAction{
+			CallType: strings.ToLower(typ),
+			From:     frame.From,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			To:       frame.To,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			trace.Result = &CallResult{
+				GasUsed: frame.GasUsed,
+				Output:  frame.Output,
+			}
+		}
+	}
+
+	*traces = append(*traces, trace)
+	for i := range frame.Calls {
+		// copy the parent address so that siblings don't share the backing array
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = i
+		flatten(&frame.Calls[i], childAddress, traces)
+	}
+}
+
+// parityError returns the Parity error message of an EVM error.
+func parityError(err string) string {
+	if msg, ok := parityErrors[err]; ok {
+		return msg
+	}
+	return err
+}
+
+// traceParties returns the sender and recipient of a trace used by trace_filter.
+func traceParties(trace *Trace) (from common.Address, to *common.Address) {
+	switch action := trace.Action.(type) {
+	case *CallAction:
+		return action.From, action.To
+	case *CreateAction:
+		if result, ok := trace.Result.(*CreateResult); ok {
+			return action.From, result.Address
+		}
+		return action.From, nil
+	case *SuicideAction:
+		return action.Address, action.RefundAddress
+	}
+	return common.Address{}, nil
+}
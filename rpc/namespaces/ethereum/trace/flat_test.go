@@ -0,0 +1,139 @@
+package trace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		library  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		created  = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	)
+
+	// callTracer output as decoded from the JSON-RPC backend
+	result := map[string]interface{}{
+		"type":    "CALL",
+		"from":    sender.Hex(),
+		"to":      contract.Hex(),
+		"value":   "0x1",
+		"gas":     "0x5208",
+		"gasUsed": "0x5000",
+		"input":   "0x01",
+		"output":  "0x02",
+		"calls": []interface{}{
+			map[string]interface{}{
+				"type":    "DELEGATECALL",
+				"from":    contract.Hex(),
+				"to":      library.Hex(),
+				"gas":     "0x100",
+				"gasUsed": "0x10",
+				"input":   "0x03",
+				"error":   "execution reverted",
+			},
+			map[string]interface{}{
+				"type":    "CREATE2",
+				"from":    contract.Hex(),
+				"to":      created.Hex(),
+				"value":   "0x0",
+				"gas":     "0x200",
+				"gasUsed": "0x20",
+				"input":   "0x04",
+				"output":  "0x05",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"type":  "SELFDESTRUCT",
+						"from":  created.Hex(),
+						"to":    sender.Hex(),
+						"value": "0x2",
+						"gas":   "0x0",
+						"input": "0x",
+					},
+				},
+			},
+		},
+	}
+
+	frame, err := decodeCallFrame(result)
+	require.NoError(t, err)
+	traces := flattenCallFrame(frame)
+	require.Len(t, traces, 4)
+
+	require.Equal(t, TypeCall, traces[0].Type)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, &CallAction{
+		CallType: "call",
+		From:     sender,
+		Gas:      0x5208,
+		Input:    hexutil.Bytes{0x01},
+		To:       &contract,
+		Value:    (*hexutil.Big)(common.Big1),
+	}, traces[0].Action)
+	require.Equal(t, &CallResult{GasUsed: 0x5000, Output: hexutil.Bytes{0x02}}, traces[0].Result)
+
+	require.Equal(t, TypeCall, traces[1].Type)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, "delegatecall", traces[1].Action.(*CallAction).CallType)
+	require.Equal(t, "Reverted", traces[1].Error)
+	require.Nil(t, traces[1].Result)
+
+	require.Equal(t, TypeCreate, traces[2].Type)
+	require.Equal(t, []int{1}, traces[2].TraceAddress)
+	require.Equal(t, 1, traces[2].Subtraces)
+	require.Equal(t, "create2", traces[2].Action.(*CreateAction).CreationMethod)
+	require.Equal(t, &CreateResult{Address: &created, Code: hexutil.Bytes{0x05}, GasUsed: 0x20}, traces[2].Result)
+
+	require.Equal(t, TypeSuicide, traces[3].Type)
+	require.Equal(t, []int{1, 0}, traces[3].TraceAddress)
+	require.Equal(t, &SuicideAction{
+		Address:       created,
+		RefundAddress: &sender,
+		Balance:       (*hexutil.Big)(common.Big2),
+	}, traces[3].Action)
+	require.Nil(t, traces[3].Result)
+
+	from, to := traceParties(traces[2])
+	require.Equal(t, contract, from)
+	require.Equal(t, &created, to)
+
+	// the location fields are omitted until set
+	bz, err := json.Marshal(traces[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "blockHash")
+	require.NotContains(t, string(bz), "transactionPosition")
+}
+
+func TestParityError(t *testing.T) {
+	testCases := []struct {
+		err    string
+		expErr string
+	}{
+		{"execution reverted", "Reverted"},
+		{"out of gas", "Out of gas"},
+		{"invalid jump destination", "Bad jump destination"},
+		{"stack underflow (0 <=> 1)", "stack underflow (0 <=> 1)"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.err, func(t *testing.T) {
+			require.Equal(t, tc.expErr, parityError(tc.err))
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+
+	require.True(t, matches(addressSet(nil), nil))
+	require.True(t, matches(addressSet(nil), &addr))
+	require.True(t, matches(addressSet([]common.Address{addr}), &addr))
+	require.False(t, matches(addressSet([]common.Address{addr}), &other))
+	require.False(t, matches(addressSet([]common.Address{addr}), nil))
+}
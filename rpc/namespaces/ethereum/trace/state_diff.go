@@ -0,0 +1,172 @@
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// unchangedDiff is the diff of a field that is left unchanged.
+const unchangedDiff = "="
+
+// prestateAccount is an account of the prestateTracer output in diff mode.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   *uint64                     `json:"nonce,omitempty"`
+	Code    *hexutil.Bytes              `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// prestateDiff is the prestateTracer output in diff mode. The post state only
+// holds the fields modified by the transaction.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// decodePrestateDiff decodes the prestateTracer result returned by the backend.
+func decodePrestateDiff(result interface{}) (*prestateDiff, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var diff prestateDiff
+	if err := json.Unmarshal(bz, &diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
+
+// decodeMuxResult decodes the muxTracer result of a callTracer and a
+// prestateTracer in diff mode returned by the backend.
+func decodeMuxResult(result interface{}) (*callFrame, *prestateDiff, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+	var res struct {
+		CallTracer     *callFrame    `json:"callTracer"`
+		PrestateTracer *prestateDiff `json:"prestateTracer"`
+	}
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, nil, err
+	}
+	if res.CallTracer == nil || res.PrestateTracer == nil {
+		return nil, nil, errors.New("incomplete muxTracer result")
+	}
+	return res.CallTracer, res.PrestateTracer, nil
+}
+
+// toStateDiff converts the prestateTracer diff into a Parity state diff.
+func toStateDiff(diff *prestateDiff) StateDiff {
+	stateDiff := make(StateDiff)
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			// the prestate tracer omits from the post state the accounts that
+			// are only read, so only the ones that got emptied are deleted
+			if pre.isEmpty() {
+				continue
+			}
+			stateDiff[addr] = deletedAccountDiff(pre)
+			continue
+		}
+		stateDiff[addr] = changedAccountDiff(pre, post)
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; ok {
+			continue
+		}
+		stateDiff[addr] = createdAccountDiff(post)
+	}
+	return stateDiff
+}
+
+func (a *prestateAccount) isEmpty() bool {
+	return a.Balance == nil && a.Nonce == nil && a.Code == nil && len(a.Storage) == 0
+}
+
+func createdAccountDiff(post *prestateAccount) *AccountDiff {
+	diff := &AccountDiff{
+		Balance: map[string]interface{}{"+": balanceOrZero(post.Balance)},
+		Nonce:   map[string]interface{}{"+": nonceOrZero(post.Nonce)},
+		Code:    map[string]interface{}{"+": codeOrEmpty(post.Code)},
+		Storage: make(map[common.Hash]interface{}, len(post.Storage)),
+	}
+	for key, value := range post.Storage {
+		diff.Storage[key] = map[string]interface{}{"+": value}
+	}
+	return diff
+}
+
+func deletedAccountDiff(pre *prestateAccount) *AccountDiff {
+	diff := &AccountDiff{
+		Balance: map[string]interface{}{"-": balanceOrZero(pre.Balance)},
+		Nonce:   map[string]interface{}{"-": nonceOrZero(pre.Nonce)},
+		Code:    map[string]interface{}{"-": codeOrEmpty(pre.Code)},
+		Storage: make(map[common.Hash]interface{}, len(pre.Storage)),
+	}
+	for key, value := range pre.Storage {
+		diff.Storage[key] = map[string]interface{}{"-": value}
+	}
+	return diff
+}
+
+func changedAccountDiff(pre, post *prestateAccount) *AccountDiff {
+	diff := &AccountDiff{
+		Balance: unchangedDiff,
+		Nonce:   unchangedDiff,
+		Code:    unchangedDiff,
+		Storage: make(map[common.Hash]interface{}),
+	}
+	if post.Balance != nil {
+		diff.Balance = changed(balanceOrZero(pre.Balance), post.Balance)
+	}
+	if post.Nonce != nil {
+		diff.Nonce = changed(nonceOrZero(pre.Nonce), hexutil.Uint64(*post.Nonce))
+	}
+	if post.Code != nil {
+		diff.Code = changed(codeOrEmpty(pre.Code), *post.Code)
+	}
+	// slots missing from the post state have been cleared and the ones
+	// missing from the pre state were empty
+	for key, value := range pre.Storage {
+		diff.Storage[key] = changed(value, post.Storage[key])
+	}
+	for key, value := range post.Storage {
+		if _, ok := pre.Storage[key]; ok {
+			continue
+		}
+		diff.Storage[key] = changed(common.Hash{}, value)
+	}
+	return diff
+}
+
+func changed(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"*": map[string]interface{}{"from": from, "to": to},
+	}
+}
+
+func balanceOrZero(balance *hexutil.Big) *hexutil.Big {
+	if balance == nil {
+		return new(hexutil.Big)
+	}
+	return balance
+}
+
+func nonceOrZero(nonce *uint64) hexutil.Uint64 {
+	if nonce == nil {
+		return 0
+	}
+	return hexutil.Uint64(*nonce)
+}
+
+func codeOrEmpty(code *hexutil.Bytes) hexutil.Bytes {
+	if code == nil {
+		return hexutil.Bytes{}
+	}
+	return *code
+}
@@ -0,0 +1,99 @@
+package trace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToStateDiff(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		created  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		deleted  = common.HexToAddress("0x4000000000000000000000000000000000000004")
+
+		slot1 = common.HexToHash("0x1")
+		slot2 = common.HexToHash("0x2")
+		slot3 = common.HexToHash("0x3")
+	)
+
+	// prestateTracer diff mode output as decoded from the JSON-RPC backend
+	result := map[string]interface{}{
+		"pre": map[string]interface{}{
+			sender.Hex():   map[string]interface{}{"balance": "0x10", "nonce": 1},
+			contract.Hex(): map[string]interface{}{"balance": "0x0", "code": "0x60", "storage": map[string]interface{}{slot1.Hex(): common.HexToHash("0xa").Hex(), slot2.Hex(): common.HexToHash("0xb").Hex()}},
+			deleted.Hex():  map[string]interface{}{"balance": "0x5", "code": "0x61"},
+		},
+		"post": map[string]interface{}{
+			sender.Hex():   map[string]interface{}{"balance": "0x8", "nonce": 2},
+			contract.Hex(): map[string]interface{}{"storage": map[string]interface{}{slot1.Hex(): common.HexToHash("0xc").Hex(), slot3.Hex(): common.HexToHash("0xd").Hex()}},
+			created.Hex():  map[string]interface{}{"balance": "0x1", "nonce": 1, "code": "0x62"},
+		},
+	}
+
+	diff, err := decodePrestateDiff(result)
+	require.NoError(t, err)
+	stateDiff := toStateDiff(diff)
+	require.Len(t, stateDiff, 4)
+
+	bz, err := json.Marshal(stateDiff)
+	require.NoError(t, err)
+	var got map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &got))
+
+	senderDiff := got[toKey(sender)]
+	require.Equal(t, map[string]interface{}{"*": map[string]interface{}{"from": "0x10", "to": "0x8"}}, senderDiff["balance"])
+	require.Equal(t, map[string]interface{}{"*": map[string]interface{}{"from": "0x1", "to": "0x2"}}, senderDiff["nonce"])
+	require.Equal(t, "=", senderDiff["code"])
+	require.Empty(t, senderDiff["storage"])
+
+	contractDiff := got[toKey(contract)]
+	require.Equal(t, "=", contractDiff["balance"])
+	require.Equal(t, "=", contractDiff["nonce"])
+	require.Equal(t, "=", contractDiff["code"])
+	require.Equal(t, map[string]interface{}{
+		slot1.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.HexToHash("0xa").Hex(), "to": common.HexToHash("0xc").Hex()}},
+		slot2.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.HexToHash("0xb").Hex(), "to": common.Hash{}.Hex()}},
+		slot3.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.Hash{}.Hex(), "to": common.HexToHash("0xd").Hex()}},
+	}, contractDiff["storage"])
+
+	createdDiff := got[toKey(created)]
+	require.Equal(t, map[string]interface{}{"+": "0x1"}, createdDiff["balance"])
+	require.Equal(t, map[string]interface{}{"+": "0x1"}, createdDiff["nonce"])
+	require.Equal(t, map[string]interface{}{"+": "0x62"}, createdDiff["code"])
+
+	deletedDiff := got[toKey(deleted)]
+	require.Equal(t, map[string]interface{}{"-": "0x5"}, deletedDiff["balance"])
+	require.Equal(t, map[string]interface{}{"-": "0x0"}, deletedDiff["nonce"])
+	require.Equal(t, map[string]interface{}{"-": "0x61"}, deletedDiff["code"])
+}
+
+// toKey returns the JSON object key of an address.
+func toKey(addr common.Address) string {
+	bz, _ := addr.MarshalText()
+	return string(bz)
+}
+
+func TestDecodeMuxResult(t *testing.T) {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// muxTracer output of a callTracer and a prestateTracer in diff mode
+	result := map[string]interface{}{
+		"callTracer": map[string]interface{}{"type": "CALL", "from": sender.Hex(), "gas": "0x5208", "gasUsed": "0x5208", "output": "0x01"},
+		"prestateTracer": map[string]interface{}{
+			"pre":  map[string]interface{}{sender.Hex(): map[string]interface{}{"balance": "0x10", "nonce": 1}},
+			"post": map[string]interface{}{sender.Hex(): map[string]interface{}{"balance": "0x8", "nonce": 2}},
+		},
+	}
+
+	frame, diff, err := decodeMuxResult(result)
+	require.NoError(t, err)
+	require.Equal(t, "0x01", frame.Output.String())
+	require.Len(t, toStateDiff(diff), 1)
+
+	_, _, err = decodeMuxResult(map[string]interface{}{"callTracer": result["callTracer"]})
+	require.Error(t, err)
+}
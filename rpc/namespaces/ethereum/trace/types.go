@@ -0,0 +1,111 @@
+package trace
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Parity trace types
+const (
+	TypeCall    = "call"
+	TypeCreate  = "create"
+	TypeSuicide = "suicide"
+)
+
+// Trace types accepted by trace_replayTransaction
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// Trace is a Parity (OpenEthereum) style flat call trace. The block and
+// transaction fields are omitted on replayed transactions.
+type Trace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// CallAction is the action of a call trace.
+type CallAction struct {
+	CallType string          `json:"callType"`
+	From     common.Address  `json:"from"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	Input    hexutil.Bytes   `json:"input"`
+	To       *common.Address `json:"to"`
+	Value    *hexutil.Big    `json:"value"`
+}
+
+// CreateAction is the action of a contract creation trace.
+type CreateAction struct {
+	CreationMethod string         `json:"creationMethod"`
+	From           common.Address `json:"from"`
+	Gas            hexutil.Uint64 `json:"gas"`
+	Init           hexutil.Bytes  `json:"init"`
+	Value          *hexutil.Big   `json:"value"`
+}
+
+// SuicideAction is the action of a self destruct trace.
+type SuicideAction struct {
+	Address       common.Address  `json:"address"`
+	RefundAddress *common.Address `json:"refundAddress"`
+	Balance       *hexutil.Big    `json:"balance"`
+}
+
+// CallResult is the result of a successful call trace.
+type CallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// CreateResult is the result of a successful contract creation trace.
+type CreateResult struct {
+	Address *common.Address `json:"address"`
+	Code    hexutil.Bytes   `json:"code"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+}
+
+// FilterArgs are the arguments of trace_filter. Traces match when their
+// sender is in FromAddress and their recipient is in ToAddress, an empty list
+// matching any address.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// TraceResults is the result of trace_replayTransaction. The fields that are
+// not requested are null.
+type TraceResults struct {
+	Output    hexutil.Bytes `json:"output"`
+	StateDiff StateDiff     `json:"stateDiff"`
+	Trace     []*Trace      `json:"trace"`
+	VMTrace   interface{}   `json:"vmTrace"`
+}
+
+// StateDiff is the Parity style state diff of a transaction, by account.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff is the diff of the fields of an account. Each diff is either
+// "=" if the field is unchanged, {"+": value} if the account is created,
+// {"-": value} if the account is deleted or {"*": {"from": value, "to": value}}
+// if the field is changed.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Nonce   interface{}                 `json:"nonce"`
+	Code    interface{}                 `json:"code"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -13,6 +14,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -212,10 +214,13 @@ func NewRPCTransaction(
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
-	case ethtypes.DynamicFeeTxType:
+	case ethtypes.DynamicFeeTxType, ethtypes.SetCodeTxType:
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
+		if tx.Type() == ethtypes.SetCodeTxType {
+			result.AuthorizationList = tx.SetCodeAuthorizations()
+		}
 		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
 		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
 		// if the transaction has been mined, compute the effective gas price
@@ -290,3 +295,18 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
 }
+
+// SyncProgress returns the sync progress of the node from the CometBFT sync
+// info, and false if the node is not catching up. The sync starts from the
+// earliest block of the node and, as CometBFT doesn't know the height of the
+// network, the highest block is the latest block of the node.
+func SyncProgress(syncInfo coretypes.SyncInfo) (ethereum.SyncProgress, bool) {
+	if !syncInfo.CatchingUp {
+		return ethereum.SyncProgress{}, false
+	}
+	return ethereum.SyncProgress{
+		StartingBlock: uint64(syncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
+		CurrentBlock:  uint64(syncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
+		HighestBlock:  uint64(syncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
+	}, true
+}
@@ -14,7 +14,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -24,10 +26,13 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/rpc/ethereum/pubsub"
+	"github.com/cosmos/evm/rpc/limiter"
+	rpcmetrics "github.com/cosmos/evm/rpc/metrics"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
@@ -40,6 +45,10 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// syncingPollInterval is the interval at which the syncing subscriptions
+	// poll the CometBFT status
+	syncingPollInterval = time.Second
 )
 
 type WebsocketsServer interface {
@@ -80,11 +89,19 @@ type websocketsServer struct {
 	certFile       string
 	keyFile        string
 	allowedOrigins []string // allowed origins for WebSocket connections
+	limiter        *limiter.Limiter
+	apiKeyHeader   string // header identifying the clients by API key for the rate limiting
 	api            *pubSubAPI
 	logger         log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	rpcLimiter *limiter.Limiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -92,6 +109,8 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
+		limiter:        rpcLimiter,
+		apiKeyHeader:   cfg.JSONRPC.RateLimitAPIKeyHeader,
 		api:            newPubSubAPI(clientCtx, logger, tmWSClient),
 		logger:         logger,
 	}
@@ -200,10 +219,18 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:      new(sync.Mutex),
+		conn:     conn,
+		clientIP: limiter.ClientIP(r),
+		client:   s.limiter.Client(r),
+	}
+	if s.apiKeyHeader != "" {
+		ws.apiKey = r.Header.Get(s.apiKeyHeader)
 	}
 
+	rpcmetrics.WSConnectionsGauge.Inc(1)
+	defer rpcmetrics.WSConnectionsGauge.Dec(1)
+
 	s.readLoop(ws)
 }
 
@@ -220,9 +247,30 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// sendLimitExceededResponse sends the error response of a call exceeding the
+// rate limit of the client.
+func (s *websocketsServer) sendLimitExceededResponse(wsConn *wsConn, connID float64, method string) {
+	res := &ErrorResponseJSON{
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(limiter.ErrCodeLimitExceeded),
+			Message: fmt.Sprintf("rate limit exceeded for the method %s", method),
+		},
+		ID: big.NewInt(int64(connID)),
+	}
+
+	_ = wsConn.WriteJSON(res) // #nosec G703
+}
+
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// clientIP and apiKey identify the client in the requests forwarded to
+	// the JSON-RPC server, for the rate limiting
+	clientIP string
+	apiKey   string
+	// client identifies the client for the rate limiting of the subscriptions
+	client string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -254,6 +302,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		for _, unsubFn := range subscriptions {
 			unsubFn()
 		}
+		rpcmetrics.WSSubscriptionsGauge.Dec(int64(len(subscriptions)))
 	}()
 
 readLoop:
@@ -306,8 +355,18 @@ readLoop:
 			continue
 		}
 
+		if !s.limiter.Allowed(method) {
+			s.sendErrResponse(wsConn, fmt.Sprintf("the method %s is not allowed", method))
+			continue
+		}
+
 		switch method {
 		case "eth_subscribe":
+			if !s.limiter.Allow(wsConn.client, s.limiter.Cost(method)) {
+				s.sendLimitExceededResponse(wsConn, connID, method)
+				continue
+			}
+
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -320,6 +379,7 @@ readLoop:
 				continue
 			}
 			subscriptions[subID] = unsubFn
+			rpcmetrics.WSSubscriptionsGauge.Inc(1)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -332,6 +392,11 @@ readLoop:
 				break readLoop
 			}
 		case "eth_unsubscribe":
+			if !s.limiter.Allow(wsConn.client, s.limiter.Cost(method)) {
+				s.sendLimitExceededResponse(wsConn, connID, method)
+				continue
+			}
+
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -348,6 +413,7 @@ readLoop:
 			if ok {
 				delete(subscriptions, subID)
 				unsubFn()
+				rpcmetrics.WSSubscriptionsGauge.Dec(1)
 			}
 
 			res := &SubscriptionResponseJSON{
@@ -394,6 +460,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(limiter.ForwardedForHeader, wsConn.clientIP)
+	if wsConn.apiKey != "" {
+		req.Header.Set(s.apiKeyHeader, wsConn.apiKey)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -755,8 +825,83 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// SyncingResult is the notification of the syncing subscription while the
+// node is catching up, in the geth format.
+type SyncingResult struct {
+	Syncing bool                  `json:"syncing"`
+	Status  ethereum.SyncProgress `json:"status"`
+}
+
+// syncingNotification returns the notification of a syncing subscription:
+// the sync progress if the node is catching up, false otherwise.
+func syncingNotification(syncInfo coretypes.SyncInfo) interface{} {
+	progress, syncing := types.SyncProgress(syncInfo)
+	if !syncing {
+		return false
+	}
+	return &SyncingResult{Syncing: true, Status: progress}
+}
+
+// subscribeSyncing polls the CometBFT status and, like geth, only notifies when
+// the node starts or stops syncing, the node being considered synced when the
+// subscription starts.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		var syncing bool
+		for {
+			// poll on ticks only, so that the subscription ID is sent before
+			// the first notification
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			status, err := api.clientCtx.Client.Status(ctx)
+			if err != nil {
+				api.logger.Debug("failed to get node status for syncing subscription", "subscription-id", subID, "error", err.Error())
+				continue
+			}
+
+			if status.SyncInfo.CatchingUp == syncing {
+				continue
+			}
+			syncing = status.SyncInfo.CatchingUp
+			notification := syncingNotification(status.SyncInfo)
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       notification,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
+					}
+				}, api.logger, "closing websocket peer sub")
+				return
+			}
+		}
+	}()
+
+	return pubsub.UnsubscribeFunc(cancel), nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
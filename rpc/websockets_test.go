@@ -1,17 +1,24 @@
 package rpc
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	"github.com/cosmos/evm/rpc/limiter"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -19,14 +26,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 )
 
-func newTestWebsocketServer() *websocketsServer {
+func newTestWebsocketServer(t *testing.T, jsonrpcCfg config.JSONRPCConfig) *websocketsServer {
+	t.Helper()
 	// dummy values for testing
-	cfg := &config.Config{}
+	cfg := &config.Config{JSONRPC: jsonrpcCfg}
 	cfg.JSONRPC.Address = "localhost:9999"   // not used
 	cfg.JSONRPC.WsAddress = "localhost:9999" // not used
 	cfg.TLS.CertificatePath = ""
 	cfg.TLS.KeyPath = ""
 
+	rpcLimiter, err := limiter.New(cfg.JSONRPC)
+	require.NoError(t, err)
+
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
 		wsAddr:         cfg.JSONRPC.WsAddress,
@@ -35,11 +46,12 @@ func newTestWebsocketServer() *websocketsServer {
 		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &rpcclient.WSClient{}),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
+		limiter:        rpcLimiter,
 	}
 }
 
 func TestWebsocketPayloadLimit(t *testing.T) {
-	srv := newTestWebsocketServer()
+	srv := newTestWebsocketServer(t, *config.DefaultJSONRPCConfig())
 
 	ts := httptest.NewServer(srv)
 	defer ts.Close()
@@ -63,6 +75,42 @@ func TestWebsocketPayloadLimit(t *testing.T) {
 	require.Error(t, readErr, "expected connection to close on oversized message")
 }
 
+func TestWebsocketSubscriptionRateLimit(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimit = 1
+	cfg.RateLimitBurst = 1
+	srv := newTestWebsocketServer(t, *cfg)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	unsubscribe := func(id int) map[string]interface{} {
+		msg := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"eth_unsubscribe","params":["0x1"]}`, id)
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		var res map[string]interface{}
+		require.NoError(t, conn.ReadJSON(&res))
+		return res
+	}
+
+	// the first call uses the bucket of the client
+	res := unsubscribe(1)
+	require.Equal(t, false, res["result"])
+
+	// the second call exceeds the rate limit
+	res = unsubscribe(2)
+	resErr, ok := res["error"].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, float64(limiter.ErrCodeLimitExceeded), resErr["code"])
+	require.Equal(t, float64(2), res["id"])
+}
+
 func TestCheckOrigin(t *testing.T) {
 	logger := log.NewNopLogger()
 	tests := []struct {
@@ -215,3 +263,71 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func syncingResult(startingBlock, currentBlock uint64) *SyncingResult {
+	return &SyncingResult{
+		Syncing: true,
+		Status:  ethereum.SyncProgress{StartingBlock: startingBlock, CurrentBlock: currentBlock, HighestBlock: currentBlock},
+	}
+}
+
+func TestSyncingNotification(t *testing.T) {
+	syncInfo := func(catchingUp bool, height int64) coretypes.SyncInfo {
+		return coretypes.SyncInfo{CatchingUp: catchingUp, EarliestBlockHeight: 1, LatestBlockHeight: height}
+	}
+
+	require.Equal(t, false, syncingNotification(syncInfo(false, 5)))
+	// the sync starts from the earliest block, as reported by eth_syncing
+	require.Equal(t, syncingResult(1, 10), syncingNotification(syncInfo(true, 10)))
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	cmtClient := mocks.NewClient(t)
+	status := func(catchingUp bool, height int64) *coretypes.ResultStatus {
+		return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: catchingUp, EarliestBlockHeight: 1, LatestBlockHeight: height}}
+	}
+	cmtClient.On("Status", mock.Anything).Return(status(false, 5), nil).Once()
+	cmtClient.On("Status", mock.Anything).Return(status(true, 10), nil).Twice()
+	cmtClient.On("Status", mock.Anything).Return(status(true, 11), nil).Once()
+	cmtClient.On("Status", mock.Anything).Return(status(false, 12), nil).Maybe()
+
+	srv := newTestWebsocketServer(t, *config.DefaultJSONRPCConfig())
+	srv.api = newPubSubAPI(client.Context{}.WithClient(cmtClient), log.NewNopLogger(), &rpcclient.WSClient{})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	conn, httpResp, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NotNil(t, httpResp)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0", "id": 1, "method": "eth_subscribe", "params": []interface{}{"syncing"},
+	}))
+
+	var subRes SubscriptionResponseJSON
+	require.NoError(t, conn.ReadJSON(&subRes))
+	require.NotEmpty(t, subRes.Result)
+
+	// only the start and the end of the sync are notified
+	var results []interface{}
+	for range 2 {
+		var notification struct {
+			Params struct {
+				Result json.RawMessage `json:"result"`
+			} `json:"params"`
+		}
+		require.NoError(t, conn.ReadJSON(&notification))
+
+		if string(notification.Params.Result) == "false" {
+			results = append(results, false)
+			continue
+		}
+		result := &SyncingResult{}
+		require.NoError(t, json.Unmarshal(notification.Params.Result, result))
+		results = append(results, result)
+	}
+	require.Equal(t, []interface{}{syncingResult(1, 10), false}, results)
+}
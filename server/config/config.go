@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	gostrings "strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -84,6 +86,14 @@ const (
 	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' query
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultLogIndexScanCap is the default cap of indexed logs read from the KV indexer log index by
+	// a single 'eth_getLogs' query
+	DefaultLogIndexScanCap int32 = 100000
+
+	// DefaultTraceFilterBlockRangeCap is the default cap of block range allowed for 'trace_filter' query,
+	// which is disabled by default
+	DefaultTraceFilterBlockRangeCap int32 = 0
+
 	// DefaultEVMTimeout is the default timeout for eth_call
 	DefaultEVMTimeout = 5 * time.Second
 
@@ -118,10 +128,34 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultEnableStorageProofs toggles whether `eth_getProof` returns Merkle-Patricia storage proofs
+	DefaultEnableStorageProofs = false
+
+	// DefaultStorageProofMaxSlots is the default max number of storage slots of an account for its storage proofs to be built
+	DefaultStorageProofMaxSlots = 1000
+
+	// DefaultRateLimit is the default number of request cost units per second allowed per client (0 = unlimited)
+	DefaultRateLimit float64 = 0
+
+	// DefaultRateLimitBurst is the default maximum number of request cost units a client can use at once
+	DefaultRateLimitBurst = 100
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+// DefaultMethodCosts are the default cost weights of the JSON-RPC methods for the rate limiting.
+// The methods that are not listed cost 1.
+var DefaultMethodCosts = []string{
+	"eth_call:5",
+	"eth_estimateGas:5",
+	"eth_getLogs:20",
+	"eth_simulateV1:20",
+	"eth_getProof:10",
+	"debug_*:50",
+	"trace_*:50",
+}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -170,7 +204,14 @@ type JSONRPCConfig struct {
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
+	// It doesn't apply to the ranges served from the KV indexer log index, which are bounded by LogIndexScanCap.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// LogIndexScanCap defines the max number of indexed logs read from the KV indexer log index by a
+	// single `eth_getLogs` query, whether they match the query or not (0 = unlimited).
+	LogIndexScanCap int32 `mapstructure:"log-index-scan-cap"`
+	// TraceFilterBlockRangeCap defines the max block range allowed for `trace_filter` query, which
+	// re-executes every block of the range. The query is disabled if 0.
+	TraceFilterBlockRangeCap int32 `mapstructure:"trace-filter-block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -187,6 +228,15 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if the custom indexer also indexes the logs by address and topic, to serve
+	// the `eth_getLogs` range queries without reading the block results.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
+	// IndexerSQLDriver defines the driver of the SQL database the indexer service also writes the blocks,
+	// txs, receipts, logs and ERC20 transfers to, "sqlite" or "postgres" (empty = disabled).
+	IndexerSQLDriver string `mapstructure:"indexer-sql-driver"`
+	// IndexerSQLDSN defines the data source name of the SQL database, it defaults to the
+	// data/evmindexer.sqlite file for SQLite.
+	IndexerSQLDSN string `mapstructure:"indexer-sql-dsn"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -195,6 +245,32 @@ type JSONRPCConfig struct {
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableStorageProofs makes `eth_getProof` return the Merkle-Patricia storage root of the account
+	// and Merkle-Patricia storage proofs, computed on demand over the account storage, and enables the
+	// StorageProof gRPC query. The storage root is not committed to by the app hash or the block headers,
+	// so the proofs are NOT verifiable against the chain state.
+	EnableStorageProofs bool `mapstructure:"enable-storage-proofs"`
+	// StorageProofMaxSlots defines the max number of storage slots of an account, and of storage keys
+	// of a query, for its storage proofs to be built.
+	StorageProofMaxSlots int `mapstructure:"storage-proof-max-slots"`
+	// AllowedMethods restricts the methods that can be called to the given list. A `namespace_*` entry
+	// matches all the methods of a namespace. An empty list allows all the methods.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the methods that can't be called, with the same format as AllowedMethods.
+	// It takes precedence over AllowedMethods.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// RateLimit defines the number of request cost units per second allowed per client (0 = unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the maximum number of request cost units a client can use at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// RateLimitAPIKeyHeader defines the HTTP header identifying the clients by API key instead of by IP.
+	RateLimitAPIKeyHeader string `mapstructure:"rate-limit-api-key-header"`
+	// RateLimitAPIKeys defines the API keys of the clients identified by API key. The clients with other
+	// keys are identified by IP.
+	RateLimitAPIKeys []string `mapstructure:"rate-limit-api-keys"`
+	// MethodCosts defines the cost weights of the methods as `method:cost` entries, with the same method
+	// format as AllowedMethods. The methods that are not listed cost 1.
+	MethodCosts []string `mapstructure:"method-costs"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -231,7 +307,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -253,6 +329,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
 		BlockRangeCap:            DefaultBlockRangeCap,
+		LogIndexScanCap:          DefaultLogIndexScanCap,
+		TraceFilterBlockRangeCap: DefaultTraceFilterBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
@@ -261,10 +339,18 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndexer:         true,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		WSOrigins:                GetDefaultWSOrigins(),
 		EnableProfiling:          DefaultEnableProfiling,
+		EnableStorageProofs:      DefaultEnableStorageProofs,
+		StorageProofMaxSlots:     DefaultStorageProofMaxSlots,
+		AllowedMethods:           []string{},
+		DeniedMethods:            []string{},
+		RateLimit:                DefaultRateLimit,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		MethodCosts:              DefaultMethodCosts,
 	}
 }
 
@@ -298,6 +384,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.LogIndexScanCap < 0 {
+		return errors.New("JSON-RPC log index scan cap cannot be negative")
+	}
+
+	if c.TraceFilterBlockRangeCap < 0 {
+		return errors.New("JSON-RPC trace filter block range cap cannot be negative")
+	}
+
+	if c.StorageProofMaxSlots < 0 {
+		return errors.New("JSON-RPC storage proof max slots cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -314,6 +412,49 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	switch c.IndexerSQLDriver {
+	case "", "sqlite":
+	case "postgres":
+		if c.IndexerSQLDSN == "" {
+			return errors.New("JSON-RPC indexer SQL DSN cannot be empty for postgres")
+		}
+	default:
+		return fmt.Errorf("invalid JSON-RPC indexer SQL driver '%s', expected 'sqlite' or 'postgres'", c.IndexerSQLDriver)
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateLimit > 0 && c.RateLimitBurst <= 0 {
+		return errors.New("JSON-RPC rate limit burst must be positive when the rate limit is enabled")
+	}
+
+	if c.RateLimitAPIKeyHeader != "" && len(c.RateLimitAPIKeys) == 0 {
+		return errors.New("JSON-RPC rate limit API keys cannot be empty when the API key header is set")
+	}
+
+	for _, methods := range [][]string{c.AllowedMethods, c.DeniedMethods} {
+		for _, method := range methods {
+			if err := validateMethodPattern(method); err != nil {
+				return err
+			}
+		}
+	}
+
+	costs, err := ParseMethodCosts(c.MethodCosts)
+	if err != nil {
+		return err
+	}
+
+	if c.RateLimit > 0 {
+		for method, cost := range costs {
+			if cost > c.RateLimitBurst {
+				return fmt.Errorf("JSON-RPC method cost of '%s' cannot be greater than the rate limit burst", method)
+			}
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -327,6 +468,45 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// ParseMethodCosts parses the `method:cost` entries of the JSON-RPC method costs into a map from the
+// method, or `namespace_*` pattern, to its cost.
+func ParseMethodCosts(entries []string) (map[string]int, error) {
+	costs := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		method, costStr, found := gostrings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost '%s', expected 'method:cost'", entry)
+		}
+
+		if err := validateMethodPattern(method); err != nil {
+			return nil, err
+		}
+
+		cost, err := strconv.Atoi(costStr)
+		if err != nil || cost <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost '%s', the cost must be a positive integer", entry)
+		}
+
+		if _, found := costs[method]; found {
+			return nil, fmt.Errorf("repeated JSON-RPC method cost '%s'", method)
+		}
+		costs[method] = cost
+	}
+
+	return costs, nil
+}
+
+// validateMethodPattern returns an error if the given JSON-RPC method, or `namespace_*` pattern, is
+// not of the form `namespace_method`.
+func validateMethodPattern(method string) error {
+	namespace, name, found := gostrings.Cut(method, "_")
+	if !found || namespace == "" || name == "" {
+		return fmt.Errorf("invalid JSON-RPC method '%s', expected 'namespace_method' or 'namespace_*'", method)
+	}
+
+	return nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -69,3 +69,99 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigValidateLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		errMsg   string
+	}{
+		{
+			"default config",
+			func(*serverconfig.JSONRPCConfig) {},
+			"",
+		},
+		{
+			"rate limit with method lists",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimit = 10
+				cfg.AllowedMethods = []string{"eth_*", "net_version"}
+				cfg.DeniedMethods = []string{"eth_sendTransaction"}
+			},
+			"",
+		},
+		{
+			"negative rate limit",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimit = -1
+			},
+			"rate limit cannot be negative",
+		},
+		{
+			"rate limit without burst",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimit = 10
+				cfg.RateLimitBurst = 0
+			},
+			"burst must be positive",
+		},
+		{
+			"method cost greater than burst",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimit = 10
+				cfg.RateLimitBurst = 10
+				cfg.MethodCosts = []string{"eth_getLogs:20"}
+			},
+			"cannot be greater than the rate limit burst",
+		},
+		{
+			"API key header without API keys",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimit = 10
+				cfg.RateLimitAPIKeyHeader = "X-API-Key"
+			},
+			"API keys cannot be empty",
+		},
+		{
+			"invalid allowed method",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.AllowedMethods = []string{"eth"}
+			},
+			"invalid JSON-RPC method 'eth'",
+		},
+		{
+			"invalid method cost",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.MethodCosts = []string{"eth_call=5"}
+			},
+			"expected 'method:cost'",
+		},
+		{
+			"non positive method cost",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.MethodCosts = []string{"eth_call:0"}
+			},
+			"the cost must be a positive integer",
+		},
+		{
+			"repeated method cost",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.MethodCosts = []string{"eth_call:5", "eth_call:10"}
+			},
+			"repeated JSON-RPC method cost",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			tt.malleate(cfg)
+
+			err := cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
@@ -37,26 +37,40 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable               = "json-rpc.enable"
-	JSONRPCAPI                  = "json-rpc.api"
-	JSONRPCAddress              = "json-rpc.address"
-	JSONWsAddress               = "json-rpc.ws-address"
-	JSONRPCWSOrigins            = "json-rpc.ws-origins"
-	JSONRPCGasCap               = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
-	JSONRPCFilterCap            = "json-rpc.filter-cap"
-	JSONRPCLogsCap              = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
-	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
-	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
-	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnable                   = "json-rpc.enable"
+	JSONRPCAPI                      = "json-rpc.api"
+	JSONRPCAddress                  = "json-rpc.address"
+	JSONWsAddress                   = "json-rpc.ws-address"
+	JSONRPCWSOrigins                = "json-rpc.ws-origins"
+	JSONRPCGasCap                   = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock      = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout               = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap                 = "json-rpc.txfee-cap"
+	JSONRPCFilterCap                = "json-rpc.filter-cap"
+	JSONRPCLogsCap                  = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap            = "json-rpc.block-range-cap"
+	JSONRPCLogIndexScanCap          = "json-rpc.log-index-scan-cap"
+	JSONRPCTraceFilterBlockRangeCap = "json-rpc.trace-filter-block-range-cap"
+	JSONRPCHTTPTimeout              = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout          = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs      = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections       = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer            = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndexer         = "json-rpc.enable-log-indexer"
+	JSONRPCIndexerSQLDriver         = "json-rpc.indexer-sql-driver"
+	JSONRPCIndexerSQLDSN            = "json-rpc.indexer-sql-dsn"
+	JSONRPCBatchRequestLimit        = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize     = "json-rpc.batch-response-max-size"
+	JSONRPCEnableProfiling          = "json-rpc.enable-profiling"
+	JSONRPCEnableStorageProofs      = "json-rpc.enable-storage-proofs"
+	JSONRPCStorageProofMaxSlots     = "json-rpc.storage-proof-max-slots"
+	JSONRPCAllowedMethods           = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods            = "json-rpc.denied-methods"
+	JSONRPCRateLimit                = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCAPIKeyHeader             = "json-rpc.rate-limit-api-key-header"
+	JSONRPCAPIKeys                  = "json-rpc.rate-limit-api-keys"
+	JSONRPCMethodCosts              = "json-rpc.method-costs"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
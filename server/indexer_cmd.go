@@ -1,15 +1,22 @@
 package server
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -26,55 +33,49 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The reindex, verify and prune subcommands fix, check and prune the already indexed blocks.
+
+		With --sql, the blocks are indexed in the SQL database configured with json-rpc.indexer-sql-driver
+		instead, see the sql-migrate subcommand to manage its schema.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
 			direction := args[0]
 			if direction != "backward" && direction != "forward" {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			stores, err := openIndexerStores(cmd)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			defer stores.Close()
+			var idxer historicalIndexer = stores.idxer
+			blockStore := stores.blockStore
 
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+			useSQL, err := cmd.Flags().GetBool(flagSQL)
 			if err != nil {
 				return err
 			}
-			blockStore := cmtstore.NewBlockStore(tmdb)
-
-			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
-			if err != nil {
-				return err
+			if useSQL {
+				sqlIdxer, err := openConfiguredSQLIndexer(cmd)
+				if err != nil {
+					return err
+				}
+				defer sqlIdxer.Close()
+				if _, err := sqlIdxer.MigrateUp(); err != nil {
+					return err
+				}
+				idxer = sqlIdxer
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
 
 			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
+				blk, txResults, err := stores.loadBlock(height)
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if err := idxer.IndexBlock(blk, txResults); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -91,7 +92,8 @@ func NewIndexTxCmd() *cobra.Command {
 					// start from the latest block if indexer db is empty
 					first = blockStore.Height()
 				}
-				for i := first - 1; i > 0; i-- {
+				// stop at the earliest block kept by the block store
+				for i := first - 1; i >= max(blockStore.Base(), 1); i-- {
 					if err := indexBlock(i); err != nil {
 						return err
 					}
@@ -117,5 +119,318 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(flagSQL, false, "Index the blocks in the configured SQL database instead of the KV indexer")
+
+	cmd.AddCommand(
+		newReindexEthTxCmd(),
+		newVerifyEthTxIndexCmd(),
+		newPruneEthTxIndexCmd(),
+		newSQLMigrateCmd(),
+	)
+	return cmd
+}
+
+// newReindexEthTxCmd creates a new Cobra command to index again a range of blocks.
+func newReindexEthTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reindex [from] [to]",
+		Short: "Reindex the eth txs of a range of blocks",
+		Long: `Delete the indexed entries of the blocks within the [from, to] range and index them again,
+		e.g. to fix the entries written by a faulty version of the indexer.
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			defer stores.Close()
+
+			from, to, err := stores.parseHeightRange(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			for height := from; height <= to; height++ {
+				blk, txResults, err := stores.loadBlock(height)
+				if err != nil {
+					return err
+				}
+				if err := stores.idxer.ReindexBlock(blk, txResults); err != nil {
+					return err
+				}
+				fmt.Println(height)
+			}
+			return nil
+		},
+	}
+}
+
+// newVerifyEthTxIndexCmd creates a new Cobra command to check the indexer against the block results.
+func newVerifyEthTxIndexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify [from] [to]",
+		Short: "Verify the indexed eth txs against the block results",
+		Long: `Verify that the indexed eth txs of the blocks within the [from, to] range agree with the block
+		results stored by CometBFT: every eth tx must be indexed by hash and by position with the same height,
+		indexes and gas used. Defaults to the whole indexed range, the mismatches found are printed and the
+		blocks can be fixed with the reindex subcommand.
+		`,
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			defer stores.Close()
+
+			var from, to int64
+			switch len(args) {
+			case 2:
+				from, to, err = stores.parseHeightRange(args[0], args[1])
+				if err != nil {
+					return err
+				}
+			case 1:
+				return errors.New("both from and to heights are required")
+			default:
+				if from, err = stores.idxer.FirstIndexedBlock(); err != nil {
+					return err
+				}
+				if to, err = stores.idxer.LastIndexedBlock(); err != nil {
+					return err
+				}
+				if from == -1 {
+					fmt.Println("indexer db is empty")
+					return nil
+				}
+				from = max(from, stores.blockStore.Base())
+			}
+
+			var mismatches int
+			for height := from; height <= to; height++ {
+				blk, txResults, err := stores.loadBlock(height)
+				if err != nil {
+					return err
+				}
+				blockMismatches, err := stores.idxer.VerifyBlock(blk, txResults)
+				if err != nil {
+					return err
+				}
+				for _, mismatch := range blockMismatches {
+					fmt.Println(mismatch)
+				}
+				mismatches += len(blockMismatches)
+			}
+			if mismatches > 0 {
+				return fmt.Errorf("found %d mismatches in blocks [%d, %d]", mismatches, from, to)
+			}
+			fmt.Printf("verified blocks [%d, %d]\n", from, to)
+			return nil
+		},
+	}
+}
+
+// newPruneEthTxIndexCmd creates a new Cobra command to prune the indexer.
+func newPruneEthTxIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the indexed eth txs below the retained height",
+		Long: `Delete the indexed entries of the blocks below the retained height. It defaults to the earliest
+		block kept by the CometBFT block store, so that the indexer is pruned in step with the retain_height
+		of CometBFT, and can't be higher than the latest block. A running node already prunes the KV indexer
+		as the block store base moves forward, this is meant for a node that is stopped.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			defer stores.Close()
+
+			retainHeight, err := cmd.Flags().GetInt64(flagRetainHeight)
+			if err != nil {
+				return err
+			}
+			if retainHeight == 0 {
+				retainHeight = stores.blockStore.Base()
+			}
+			if retainHeight < 0 || retainHeight > stores.blockStore.Height() {
+				return fmt.Errorf("retain height %d out of range [0, %d]", retainHeight, stores.blockStore.Height())
+			}
+
+			pruned, err := stores.idxer.PruneBlocks(retainHeight)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("pruned %d entries below height %d\n", pruned, retainHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagRetainHeight, 0, "Height of the earliest block to retain, defaults to the earliest block of the block store")
 	return cmd
 }
+
+// newSQLMigrateCmd creates a new Cobra command to manage the schema of the SQL indexer.
+func newSQLMigrateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sql-migrate [up|down|status]",
+		Short: "Migrate the schema of the SQL indexer database",
+		Long: `Manage the schema of the SQL database configured with json-rpc.indexer-sql-driver and json-rpc.indexer-sql-dsn:
+		- up: apply the pending migrations, which is also done when starting the node.
+		- down: revert the last applied migration, dropping the data of its tables.
+		- status: print the schema version of the database.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sqlIdxer, err := openConfiguredSQLIndexer(cmd)
+			if err != nil {
+				return err
+			}
+			defer sqlIdxer.Close()
+
+			var version int
+			switch args[0] {
+			case "up":
+				version, err = sqlIdxer.MigrateUp()
+			case "down":
+				version, err = sqlIdxer.MigrateDown()
+			case "status":
+				version, err = sqlIdxer.SchemaVersion()
+			default:
+				return fmt.Errorf("unknown migration, expect: up|down|status, got: %s", args[0])
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("schema version %d, latest %d\n", version, indexer.SQLSchemaVersion)
+			return nil
+		},
+	}
+}
+
+const (
+	flagRetainHeight = "retain-height"
+	flagSQL          = "sql"
+)
+
+// historicalIndexer is an indexer that can be filled backward and forward.
+type historicalIndexer interface {
+	cosmosevmtypes.EVMTxIndexer
+	FirstIndexedBlock() (int64, error)
+}
+
+// openConfiguredSQLIndexer opens the SQL indexer configured in app.toml.
+func openConfiguredSQLIndexer(cmd *cobra.Command) (*indexer.SQLIndexer, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.JSONRPC.IndexerSQLDriver == "" {
+		return nil, errors.New("the SQL indexer is not configured, set json-rpc.indexer-sql-driver")
+	}
+	return OpenSQLIndexer(serverCtx.Config.RootDir, cfg.JSONRPC, serverCtx.Logger.With("module", "evmindex"), clientCtx)
+}
+
+// indexerStores holds the indexer and the local CometBFT stores used by the
+// indexer commands, because the local rpc won't be available.
+type indexerStores struct {
+	idxer      *indexer.KVIndexer
+	blockStore *cmtstore.BlockStore
+	stateStore sm.Store
+
+	idxDB dbm.DB
+}
+
+// openIndexerStores opens the indexer db and the local CometBFT stores.
+func openIndexerStores(cmd *cobra.Command) (*indexerStores, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	appCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := serverCtx.Config
+	home := cfg.RootDir
+	logger := serverCtx.Logger
+	idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+	idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx).WithLogIndex(appCfg.JSONRPC.EnableLogIndexer)
+
+	// open local tendermint db, because the local rpc won't be available.
+	tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(tmdb)
+
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+
+	return &indexerStores{
+		idxer:      idxer,
+		blockStore: blockStore,
+		stateStore: stateStore,
+		idxDB:      idxDB,
+	}, nil
+}
+
+// Close closes the indexer db and the CometBFT stores.
+func (s *indexerStores) Close() {
+	_ = s.stateStore.Close()
+	_ = s.blockStore.Close()
+	_ = s.idxDB.Close()
+}
+
+// loadBlock loads a block and its txs results from the CometBFT stores.
+func (s *indexerStores) loadBlock(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
+	blk := s.blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	resBlk, err := s.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, resBlk.TxResults, nil
+}
+
+// parseHeightRange parses a [from, to] block range, which must be kept by the block store.
+func (s *indexerStores) parseHeightRange(fromArg, toArg string) (int64, int64, error) {
+	from, err := strconv.ParseInt(fromArg, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid from height %s: %w", fromArg, err)
+	}
+	to, err := strconv.ParseInt(toArg, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid to height %s: %w", toArg, err)
+	}
+	if from > to {
+		return 0, 0, fmt.Errorf("from height %d is greater than to height %d", from, to)
+	}
+	if base, height := s.blockStore.Base(), s.blockStore.Height(); from < base || to > height {
+		return 0, 0, fmt.Errorf("block range [%d, %d] not kept by the block store [%d, %d]", from, to, base, height)
+	}
+	return from, to, nil
+}
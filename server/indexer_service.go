@@ -16,26 +16,59 @@ const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// MaxSinkCatchUpBlocks is the maximum number of blocks a sink lagging
+	// behind the primary indexer indexes per pass.
+	MaxSinkCatchUpBlocks = 100
 )
 
+// BlockStore is the part of the CometBFT block store the indexer service
+// follows to prune the indexer.
+type BlockStore interface {
+	// Base returns the earliest block kept by the block store.
+	Base() int64
+}
+
+// blockPruner is implemented by the indexers that can be pruned, e.g. the KV
+// indexer.
+type blockPruner interface {
+	PruneBlocks(retainHeight int64) (int, error)
+}
+
 // EVMIndexerService indexes transactions for json-rpc service.
 type EVMIndexerService struct {
 	service.BaseService
 
-	txIdxr cosmosevmtypes.EVMTxIndexer
-	client rpcclient.Client
+	txIdxr     cosmosevmtypes.EVMTxIndexer
+	sinks      []cosmosevmtypes.EVMTxIndexer
+	client     rpcclient.Client
+	blockStore BlockStore
+	// prunedBase is the block store base the indexer was last pruned at
+	prunedBase int64
 }
 
-// NewEVMIndexerService returns a new service instance.
+// NewEVMIndexerService returns a new service instance. The blocks are also
+// indexed by the sinks, e.g. a SQL indexer, each one resuming from its own last
+// indexed block and catching up on the blocks it failed to index without
+// holding back the primary indexer.
 func NewEVMIndexerService(
 	txIdxr cosmosevmtypes.EVMTxIndexer,
 	client rpcclient.Client,
+	sinks ...cosmosevmtypes.EVMTxIndexer,
 ) *EVMIndexerService {
-	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is := &EVMIndexerService{txIdxr: txIdxr, sinks: sinks, client: client}
 	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
 	return is
 }
 
+// WithBlockStore sets the CometBFT block store, the indexer is then pruned
+// below its base as it moves forward, so that it follows the CometBFT
+// retain_height.
+func (eis *EVMIndexerService) WithBlockStore(blockStore BlockStore) *EVMIndexerService {
+	eis.blockStore = blockStore
+	return eis
+}
+
 // OnStart implements service.Service by subscribing for new blocks
 // and indexing them by events.
 func (eis *EVMIndexerService) OnStart() error {
@@ -81,6 +114,19 @@ func (eis *EVMIndexerService) OnStart() error {
 	if lastBlock == -1 {
 		lastBlock = latestBlock
 	}
+	// each sink resumes from its own last indexed block, so that a sink
+	// lagging behind doesn't hold back the primary indexer
+	sinkBlocks := make([]int64, len(eis.sinks))
+	for j, sink := range eis.sinks {
+		last, err := sink.LastIndexedBlock()
+		if err != nil {
+			return err
+		}
+		if last == -1 {
+			last = latestBlock
+		}
+		sinkBlocks[j] = last
+	}
 
 	// blockErr indicates an error fetching an expected block or its results
 	var blockErr error
@@ -97,6 +143,7 @@ func (eis *EVMIndexerService) OnStart() error {
 			case <-newBlockSignal:
 			case <-time.After(NewBlockWaitTimeout):
 			}
+			blockErr = nil
 			continue
 		}
 		for i := lastBlock + 1; i <= latestBlock; i++ {
@@ -119,6 +166,72 @@ func (eis *EVMIndexerService) OnStart() error {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
 			lastBlock = blockResult.Height
+			for j, sink := range eis.sinks {
+				// the blocks are indexed in order, so a sink that failed to index
+				// a previous block skips the next ones until it catches up
+				if sinkBlocks[j] != i-1 {
+					continue
+				}
+				if err := sink.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+					eis.Logger.Error("failed to index block in sink", "height", i, "err", err)
+					continue
+				}
+				sinkBlocks[j] = blockResult.Height
+			}
+		}
+		eis.catchUpSinks(ctx, lastBlock, sinkBlocks)
+		eis.prune()
+	}
+}
+
+// prune prunes the indexer below the block store base once it moved forward,
+// the blocks below it can't be fetched anymore.
+func (eis *EVMIndexerService) prune() {
+	if eis.blockStore == nil {
+		return
+	}
+	pruner, ok := eis.txIdxr.(blockPruner)
+	if !ok {
+		return
+	}
+	base := eis.blockStore.Base()
+	if base <= eis.prunedBase {
+		return
+	}
+	pruned, err := pruner.PruneBlocks(base)
+	if err != nil {
+		eis.Logger.Error("failed to prune indexer", "retain_height", base, "err", err)
+		return
+	}
+	eis.prunedBase = base
+	if pruned > 0 {
+		eis.Logger.Info("pruned indexer", "retain_height", base, "entries", pruned)
+	}
+}
+
+// catchUpSinks retries the blocks up to lastBlock that the sinks failed to
+// index. Each sink indexes at most MaxSinkCatchUpBlocks blocks per call and
+// stops at its first failure, so a failing sink only delays the next pass by a
+// bounded number of block fetches.
+func (eis *EVMIndexerService) catchUpSinks(ctx context.Context, lastBlock int64, sinkBlocks []int64) {
+	for j, sink := range eis.sinks {
+		toBlock := min(lastBlock, sinkBlocks[j]+MaxSinkCatchUpBlocks)
+		for i := sinkBlocks[j] + 1; i <= toBlock; i++ {
+			block, err := eis.client.Block(ctx, &i)
+			if err != nil {
+				eis.Logger.Error("failed to fetch block", "height", i, "err", err)
+				break
+			}
+			blockResult, err := eis.client.BlockResults(ctx, &i)
+			if err != nil {
+				eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
+				break
+			}
+			if err := sink.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+				eis.Logger.Error("failed to index block in sink", "height", i, "err", err)
+				break
+			}
+			sinkBlocks[j] = blockResult.Height
 		}
 	}
 }
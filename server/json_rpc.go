@@ -5,12 +5,16 @@ import (
 	"log/slog"
 	"net/http"
 
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/limiter"
+	rpcmetrics "github.com/cosmos/evm/rpc/metrics"
 	serverconfig "github.com/cosmos/evm/server/config"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
@@ -27,6 +31,7 @@ func StartJSONRPC(
 	tmRPCAddr, tmEndpoint string,
 	config *serverconfig.Config,
 	indexer cosmosevmtypes.EVMTxIndexer,
+	txPool backend.TxPool,
 ) (*http.Server, error) {
 	logger := srvCtx.Logger.With("module", "geth")
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, logger)
@@ -41,7 +46,7 @@ func StartJSONRPC(
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, txPool, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -54,8 +59,23 @@ func StartJSONRPC(
 		}
 	}
 
+	// Restrict the methods and rate limit the clients if configured
+	var rpcHandler http.Handler = rpcServer
+	rpcLimiter, err := limiter.New(config.JSONRPC)
+	if err != nil {
+		return nil, err
+	}
+	if rpcLimiter.Enabled() {
+		rpcHandler = rpcLimiter.Handler(rpcHandler)
+	}
+
+	// Record the per-method metrics when the metrics server is enabled
+	if ethmetrics.Enabled() {
+		rpcHandler = rpcmetrics.NewHandler(rpcHandler, apis)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -111,7 +131,7 @@ func StartJSONRPC(
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, tmWsClient, config, rpcLimiter)
 	wsSrv.Start()
 	return httpSrv, nil
 }
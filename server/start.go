@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime/pprof"
 
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -28,12 +29,14 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/rpc/backend"
 	ethdebug "github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -195,10 +198,24 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCLogIndexScanCap, cosmosevmserverconfig.DefaultLogIndexScanCap, "Sets the max number of indexed logs read from the log index by a single `eth_getLogs` query (0=unlimited)")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceFilterBlockRangeCap, cosmosevmserverconfig.DefaultTraceFilterBlockRangeCap, "Sets the max block range allowed for `trace_filter` query, disabled if 0")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, true, "Enable the indexing of the logs by address and topic in the custom tx indexer")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDriver, "", "Enable writing the indexed blocks, txs, receipts, logs and ERC20 transfers to a SQL database (sqlite|postgres)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDSN, "", "Sets the data source name of the indexer SQL database (default data/evmindexer.sqlite for sqlite)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableStorageProofs, cosmosevmserverconfig.DefaultEnableStorageProofs, "Enables the Merkle-Patricia storage root and storage proofs in eth_getProof, which are not verifiable against the block headers")
+	cmd.Flags().Int(srvflags.JSONRPCStorageProofMaxSlots, cosmosevmserverconfig.DefaultStorageProofMaxSlots, "Sets the max number of storage slots of an account, and of storage keys of a query, for its storage proofs to be built")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, []string{}, "Defines a list of JSON-RPC methods, or namespace_* patterns, that can be called (empty=all)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, []string{}, "Defines a list of JSON-RPC methods, or namespace_* patterns, that can't be called")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, cosmosevmserverconfig.DefaultRateLimit, "Sets the number of JSON-RPC request cost units per second allowed per client (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, cosmosevmserverconfig.DefaultRateLimitBurst, "Sets the maximum number of JSON-RPC request cost units a client can use at once")
+	cmd.Flags().String(srvflags.JSONRPCAPIKeyHeader, "", "Defines the HTTP header identifying the JSON-RPC clients by API key instead of by IP for the rate limiting")                                       //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPIKeys, []string{}, "Defines the API keys of the JSON-RPC clients identified by the API key header for the rate limiting")                                      //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodCosts, cosmosevmserverconfig.DefaultMethodCosts, "Defines the rate limiting cost weights of the JSON-RPC methods as method:cost entries (default cost=1)") //nolint:lll
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -382,6 +399,7 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		logger.Info("starting node in query only mode; CometBFT is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
+		config.JSONRPC.IndexerSQLDriver = ""
 	} else {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
@@ -416,7 +434,8 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	// Add the tx service to the gRPC router. We only need to register this
 	// service if API or gRPC or JSONRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local CometBFT RPC client.
-	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && tmNode != nil {
+	enableIndexerService := config.JSONRPC.EnableIndexer || config.JSONRPC.IndexerSQLDriver != ""
+	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || enableIndexerService) && tmNode != nil {
 		clientCtx = clientCtx.WithClient(local.New(tmNode))
 
 		app.RegisterTxService(clientCtx)
@@ -432,20 +451,46 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	// Enable metrics if JSONRPC is enabled and --metrics is passed
 	// Flag not added in config to avoid user enabling in config without passing in CLI
 	if config.JSONRPC.Enable && svrCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		// The histograms and meters are only updated once the metrics are enabled
+		ethmetrics.Enable()
 		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
 	}
 
 	var idxer cosmosevmtypes.EVMTxIndexer
-	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
-		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
-			return err
+	if enableIndexerService {
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+
+		var sinks []cosmosevmtypes.EVMTxIndexer
+		if config.JSONRPC.EnableIndexer {
+			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx).WithLogIndex(config.JSONRPC.EnableLogIndexer)
+		}
+		if config.JSONRPC.IndexerSQLDriver != "" {
+			sqlIdxer, err := OpenSQLIndexer(home, config.JSONRPC, idxLogger, clientCtx)
+			if err != nil {
+				logger.Error("failed to open evm SQL indexer", "error", err.Error())
+				return err
+			}
+			defer sqlIdxer.Close()
+			if _, err := sqlIdxer.MigrateUp(); err != nil {
+				logger.Error("failed to migrate evm SQL indexer schema", "error", err.Error())
+				return err
+			}
+			sinks = append(sinks, sqlIdxer)
 		}
 
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+		// the SQL indexer is a sink of the KV indexer, or is fed alone if the KV
+		// indexer is disabled, it's never used by the json-rpc
+		serviceIdxer := idxer
+		if serviceIdxer == nil {
+			serviceIdxer, sinks = sinks[0], sinks[1:]
+		}
+		indexerService := NewEVMIndexerService(serviceIdxer, clientCtx.Client.(rpcclient.Client), sinks...).
+			WithBlockStore(tmNode.BlockStore())
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
 		g.Go(func() error {
@@ -476,7 +521,9 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	if config.JSONRPC.Enable {
 		cmtEndpoint := "/websocket"
-		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, cfg.RPC.ListenAddress, cmtEndpoint, &config, idxer)
+		// the txpool namespace reads the app mempool if the app exposes it
+		txPool, _ := app.(backend.TxPool)
+		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, cfg.RPC.ListenAddress, cmtEndpoint, &config, idxer, txPool)
 		if err != nil {
 			return err
 		}
@@ -501,6 +548,16 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenSQLIndexer opens the SQL indexer configured in the json-rpc config, the
+// SQLite database defaults to the data/evmindexer.sqlite file.
+func OpenSQLIndexer(rootDir string, cfg cosmosevmserverconfig.JSONRPCConfig, logger log.Logger, clientCtx client.Context) (*indexer.SQLIndexer, error) {
+	dsn := cfg.IndexerSQLDSN
+	if dsn == "" && cfg.IndexerSQLDriver == indexer.SQLDriverSQLite {
+		dsn = "file:" + filepath.Join(rootDir, "data", "evmindexer.sqlite") + "?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+	}
+	return indexer.NewSQLIndexer(cfg.IndexerSQLDriver, dsn, logger, clientCtx)
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
@@ -0,0 +1,128 @@
+package ante
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *EvmUnitAnteTestSuite) TestFeeGranter() {
+	// the fees are charged in the evm denom of the allowance whatever its
+	// decimals
+	for _, chainID := range []testconstants.ChainID{
+		{ChainID: s.ChainID, EVMChainID: s.EvmChainID},
+		testconstants.SixDecimalsChainID,
+	} {
+		s.Run(chainID.ChainID, func() {
+			s.testFeeGranter(chainID)
+		})
+	}
+}
+
+func (s *EvmUnitAnteTestSuite) testFeeGranter(chainID testconstants.ChainID) {
+	// Setup
+	keyring := testkeyring.New(3)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithChainID(chainID),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := unitNetwork.GetEncodingConfig().TxConfig
+	granterKey := keyring.GetKey(0)
+	senderKey := keyring.GetKey(1)
+	to := keyring.GetAddr(2)
+	value := big.NewInt(1000)
+	spendLimit := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewInt(1e18)))
+
+	testCases := []struct {
+		name          string
+		expectedError string
+		malleate      func()
+	}{
+		{
+			name:          "fail: granter didn't grant a fee allowance to the sender",
+			expectedError: "does not allow to pay fees",
+			malleate:      func() {},
+		},
+		{
+			name: "success: granter pays the fees of the sender",
+			malleate: func() {
+				msg, err := feegrant.NewMsgGrantAllowance(
+					&feegrant.BasicAllowance{SpendLimit: spendLimit},
+					granterKey.AccAddr,
+					senderKey.AccAddr,
+				)
+				s.Require().NoError(err)
+				res, err := txFactory.CommitCosmosTx(granterKey.Priv, commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msg}})
+				s.Require().NoError(err)
+				s.Require().True(res.IsOK(), res.Log)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.malleate()
+
+			ctx := unitNetwork.GetContext()
+			evmKeeper := unitNetwork.App.GetEVMKeeper()
+			prevGranterBalance := evmKeeper.GetBalance(ctx, granterKey.Addr)
+			prevSenderBalance := evmKeeper.GetBalance(ctx, senderKey.Addr)
+
+			// The fee granter is declared in the signed access list
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, ethtypes.DynamicFeeTxType)
+			s.Require().NoError(err)
+			txArgs.To = &to
+			txArgs.Amount = value
+			txArgs.Accesses = &ethtypes.AccessList{evmtypes.NewFeeGranterAccessTuple(granterKey.AccAddr)}
+			txArgs.GasLimit = 100_000
+			msg, err := txFactory.GenerateSignedMsgEthereumTx(senderKey.Priv, txArgs)
+			s.Require().NoError(err)
+
+			tx, err := msg.BuildTx(txConfig.NewTxBuilder(), unitNetwork.GetBaseDenom())
+			s.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := unitNetwork.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResults, 1)
+			txRes := res.TxResults[0]
+
+			if tc.expectedError != "" {
+				s.Require().False(txRes.IsOK())
+				s.Require().Contains(txRes.Log, tc.expectedError)
+				return
+			}
+			s.Require().True(txRes.IsOK(), txRes.Log)
+
+			ctx = unitNetwork.GetContext()
+			// The sender only pays the value of the transaction
+			expSenderBalance := new(uint256.Int).Sub(prevSenderBalance, uint256.MustFromBig(value))
+			s.Require().Equal(expSenderBalance, evmKeeper.GetBalance(ctx, senderKey.Addr))
+			s.Require().True(evmKeeper.GetBalance(ctx, granterKey.Addr).Lt(prevGranterBalance))
+
+			allowance, err := unitNetwork.App.GetFeeGrantKeeper().GetAllowance(ctx, granterKey.AccAddr, senderKey.AccAddr)
+			s.Require().NoError(err)
+			basic, ok := allowance.(*feegrant.BasicAllowance)
+			s.Require().True(ok)
+			s.Require().True(basic.SpendLimit.IsAllLT(spendLimit))
+		})
+	}
+}
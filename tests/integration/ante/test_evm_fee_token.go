@@ -0,0 +1,108 @@
+package ante
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+)
+
+func (s *EvmUnitAnteTestSuite) TestFeeToken() {
+	// Setup
+	feeTokenDenom := "uusdc"
+	keyring := testkeyring.New(2)
+
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.FeeTokens = []feemarkettypes.FeeToken{
+		feemarkettypes.NewFeeToken(feeTokenDenom, math.LegacyNewDecWithPrec(5, 1), ""),
+	}
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithOtherDenoms([]string{feeTokenDenom}),
+		network.WithCustomGenesis(network.CustomGenesisState{
+			feemarkettypes.ModuleName: feemarketGenesis,
+		}),
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := unitNetwork.GetEncodingConfig().TxConfig
+	senderKey := keyring.GetKey(0)
+	to := keyring.GetAddr(1)
+	value := big.NewInt(1000)
+
+	testCases := []struct {
+		name          string
+		denom         string
+		expectedError string
+	}{
+		{
+			name:          "fail: denom is not registered as fee token",
+			denom:         "uatom",
+			expectedError: "is not accepted to pay fees",
+		},
+		{
+			name:  "success: sender pays the fees in the fee token",
+			denom: feeTokenDenom,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := unitNetwork.GetContext()
+			evmKeeper := unitNetwork.App.GetEVMKeeper()
+			bankKeeper := unitNetwork.App.GetBankKeeper()
+			prevSenderBalance := evmKeeper.GetBalance(ctx, senderKey.Addr)
+			prevFeeTokenBalance := bankKeeper.GetBalance(ctx, senderKey.AccAddr, feeTokenDenom)
+
+			// The fee token is declared in the signed access list
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, ethtypes.DynamicFeeTxType)
+			s.Require().NoError(err)
+			txArgs.To = &to
+			txArgs.Amount = value
+			txArgs.Accesses = &ethtypes.AccessList{evmtypes.NewFeeTokenAccessTuple(tc.denom)}
+			txArgs.GasLimit = 100_000
+			msg, err := txFactory.GenerateSignedMsgEthereumTx(senderKey.Priv, txArgs)
+			s.Require().NoError(err)
+
+			tx, err := msg.BuildTx(txConfig.NewTxBuilder(), unitNetwork.GetBaseDenom())
+			s.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := unitNetwork.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResults, 1)
+			txRes := res.TxResults[0]
+
+			if tc.expectedError != "" {
+				s.Require().False(txRes.IsOK())
+				s.Require().Contains(txRes.Log, tc.expectedError)
+				return
+			}
+			s.Require().True(txRes.IsOK(), txRes.Log)
+
+			ctx = unitNetwork.GetContext()
+			// The sender only pays the value of the transaction in the EVM coin
+			expSenderBalance := new(uint256.Int).Sub(prevSenderBalance, uint256.MustFromBig(value))
+			s.Require().Equal(expSenderBalance, evmKeeper.GetBalance(ctx, senderKey.Addr))
+
+			feeTokenBalance := bankKeeper.GetBalance(ctx, senderKey.AccAddr, feeTokenDenom)
+			s.Require().True(feeTokenBalance.IsLT(prevFeeTokenBalance))
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/evm/ante/evm"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -42,6 +44,7 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 	testCases := []struct {
 		name                   string
 		expectedError          error
+		feeGranter             sdk.AccAddress
 		generateAccountAndArgs func() (*statedb.Account, evmtypes.EvmTxArgs)
 	}{
 		{
@@ -57,6 +60,21 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 				return statedbAccount, txArgs
 			},
 		},
+		{
+			name:          "success: sender is an EOA with a code delegation",
+			expectedError: nil,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				code := ethtypes.AddressToDelegation(keyring.GetAddr(0))
+				codeHash := crypto.Keccak256Hash(code)
+				unitNetwork.App.GetEVMKeeper().SetCode(unitNetwork.GetContext(), codeHash.Bytes(), code)
+				statedbAccount.CodeHash = codeHash.Bytes()
+				return statedbAccount, txArgs
+			},
+		},
 		{
 			name:          "fail: sender balance is lower than the transaction cost",
 			expectedError: errortypes.ErrInsufficientFunds,
@@ -76,6 +94,32 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 				return statedbAccount, txArgs
 			},
 		},
+		{
+			name:          "success: fee granter pays the fees, sender balance equals the transaction value",
+			expectedError: nil,
+			feeGranter:    keyring.GetAccAddr(0),
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				txArgs.Amount = statedbAccount.Balance.ToBig()
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "fail: fee granter pays the fees, sender balance is lower than the transaction value",
+			expectedError: errortypes.ErrInsufficientFunds,
+			feeGranter:    keyring.GetAccAddr(0),
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+				s.Require().NoError(err)
+
+				txArgs.Amount = new(big.Int).Add(statedbAccount.Balance.ToBig(), big.NewInt(1))
+				return statedbAccount, txArgs
+			},
+		},
 		{
 			name:          "fail: tx cost is negative",
 			expectedError: errortypes.ErrInvalidCoins,
@@ -221,9 +265,11 @@ func (s *EvmUnitAnteTestSuite) TestVerifyAccountBalance() {
 			err = evm.VerifyAccountBalance(
 				unitNetwork.GetContext(),
 				unitNetwork.App.GetAccountKeeper(),
+				unitNetwork.App.GetEVMKeeper(),
 				statedbAccount,
 				senderKey.Addr,
 				txData,
+				tc.feeGranter.Empty(),
 			)
 
 			if tc.expectedError != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/evm/testutil"
 	"github.com/cosmos/evm/testutil/constants"
 	testutiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/math"
 
@@ -124,6 +125,38 @@ func (s *AnteTestSuite) TestMinGasPriceDecorator() {
 			"provided fee < minimum global fee",
 			true,
 		},
+		{
+			"valid cosmos tx with MinGasPrices = 10, fee token gasPrice = 20, conversion rate = 2",
+			func() sdk.Tx {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+				s.Require().NoError(err)
+				nw.App.GetFeeMarketKeeper().SetFeeToken(ctx, feemarkettypes.NewFeeToken("uusdc", math.LegacyNewDec(2), ""))
+
+				txBuilder := s.CreateTestCosmosTxBuilder(math.NewInt(20), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"invalid cosmos tx with MinGasPrices = 10, fee token gasPrice = 10, conversion rate = 2",
+			func() sdk.Tx {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+				s.Require().NoError(err)
+				nw.App.GetFeeMarketKeeper().SetFeeToken(ctx, feemarkettypes.NewFeeToken("uusdc", math.LegacyNewDec(2), ""))
+
+				txBuilder := s.CreateTestCosmosTxBuilder(math.NewInt(10), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"provided fee < minimum global fee",
+			true,
+		},
 		{
 			"valid cosmos tx with MinGasPrices = 0, gasPrice = 0, valid fee",
 			func() sdk.Tx {
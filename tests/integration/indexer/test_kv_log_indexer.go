@@ -0,0 +1,135 @@
+package indexer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestKVIndexerLogs(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addr1, addr2 := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	topic1, topic2, topic3 := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+
+	logA := &ethtypes.Log{Address: addr1, Topics: []common.Hash{topic1, topic2}, BlockNumber: 1, Index: 0}
+	logB := &ethtypes.Log{Address: addr2, Topics: []common.Hash{topic1}, BlockNumber: 2, Index: 0}
+	logC := &ethtypes.Log{Address: addr1, Topics: []common.Hash{topic3}, BlockNumber: 2, Index: 1, Data: []byte{1}}
+
+	txLogEvent := func(logs ...*ethtypes.Log) abci.Event {
+		event := abci.Event{Type: types.EventTypeTxLog}
+		for _, ethLog := range logs {
+			bz, err := json.Marshal(types.NewLogFromEth(ethLog))
+			require.NoError(t, err)
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)})
+		}
+		return event
+	}
+	indexBlock := func(idxer *indexer.KVIndexer, height int64, txResults ...*abci.ExecTxResult) {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, txResults))
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	indexBlock(idxer, 1, &abci.ExecTxResult{Events: []abci.Event{txLogEvent(logA)}})
+	indexBlock(idxer, 2,
+		&abci.ExecTxResult{Events: []abci.Event{txLogEvent(logB)}},
+		&abci.ExecTxResult{Events: []abci.Event{txLogEvent(logC)}},
+	)
+	indexBlock(idxer, 3)
+
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []*ethtypes.Log
+		expErr    string
+	}{
+		{"all logs", 1, 3, nil, nil, 10, []*ethtypes.Log{logA, logB, logC}, ""},
+		{"sub range", 2, 3, nil, nil, 10, []*ethtypes.Log{logB, logC}, ""},
+		{"empty block", 3, 3, nil, nil, 10, []*ethtypes.Log{}, ""},
+		{"by address", 1, 3, []common.Address{addr1}, nil, 10, []*ethtypes.Log{logA, logC}, ""},
+		{"by addresses", 1, 3, []common.Address{addr1, addr2}, nil, 10, []*ethtypes.Log{logA, logB, logC}, ""},
+		{"by topic", 1, 3, nil, [][]common.Hash{{topic1}}, 10, []*ethtypes.Log{logA, logB}, ""},
+		{"by topic position", 1, 3, nil, [][]common.Hash{nil, {topic2}}, 10, []*ethtypes.Log{logA}, ""},
+		{"by topics", 1, 3, nil, [][]common.Hash{{topic1, topic3}}, 10, []*ethtypes.Log{logA, logB, logC}, ""},
+		{"by address and topic", 1, 3, []common.Address{addr1}, [][]common.Hash{{topic1}}, 10, []*ethtypes.Log{logA}, ""},
+		{"no match", 1, 3, []common.Address{addr2}, [][]common.Hash{{topic3}}, 10, []*ethtypes.Log{}, ""},
+		{"limit exceeded", 1, 3, nil, nil, 2, nil, "query returned more than 2 results"},
+		{"limit exceeded with criteria", 1, 3, []common.Address{addr1}, nil, 1, nil, "query returned more than 1 results"},
+		{"range not covered", 1, 4, nil, nil, 10, nil, "not covered by the log index"},
+		{"too many topics", 1, 3, nil, [][]common.Hash{nil, nil, nil, nil, {topic1}}, 10, nil, "exceed max topics"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit, 0)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, logs, len(tc.expLogs))
+			for i, expLog := range tc.expLogs {
+				require.Equal(t, expLog.Address, logs[i].Address)
+				require.Equal(t, expLog.Topics, logs[i].Topics)
+				require.Equal(t, expLog.BlockNumber, logs[i].BlockNumber)
+				require.Equal(t, expLog.Index, logs[i].Index)
+			}
+		})
+	}
+
+	// the indexed logs read to match the criteria are capped, matching or not
+	_, err = idxer.GetLogs(1, 3, []common.Address{addr1}, [][]common.Hash{{topic3}}, 10, 1)
+	require.ErrorContains(t, err, "query scanned more than 1 indexed logs")
+	logs, err := idxer.GetLogs(1, 3, []common.Address{addr1}, [][]common.Hash{{topic3}}, 10, 2)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, logC.Index, logs[0].Index)
+
+	// a gap restarts the covered range
+	indexBlock(idxer, 5)
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	require.Equal(t, int64(5), last)
+
+	// no block is covered once the log index is disabled
+	idxer.WithLogIndex(false)
+	indexBlock(idxer, 6, &abci.ExecTxResult{Events: []abci.Event{txLogEvent(logA)}})
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+	_, err = idxer.GetLogs(6, 6, nil, nil, 10, 0)
+	require.ErrorContains(t, err, "not covered by the log index")
+}
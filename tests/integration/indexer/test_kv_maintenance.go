@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestKVIndexerMaintenance(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 50000,
+	})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	ethLog := &ethtypes.Log{Address: utiltx.GenerateAddress(), Topics: []common.Hash{common.HexToHash("0x01")}}
+	logBz, err := json.Marshal(types.NewLogFromEth(ethLog))
+	require.NoError(t, err)
+
+	txResults := func(gasUsed int64) []*abci.ExecTxResult {
+		return []*abci.ExecTxResult{
+			{
+				Code:    0,
+				GasUsed: gasUsed,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: strconv.FormatInt(gasUsed, 10)},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: to.Hex()},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+						{Key: types.AttributeKeyTxLog, Value: string(logBz)},
+					}},
+				},
+			},
+		}
+	}
+	txBlock := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(txBlock, txResults(21000)))
+	for height := int64(2); height <= 3; height++ {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, nil))
+	}
+
+	// verify
+	mismatches, err := idxer.VerifyBlock(txBlock, txResults(21000))
+	require.NoError(t, err)
+	require.Empty(t, mismatches)
+
+	mismatches, err = idxer.VerifyBlock(txBlock, txResults(30000))
+	require.NoError(t, err)
+	require.Len(t, mismatches, 1)
+	require.Equal(t, txHash, mismatches[0].TxHash)
+	require.Contains(t, mismatches[0].Reason, "indexed gas used 21000")
+
+	mismatches, err = idxer.VerifyBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, nil)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 1)
+	require.Contains(t, mismatches[0].Reason, "unexpected tx indexed at eth tx index 0")
+
+	// reindex
+	require.NoError(t, idxer.ReindexBlock(txBlock, txResults(30000)))
+	mismatches, err = idxer.VerifyBlock(txBlock, txResults(30000))
+	require.NoError(t, err)
+	require.Empty(t, mismatches)
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000), res.GasUsed)
+	logs, err := idxer.GetLogs(1, 3, nil, nil, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	// prune
+	pruned, err := idxer.PruneBlocks(2)
+	require.NoError(t, err)
+	// tx hash, tx index, log, log address and log topic entries
+	require.Equal(t, 5, pruned)
+	_, err = idxer.GetByTxHash(txHash)
+	require.ErrorContains(t, err, "tx not found")
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	firstLog, lastLog, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), firstLog)
+	require.Equal(t, int64(3), lastLog)
+	logs, err = idxer.GetLogs(2, 3, []common.Address{ethLog.Address}, nil, 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	_, err = idxer.PruneBlocks(4)
+	require.NoError(t, err)
+	firstLog, lastLog, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), firstLog)
+	require.Equal(t, int64(-1), lastLog)
+}
@@ -0,0 +1,170 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 50000,
+	})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	token := utiltx.GenerateAddress()
+	recipient := utiltx.GenerateAddress()
+	transferLog := &ethtypes.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(recipient.Bytes()),
+		},
+		Data:   common.BigToHash(big.NewInt(500)).Bytes(),
+		TxHash: txHash,
+	}
+	otherLog := &ethtypes.Log{Address: token, Topics: []common.Hash{common.HexToHash("0x01")}, TxHash: txHash}
+
+	txEvents := []abci.Event{
+		{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: "ethereumTxHash", Value: txHash.Hex()},
+			{Key: "txIndex", Value: "0"},
+			{Key: "amount", Value: "1000"},
+			{Key: "txGasUsed", Value: "21000"},
+			{Key: "txHash", Value: ""},
+			{Key: "recipient", Value: to.Hex()},
+		}},
+	}
+	for _, ethLog := range []*ethtypes.Log{transferLog, otherLog} {
+		logBz, err := json.Marshal(types.NewLogFromEth(ethLog))
+		require.NoError(t, err)
+		txEvents = append(txEvents, abci.Event{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyTxLog, Value: string(logBz)},
+		}})
+	}
+	txResults := []*abci.ExecTxResult{{Code: 0, GasUsed: 21000, Events: txEvents}}
+	txBlock := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "evmindexer.sqlite")
+	idxer, err := indexer.NewSQLIndexer(indexer.SQLDriverSQLite, dsn, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+	defer idxer.Close()
+
+	// migrations
+	version, err := idxer.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, 0, version)
+	version, err = idxer.MigrateUp()
+	require.NoError(t, err)
+	require.Equal(t, indexer.SQLSchemaVersion, version)
+	version, err = idxer.MigrateDown()
+	require.NoError(t, err)
+	require.Equal(t, indexer.SQLSchemaVersion-1, version)
+	version, err = idxer.MigrateUp()
+	require.NoError(t, err)
+	require.Equal(t, indexer.SQLSchemaVersion, version)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	// index the block twice, the second time replaces the rows of the first
+	for i := 0; i < 2; i++ {
+		require.NoError(t, idxer.IndexBlock(txBlock, txResults))
+	}
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil))
+
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, int32(0), res.EthTxIndex)
+	require.Equal(t, uint64(21000), res.GasUsed)
+	require.False(t, res.Failed)
+
+	res, err = idxer.GetByBlockAndIndex(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), res.CumulativeGasUsed)
+
+	_, err = idxer.GetByBlockAndIndex(1, 1)
+	require.ErrorContains(t, err, "tx not found")
+	_, err = idxer.GetByTxHash(common.HexToHash("0x01"))
+	require.ErrorContains(t, err, "tx not found")
+
+	// check the rows with a separate connection
+	db, err := sql.Open(indexer.SQLDriverSQLite, dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	count := func(table string) int {
+		var n int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM `+table).Scan(&n))
+		return n
+	}
+	require.Equal(t, 2, count("blocks"))
+	require.Equal(t, 1, count("transactions"))
+	require.Equal(t, 1, count("receipts"))
+	require.Equal(t, 2, count("logs"))
+	require.Equal(t, 1, count("erc20_transfers"))
+
+	var logCount int
+	require.NoError(t, db.QueryRow(`SELECT log_count FROM receipts WHERE tx_hash = ?`, txHash.Hex()).Scan(&logCount))
+	require.Equal(t, 2, logCount)
+
+	var tokenAddress, fromAddress, toAddress, value string
+	require.NoError(t, db.QueryRow(`SELECT token_address, from_address, to_address, value FROM erc20_transfers`).
+		Scan(&tokenAddress, &fromAddress, &toAddress, &value))
+	require.Equal(t, token, common.HexToAddress(tokenAddress))
+	require.Equal(t, from, common.HexToAddress(fromAddress))
+	require.Equal(t, recipient, common.HexToAddress(toAddress))
+	require.Equal(t, "500", value)
+}
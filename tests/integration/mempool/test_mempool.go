@@ -0,0 +1,97 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEVMMempool(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	keyring := testkeyring.New(1)
+	options = append(options, network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...))
+	nw := network.NewUnitTestNetwork(create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+	privKey := keyring.GetPrivKey(0)
+	to := common.BigToAddress(big.NewInt(1))
+
+	baseFeeResp, err := grpcHandler.GetEvmBaseFee()
+	require.NoError(t, err)
+	gasPrice := new(big.Int).Mul(baseFeeResp.BaseFee.BigInt(), big.NewInt(2))
+
+	buildTxWithAmount := func(nonce uint64, gasPrice, amount *big.Int) (sdk.Tx, []byte) {
+		tx, err := txFactory.GenerateSignedEthTx(privKey, evmtypes.EvmTxArgs{
+			Nonce:    nonce,
+			To:       &to,
+			Amount:   amount,
+			GasLimit: 21000,
+			GasPrice: gasPrice,
+		})
+		require.NoError(t, err)
+		bz, err := txFactory.EncodeTx(tx)
+		require.NoError(t, err)
+		return tx, bz
+	}
+	buildTx := func(nonce uint64, gasPrice *big.Int) (sdk.Tx, []byte) {
+		return buildTxWithAmount(nonce, gasPrice, big.NewInt(1))
+	}
+	checkTx := func(bz []byte) *abci.ResponseCheckTx {
+		res, err := nw.CheckTx(bz)
+		require.NoError(t, err)
+		return res
+	}
+	mp := nw.App.GetBaseApp().Mempool()
+
+	// a tx with a nonce gap passes CheckTx and is queued
+	queued, queuedBz := buildTx(1, gasPrice)
+	res := checkTx(queuedBz)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(21000), res.GasWanted)
+	require.Equal(t, 1, mp.CountTx())
+
+	_, pendingBz := buildTx(0, gasPrice)
+	res = checkTx(pendingBz)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, mp.CountTx())
+
+	// a pending tx can only be replaced with a fee bump
+	_, underpricedBz := buildTx(0, new(big.Int).Add(gasPrice, big.NewInt(1)))
+	res = checkTx(underpricedBz)
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "replacement transaction underpriced")
+
+	replacement, replacementBz := buildTx(0, new(big.Int).Mul(gasPrice, big.NewInt(2)))
+	res = checkTx(replacementBz)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, mp.CountTx())
+
+	// the proposal includes the replacement followed by the no longer queued tx
+	var selected []sdk.Tx
+	for iter := mp.Select(nw.GetContext(), nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx())
+	}
+	require.Equal(t, []sdk.Tx{replacement, queued}, selected)
+
+	// a queued tx is rejected if the sender balance only covers it alone, and
+	// not along with the other pooled txs of the sender. The check state
+	// balance is already charged the fee of the first pending tx.
+	balance := nw.App.GetEVMKeeper().GetBalance(nw.GetContext(), keyring.GetAddr(0)).ToBig()
+	fee := new(big.Int).Mul(gasPrice, big.NewInt(21000))
+	_, tooCostlyBz := buildTxWithAmount(2, gasPrice, new(big.Int).Sub(balance, new(big.Int).Mul(fee, big.NewInt(2))))
+	res = checkTx(tooCostlyBz)
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "insufficient funds for the pooled transactions")
+	require.Equal(t, 2, mp.CountTx())
+}
@@ -0,0 +1,323 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupGrants stores a generic authorization with an expiration and a stake
+// authorization from the first to the second keyring account, and a generic
+// authorization from the third to the second keyring account.
+func (s *PrecompileTestSuite) setupGrants(ctx sdk.Context) (time.Time, string) {
+	expiration := ctx.BlockTime().Add(time.Hour).UTC().Truncate(time.Second)
+	valAddr := s.network.GetValidators()[0].OperatorAddress
+
+	s.saveGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdkauthz.NewGenericAuthorization(sendMsgTypeURL), &expiration)
+
+	maxTokens := sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000))
+	stakeAuthorization, err := stakingtypes.NewStakeAuthorization(
+		[]sdk.ValAddress{sdk.MustValAddressFromBech32(valAddr)},
+		nil,
+		stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+		&maxTokens,
+	)
+	s.Require().NoError(err)
+	s.saveGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), stakeAuthorization, nil)
+
+	s.saveGrant(ctx, s.keyring.GetAddr(2), s.keyring.GetAddr(1), sdkauthz.NewGenericAuthorization(sendMsgTypeURL), nil)
+
+	return expiration, valAddr
+}
+
+func (s *PrecompileTestSuite) TestGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantsMethod]
+	delegateMsgTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput, expiration time.Time, valAddr string)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*authz.GrantsOutput, time.Time, string) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			func(*authz.GrantsOutput, time.Time, string) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - authorization not found for the msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "/cosmos.gov.v1.MsgVote", query.PageRequest{}}
+			},
+			func(*authz.GrantsOutput, time.Time, string) {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - all grants between the granter and grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput, _ time.Time, _ string) {
+				s.Require().Len(out.Grants, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+					s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - generic authorization by msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput, expiration time.Time, _ string) {
+				s.Require().Len(out.Grants, 1)
+				grant := out.Grants[0]
+				s.Require().Equal("/cosmos.authz.v1beta1.GenericAuthorization", grant.AuthorizationType)
+				s.Require().Equal(sendMsgTypeURL, grant.MsgTypeUrl)
+				s.Require().Equal(expiration.Unix(), grant.Expiration)
+				s.Require().Empty(grant.StakeAuthorization.AllowList)
+			},
+			false,
+			"",
+		},
+		{
+			"success - stake authorization by msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput, _ time.Time, valAddr string) {
+				s.Require().Len(out.Grants, 1)
+				grant := out.Grants[0]
+				s.Require().Equal("/cosmos.staking.v1beta1.StakeAuthorization", grant.AuthorizationType)
+				s.Require().Equal(delegateMsgTypeURL, grant.MsgTypeUrl)
+				s.Require().Equal(int64(0), grant.Expiration)
+				s.Require().Equal(uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE), grant.StakeAuthorization.AuthorizationType)
+				s.Require().Equal([]string{valAddr}, grant.StakeAuthorization.AllowList)
+				s.Require().Empty(grant.StakeAuthorization.DenyList)
+				s.Require().Equal(s.network.GetBaseDenom(), grant.StakeAuthorization.MaxTokens.Denom)
+				s.Require().Equal(int64(1000), grant.StakeAuthorization.MaxTokens.Amount.Int64())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			expiration, valAddr := s.setupGrants(ctx)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Grants(ctx, &method, contract, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+			s.Require().NoError(err)
+			tc.postCheck(&out, expiration, valAddr)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0,
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - granter with grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"success - paginated grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1}}
+			},
+			1,
+			false,
+			"",
+		},
+		{
+			"success - granter without grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.setupGrants(ctx)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GranterGrants(ctx, &method, contract, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Len(out.Grants, tc.expGrants)
+			for _, grant := range out.Grants {
+				s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - grantee with grants from several granters",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			3,
+			false,
+			"",
+		},
+		{
+			"success - grantee without grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.setupGrants(ctx)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GranteeGrants(ctx, &method, contract, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Len(out.Grants, tc.expGrants)
+			for _, grant := range out.Grants {
+				s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package authz
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *authz.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.network = nw
+
+	var err error
+	if s.precompile, err = authz.NewPrecompile(
+		s.network.App.GetAuthzKeeper(),
+		s.network.App.AppCodec(),
+		address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+	); err != nil {
+		panic(err)
+	}
+}
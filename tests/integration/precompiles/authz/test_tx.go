@@ -0,0 +1,573 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrantGenericAuthorization() {
+	var (
+		ctx     sdk.Context
+		granter common.Address
+		grantee common.Address
+	)
+	method := s.precompile.Methods[authz.GrantGenericAuthorizationMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, grantee, sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{granter, "", sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - empty msg type URL",
+			func() []interface{} {
+				return []interface{}{granter, grantee, "", int64(0)}
+			},
+			func() {},
+			true,
+			"invalid message type URL",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sendMsgTypeURL, int64(-1)}
+			},
+			func() {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sendMsgTypeURL, ctx.BlockTime().Add(-time.Hour).Unix()}
+			},
+			func() {},
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"fail - disabled msg type",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"fail - unknown msg type",
+			func() []interface{} {
+				return []interface{}{granter, grantee, "/cosmos.unknown.v1.MsgUnknown", int64(0)}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"fail - granter is the grantee",
+			func() []interface{} {
+				return []interface{}{granter, granter, sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"grantee and granter should be different",
+		},
+		{
+			"success - grant without expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sendMsgTypeURL, int64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().Equal(sendMsgTypeURL, authorization.MsgTypeURL())
+				s.Require().Nil(expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - grant with expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sendMsgTypeURL, ctx.BlockTime().Add(time.Hour).Unix()}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GrantGenericAuthorization(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event authz.EventGrant
+			err = cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeGrant, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(granter, event.Granter)
+			s.Require().Equal(grantee, event.Grantee)
+			s.Require().Equal(sendMsgTypeURL, event.MsgTypeUrl)
+
+			tc.postCheck()
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantStakeAuthorization() {
+	var (
+		ctx     sdk.Context
+		granter common.Address
+		grantee common.Address
+		valAddr string
+	)
+	method := s.precompile.Methods[authz.GrantStakeAuthorizationMethod]
+	delegateType := uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE)
+	delegateMsgTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	testCases := []struct {
+		name          string
+		authorization func() authz.StakeAuthorization
+		postCheck     func()
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - unspecified authorization type",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AllowList: []string{valAddr},
+					MaxTokens: cmn.Coin{Amount: big.NewInt(0)},
+				}
+			},
+			func() {},
+			true,
+			"unknown authorization type",
+		},
+		{
+			"fail - unknown authorization type",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: 10,
+					AllowList:         []string{valAddr},
+					MaxTokens:         cmn.Coin{Amount: big.NewInt(0)},
+				}
+			},
+			func() {},
+			true,
+			"unknown authorization type",
+		},
+		{
+			"fail - empty allow and deny lists",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: delegateType,
+					MaxTokens:         cmn.Coin{Amount: big.NewInt(0)},
+				}
+			},
+			func() {},
+			true,
+			"both allowed & deny list cannot be empty",
+		},
+		{
+			"fail - both allow and deny lists",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: delegateType,
+					AllowList:         []string{valAddr},
+					DenyList:          []string{valAddr},
+					MaxTokens:         cmn.Coin{Amount: big.NewInt(0)},
+				}
+			},
+			func() {},
+			true,
+			"cannot set both allowed & deny list",
+		},
+		{
+			"fail - invalid validator address",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: delegateType,
+					AllowList:         []string{"invalid"},
+					MaxTokens:         cmn.Coin{Amount: big.NewInt(0)},
+				}
+			},
+			func() {},
+			true,
+			"invalid stake authorization",
+		},
+		{
+			"fail - invalid max tokens denom",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: delegateType,
+					AllowList:         []string{valAddr},
+					MaxTokens:         cmn.Coin{Denom: "", Amount: big.NewInt(100)},
+				}
+			},
+			func() {},
+			true,
+			"invalid stake authorization",
+		},
+		{
+			"success - delegate authorization with allow list and max tokens",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: delegateType,
+					AllowList:         []string{valAddr},
+					MaxTokens:         cmn.Coin{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)},
+				}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), delegateMsgTypeURL)
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal([]string{valAddr}, stakeAuthorization.GetAllowList().Address)
+				s.Require().Equal(int64(100), stakeAuthorization.MaxTokens.Amount.Int64())
+			},
+			false,
+			"",
+		},
+		{
+			"success - delegate authorization with deny list and no limit",
+			func() authz.StakeAuthorization {
+				return authz.StakeAuthorization{
+					AuthorizationType: delegateType,
+					DenyList:          []string{valAddr},
+					MaxTokens:         cmn.Coin{Amount: big.NewInt(0)},
+				}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), delegateMsgTypeURL)
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal([]string{valAddr}, stakeAuthorization.GetDenyList().Address)
+				s.Require().Nil(stakeAuthorization.MaxTokens)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+			valAddr = s.network.GetValidators()[0].OperatorAddress
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile.Address(), 200_000)
+
+			args := []interface{}{granter, grantee, tc.authorization(), int64(0)}
+			_, err := s.precompile.GrantStakeAuthorization(ctx, contract, stateDB, &method, args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event authz.EventGrant
+			err = cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeGrant, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(delegateMsgTypeURL, event.MsgTypeUrl)
+
+			tc.postCheck()
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var (
+		ctx     sdk.Context
+		granter common.Address
+		grantee common.Address
+	)
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, sendMsgTypeURL}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - authorization not found",
+			func() []interface{} {
+				return []interface{}{granter, utiltx.GenerateAddress(), sendMsgTypeURL}
+			},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - revoke authorization",
+			func() []interface{} {
+				return []interface{}{granter, grantee, sendMsgTypeURL}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+			stateDB := s.network.GetStateDB()
+			s.saveGrant(ctx, granter, grantee, sdkauthz.NewGenericAuthorization(sendMsgTypeURL), nil)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile.Address(), 200_000)
+
+			_, err := s.precompile.Revoke(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+			s.Require().Nil(authorization)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event authz.EventRevoke
+			err = cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeRevoke, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(granter, event.Granter)
+			s.Require().Equal(grantee, event.Grantee)
+			s.Require().Equal(sendMsgTypeURL, event.MsgTypeUrl)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var (
+		ctx      sdk.Context
+		granter  common.Address
+		grantee  common.Address
+		receiver common.Address
+	)
+	method := s.precompile.Methods[authz.ExecMethod]
+
+	sendMsg := func(from common.Address) *banktypes.MsgSend {
+		return banktypes.NewMsgSend(from.Bytes(), receiver.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)))
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{}}
+			},
+			true,
+			"invalid messages",
+		},
+		{
+			"fail - invalid message JSON",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{[]byte("{}")}}
+			},
+			true,
+			"message 0",
+		},
+		{
+			"fail - grantee is not the caller",
+			func() []interface{} {
+				return []interface{}{granter, [][]byte{s.msgJSON(sendMsg(granter))}}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - disabled msg type",
+			func() []interface{} {
+				msg := sdkvesting.NewMsgCreateVestingAccount(granter.Bytes(), receiver.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), 1, false)
+				return []interface{}{grantee, [][]byte{s.msgJSON(msg)}}
+			},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"fail - disabled msg type in a nested exec",
+			func() []interface{} {
+				inner := sdkvesting.NewMsgCreateVestingAccount(granter.Bytes(), receiver.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), 1, false)
+				nested := sdkauthz.NewMsgExec(grantee.Bytes(), []sdk.Msg{inner})
+				return []interface{}{grantee, [][]byte{s.msgJSON(&nested)}}
+			},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"fail - no authorization from the signer",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{s.msgJSON(sendMsg(s.keyring.GetAddr(2)))}}
+			},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - exec a message on behalf of the granter",
+			func() []interface{} {
+				return []interface{}{grantee, [][]byte{s.msgJSON(sendMsg(granter))}}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+			receiver = utiltx.GenerateAddress()
+			stateDB := s.network.GetStateDB()
+			s.saveGrant(ctx, granter, grantee, sdkauthz.NewGenericAuthorization(sendMsgTypeURL), nil)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, grantee, s.precompile.Address(), 500_000)
+
+			bz, err := s.precompile.Exec(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			results, ok := out[0].([][]byte)
+			s.Require().True(ok)
+			s.Require().Len(results, 1)
+
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.network.GetBaseDenom())
+			s.Require().Equal(int64(100), balance.Amount.Int64())
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event authz.EventExec
+			err = cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeExec, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(granter, event.Granter)
+			s.Require().Equal(grantee, event.Grantee)
+			s.Require().Equal(sendMsgTypeURL, event.MsgTypeUrl)
+		})
+	}
+}
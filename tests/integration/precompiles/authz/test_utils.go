@@ -0,0 +1,25 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// saveGrant is a helper function to store an authorization from the granter
+// to the grantee directly in the authz keeper.
+func (s *PrecompileTestSuite) saveGrant(ctx sdk.Context, granter, grantee common.Address, authorization authz.Authorization, expiration *time.Time) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), authorization, expiration)
+	s.Require().NoError(err)
+}
+
+// msgJSON is a helper function to encode a Cosmos SDK message as the JSON
+// expected by the exec method.
+func (s *PrecompileTestSuite) msgJSON(msg sdk.Msg) []byte {
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+	return bz
+}
@@ -14,6 +14,7 @@ import (
 
 	bank2 "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bank/testdata"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/testutil"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
@@ -295,6 +296,98 @@ func TestIntegrationSuite(t *testing.T, create network.CreateEvmApp, options ...
 			})
 		})
 
+		Context("Direct precompile transactions", func() {
+			Context("send", func() {
+				It("should send native coins and sync the EVM balances", func() {
+					receiver := utiltx.GenerateAddress()
+					coins := []cmn.Coin{
+						{Denom: is.network.GetBaseDenom(), Amount: amount},
+						{Denom: is.tokenDenom, Amount: amount},
+					}
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, receiver, coins)
+					// a Send event is emitted per coin
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend, bank2.EventTypeSend)
+					_, ethRes, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					out, err := is.precompile.Unpack(bank2.SendMethod, ethRes.Ret)
+					Expect(err).ToNot(HaveOccurred(), "failed to unpack result")
+					Expect(out[0]).To(BeTrue())
+
+					for _, coin := range coins {
+						balance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), coin.Denom)
+						Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+						Expect(balance.Balance.Amount.BigInt()).To(Equal(amount))
+					}
+
+					evmBalance, err := is.grpcHandler.GetBalanceFromEVM(receiver.Bytes())
+					Expect(err).ToNot(HaveOccurred(), "failed to get EVM balance")
+					Expect(evmBalance.Balance).To(Equal(amount.String()))
+				})
+
+				It("should fail to send more than the balance", func() {
+					coins := []cmn.Coin{{Denom: is.tokenDenom, Amount: new(big.Int).Add(network.PrefundedAccountInitialBalance.BigInt(), amount)}}
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, utiltx.GenerateAddress(), coins)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, testutil.LogCheckArgs{}.WithErrContains("insufficient funds"))
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+				})
+
+				It("should fail to send to a blocked address", func() {
+					coins := []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}}
+
+					txArgs, sendArgs := getTxAndCallArgs(directCall, contractData, bank2.SendMethod, is.precompile.Address(), coins)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, sendArgs, testutil.LogCheckArgs{}.WithErrContains("is not allowed to receive funds"))
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+				})
+			})
+
+			Context("multiSend", func() {
+				It("should send native coins to all the outputs", func() {
+					receivers := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+					outputs := []bank2.Output{
+						{ToAddress: receivers[0], Amount: []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}}},
+						{ToAddress: receivers[1], Amount: []cmn.Coin{{Denom: is.network.GetBaseDenom(), Amount: amount}}},
+					}
+
+					txArgs, multiSendArgs := getTxAndCallArgs(directCall, contractData, bank2.MultiSendMethod, outputs)
+					// a Send event is emitted per coin
+					sendCheck := passCheck.WithABIEvents(is.precompile.Events).WithExpEvents(bank2.EventTypeSend, bank2.EventTypeSend)
+					_, ethRes, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, multiSendArgs, sendCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+					Expect(bank2.GasSend * len(outputs)).To(BeNumerically("<=", ethRes.GasUsed))
+
+					balance, err := is.grpcHandler.GetBalanceFromBank(receivers[0].Bytes(), is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balance.Balance.Amount.BigInt()).To(Equal(amount))
+
+					evmBalance, err := is.grpcHandler.GetBalanceFromEVM(receivers[1].Bytes())
+					Expect(err).ToNot(HaveOccurred(), "failed to get EVM balance")
+					Expect(evmBalance.Balance).To(Equal(amount.String()))
+				})
+
+				It("should not send anything if an output fails", func() {
+					receiver := utiltx.GenerateAddress()
+					outputs := []bank2.Output{
+						{ToAddress: receiver, Amount: []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}}},
+						{ToAddress: is.precompile.Address(), Amount: []cmn.Coin{{Denom: is.tokenDenom, Amount: amount}}},
+					}
+
+					txArgs, multiSendArgs := getTxAndCallArgs(directCall, contractData, bank2.MultiSendMethod, outputs)
+					_, _, err := is.factory.CallContractAndCheckLogs(sender.Priv, txArgs, multiSendArgs, testutil.LogCheckArgs{}.WithErrContains("is not allowed to receive funds"))
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+
+					balance, err := is.grpcHandler.GetBalanceFromBank(receiver.Bytes(), is.tokenDenom)
+					Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+					Expect(balance.Balance.Amount.IsZero()).To(BeTrue())
+				})
+			})
+		})
+
 		Context("Calls from a contract", func() {
 			const (
 				BalancesFunction = "callBalances"
@@ -0,0 +1,257 @@
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	var (
+		ctx      sdk.Context
+		receiver common.Address
+	)
+	method := s.precompile.Methods[bank.SendMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - invalid receiver address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}},
+				}
+			},
+			false,
+			"invalid hex address address",
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(0)}},
+				}
+			},
+			false,
+			"invalid amount",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: network.PrefundedAccountInitialBalance.AddRaw(1).BigInt()}},
+				}
+			},
+			false,
+			"insufficient funds",
+		},
+		{
+			"fail - blocked receiver address",
+			func() []interface{} {
+				return []interface{}{
+					s.precompile.Address(),
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}},
+				}
+			},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"pass - send multiple denoms",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{
+						{Denom: s.bondDenom, Amount: big.NewInt(100)},
+						{Denom: s.tokenDenom, Amount: big.NewInt(200)},
+					},
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest()
+			receiver = cosmosevmutiltx.GenerateAddress()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			s.Require().Equal(int64(100), s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.bondDenom).Amount.Int64())
+			s.Require().Equal(int64(200), s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.tokenDenom).Amount.Int64())
+
+			// a Send event is emitted per coin
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 2)
+			for i, coin := range []sdk.Coin{sdk.NewInt64Coin(s.bondDenom, 100), sdk.NewInt64Coin(s.tokenDenom, 200)} {
+				var event bank.EventSend
+				err := cmn.UnpackLog(s.precompile.ABI, &event, bank.EventTypeSend, *logs[i])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.From)
+				s.Require().Equal(receiver, event.To)
+				s.Require().Equal(coin.Denom, event.Denom)
+				s.Require().Equal(coin.Amount.BigInt(), event.Amount)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendEVMCoinBalanceSync() {
+	// With 18 decimals, the app bank precompile sends the EVM coin through
+	// x/bank, whose bank events are synced once by the balance handler.
+	ctx := s.SetupTest()
+	params := s.network.App.GetEVMKeeper().GetParams(ctx)
+	instance, found, err := s.network.App.GetEVMKeeper().GetStaticPrecompileInstance(&params, common.HexToAddress(evmtypes.BankPrecompileAddress))
+	s.Require().NoError(err)
+	s.Require().True(found)
+	precompile, ok := instance.(*bank.Precompile)
+	s.Require().True(ok)
+	method := precompile.Methods[bank.SendMethod]
+
+	sender := s.keyring.GetAddr(0)
+	receiver := cosmosevmutiltx.GenerateAddress()
+	stateDB := s.network.GetStateDB()
+	prevSenderBalance := stateDB.GetBalance(sender)
+	stateDB.CreateAccount(receiver)
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, sender, precompile.Address(), 200_000)
+
+	args := []interface{}{
+		receiver,
+		[]cmn.Coin{
+			{Denom: evmtypes.GetEVMCoinDenom(), Amount: big.NewInt(100)},
+			{Denom: s.tokenDenom, Amount: big.NewInt(200)},
+		},
+	}
+	balanceHandler := precompile.GetBalanceHandler()
+	balanceHandler.BeforeBalanceChange(ctx)
+	_, err = precompile.Send(ctx, contract, stateDB, &method, args)
+	s.Require().NoError(err)
+	s.Require().NoError(balanceHandler.AfterBalanceChange(ctx, stateDB))
+
+	s.Require().Equal(uint256.NewInt(100), stateDB.GetBalance(receiver))
+	s.Require().Equal(new(uint256.Int).Sub(prevSenderBalance, uint256.NewInt(100)), stateDB.GetBalance(sender))
+	s.Require().Equal(int64(200), s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.tokenDenom).Amount.Int64())
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var (
+		ctx       sdk.Context
+		receivers []common.Address
+	)
+	method := s.precompile.Methods[bank.MultiSendMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - no outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			false,
+			"no outputs",
+		},
+		{
+			"fail - blocked receiver address",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{ToAddress: receivers[0], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+					{ToAddress: s.precompile.Address(), Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+				}}
+			},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"pass - send to multiple receivers",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{ToAddress: receivers[0], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(100)}}},
+					{ToAddress: receivers[1], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(200)}}},
+				}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest()
+			receivers = []common.Address{cosmosevmutiltx.GenerateAddress(), cosmosevmutiltx.GenerateAddress()}
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			_, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the first send is charged by RequiredGas
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(bank.GasSend))
+			s.Require().Equal(int64(100), s.network.App.GetBankKeeper().GetBalance(ctx, receivers[0].Bytes(), s.tokenDenom).Amount.Int64())
+			s.Require().Equal(int64(200), s.network.App.GetBankKeeper().GetBalance(ctx, receivers[1].Bytes(), s.tokenDenom).Amount.Int64())
+			s.Require().Len(stateDB.Logs(), 2)
+		})
+	}
+}
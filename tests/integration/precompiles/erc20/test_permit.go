@@ -0,0 +1,515 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	permitTypes = apitypes.Types{
+		"Permit": {
+			{Name: "owner", Type: "address"},
+			{Name: "spender", Type: "address"},
+			{Name: "value", Type: "uint256"},
+			{Name: "nonce", Type: "uint256"},
+			{Name: "deadline", Type: "uint256"},
+		},
+	}
+	transferWithAuthorizationFields = []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}
+	authorizationTypes = apitypes.Types{
+		"TransferWithAuthorization": transferWithAuthorizationFields,
+		"ReceiveWithAuthorization":  transferWithAuthorizationFields,
+		"CancelAuthorization": {
+			{Name: "authorizer", Type: "address"},
+			{Name: "nonce", Type: "bytes32"},
+		},
+	}
+)
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	amount := big.NewInt(100)
+
+	var (
+		owner    common.Address
+		spender  common.Address
+		deadline *big.Int
+	)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			malleate:    func() []interface{} { return []interface{}{1, 2, 3} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func() []interface{} {
+				deadline = big.NewInt(s.network.GetContext().BlockTime().Unix() - 1)
+				return s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, amount, 0, deadline)
+			},
+			errContains: erc20.ErrPermitExpired.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func() []interface{} {
+				return s.permitArgs(s.keyring.GetPrivKey(1), owner, spender, amount, 0, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - signed for another value",
+			malleate: func() []interface{} {
+				args := s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, amount, 0, deadline)
+				args[2] = big.NewInt(101)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - invalid v",
+			malleate: func() []interface{} {
+				args := s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, amount, 0, deadline)
+				args[4] = args[4].(uint8) - 27
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - nonce already used",
+			malleate: func() []interface{} {
+				s.network.App.GetErc20Keeper().SetNonce(s.network.GetContext(), s.precompile.Address(), owner, 1)
+				return s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, amount, 0, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "pass - permit without existing allowance",
+			malleate: func() []interface{} {
+				return s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, amount, 0, deadline)
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile.Address(), owner, spender, amount)
+				nonce := s.network.App.GetErc20Keeper().GetNonce(s.network.GetContext(), s.precompile.Address(), owner)
+				s.Require().Equal(uint64(1), nonce, "expected nonce to be incremented")
+			},
+		},
+		{
+			name: "pass - permit with the next nonce",
+			malleate: func() []interface{} {
+				s.network.App.GetErc20Keeper().SetNonce(s.network.GetContext(), s.precompile.Address(), owner, 5)
+				return s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, amount, 5, deadline)
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile.Address(), owner, spender, amount)
+				nonce := s.network.App.GetErc20Keeper().GetNonce(s.network.GetContext(), s.precompile.Address(), owner)
+				s.Require().Equal(uint64(6), nonce, "expected nonce to be incremented")
+			},
+		},
+		{
+			name: "pass - permit zero value deletes the existing allowance",
+			malleate: func() []interface{} {
+				s.setAllowance(s.precompile.Address(), s.keyring.GetPrivKey(0), spender, amount)
+				return s.permitArgs(s.keyring.GetPrivKey(0), owner, spender, common.Big0, 0, deadline)
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile.Address(), owner, spender, common.Big0)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			owner = s.keyring.GetAddr(0)
+			spender = s.keyring.GetAddr(1)
+			deadline = big.NewInt(s.network.GetContext().BlockTime().Unix() + 3600)
+			stateDB := s.network.GetStateDB()
+
+			// NOTE: anyone can submit the permit of the owner
+			var contract *vm.Contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), spender, s.precompile.Address(), 0)
+
+			_, err := s.precompile.Permit(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expPass {
+				s.Require().NoError(err, "expected permit to succeed")
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected permit to fail")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	amount := big.NewInt(100)
+
+	var (
+		from        common.Address
+		to          common.Address
+		caller      common.Address
+		methodName  string
+		validAfter  *big.Int
+		validBefore *big.Int
+		nonce       common.Hash
+	)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			malleate:    func() []interface{} { return []interface{}{1, 2, 3} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - not yet valid",
+			malleate: func() []interface{} {
+				validAfter = big.NewInt(s.network.GetContext().BlockTime().Unix())
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			name: "fail - expired",
+			malleate: func() []interface{} {
+				validBefore = big.NewInt(s.network.GetContext().BlockTime().Unix())
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func() []interface{} {
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(1), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			name: "fail - signed for receiveWithAuthorization",
+			malleate: func() []interface{} {
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), erc20.ReceiveWithAuthorizationMethod, from, to, amount, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			name: "fail - authorization already used",
+			malleate: func() []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), from, nonce)
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			name: "fail - amount exceeds balance",
+			malleate: func() []interface{} {
+				value := new(big.Int).Add(XMPLCoin.AmountOf(tokenDenom).BigInt(), common.Big1)
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, value, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+		{
+			name: "fail - receiveWithAuthorization called by another account than the payee",
+			malleate: func() []interface{} {
+				methodName = erc20.ReceiveWithAuthorizationMethod
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			errContains: erc20.ErrCallerIsNotPayee.Error(),
+		},
+		{
+			name: "pass - transferWithAuthorization",
+			malleate: func() []interface{} {
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			expPass: true,
+			postCheck: func() {
+				toBalance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), to.Bytes(), tokenDenom)
+				s.Require().Equal(amount, toBalance.Amount.BigInt(), "expected the payee to receive the tokens")
+				used := s.network.App.GetErc20Keeper().IsAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), from, nonce)
+				s.Require().True(used, "expected the authorization to be used")
+			},
+		},
+		{
+			name: "pass - receiveWithAuthorization",
+			malleate: func() []interface{} {
+				methodName = erc20.ReceiveWithAuthorizationMethod
+				caller = to
+				return s.transferWithAuthorizationArgs(s.keyring.GetPrivKey(0), methodName, from, to, amount, validAfter, validBefore, nonce)
+			},
+			expPass: true,
+			postCheck: func() {
+				toBalance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), to.Bytes(), tokenDenom)
+				s.Require().Equal(amount, toBalance.Amount.BigInt(), "expected the payee to receive the tokens")
+				used := s.network.App.GetErc20Keeper().IsAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), from, nonce)
+				s.Require().True(used, "expected the authorization to be used")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			from = s.keyring.GetAddr(0)
+			to = GenerateAddress()
+			caller = s.keyring.GetAddr(1)
+			methodName = erc20.TransferWithAuthorizationMethod
+			validAfter = common.Big0
+			validBefore = big.NewInt(s.network.GetContext().BlockTime().Unix() + 3600)
+			nonce = common.BytesToHash(crypto.Keccak256([]byte(tc.name)))
+			stateDB := s.network.GetStateDB()
+
+			// Mint some coins to the module account and then send to the from address
+			err := s.network.App.GetBankKeeper().MintCoins(s.network.GetContext(), erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(s.network.GetContext(), erc20types.ModuleName, from.Bytes(), XMPLCoin)
+			s.Require().NoError(err, "failed to send coins from module to account")
+
+			args := tc.malleate()
+			method := s.precompile.Methods[methodName]
+
+			var contract *vm.Contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 0)
+
+			_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			if tc.expPass {
+				s.Require().NoError(err, "expected transfer with authorization to succeed")
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected transfer with authorization to fail")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelAuthorization() {
+	method := s.precompile.Methods[erc20.CancelAuthorizationMethod]
+	nonce := common.BytesToHash(crypto.Keccak256([]byte("nonce")))
+
+	var authorizer common.Address
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			malleate:    func() []interface{} { return []interface{}{1, 2, 3} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func() []interface{} {
+				return s.cancelAuthorizationArgs(s.keyring.GetPrivKey(1), authorizer, nonce)
+			},
+			errContains: erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			name: "fail - authorization already used",
+			malleate: func() []interface{} {
+				s.network.App.GetErc20Keeper().SetAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), authorizer, nonce)
+				return s.cancelAuthorizationArgs(s.keyring.GetPrivKey(0), authorizer, nonce)
+			},
+			errContains: erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			name: "pass",
+			malleate: func() []interface{} {
+				return s.cancelAuthorizationArgs(s.keyring.GetPrivKey(0), authorizer, nonce)
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			authorizer = s.keyring.GetAddr(0)
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 0)
+
+			_, err := s.precompile.CancelAuthorization(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expPass {
+				s.Require().NoError(err, "expected cancel authorization to succeed")
+				used := s.network.App.GetErc20Keeper().IsAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), authorizer, nonce)
+				s.Require().True(used, "expected the authorization to be canceled")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected cancel authorization to fail")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	method := s.precompile.Methods[erc20.NoncesMethod]
+	owner := s.keyring.GetAddr(0)
+
+	s.network.App.GetErc20Keeper().SetNonce(s.network.GetContext(), s.precompile.Address(), owner, 3)
+
+	bz, err := s.precompile.Nonces(s.network.GetContext(), nil, nil, &method, []interface{}{owner})
+	s.requireOut(bz, err, method, true, "", big.NewInt(3))
+
+	// The nonces are scoped to the precompile
+	bz, err = s.precompile2.Nonces(s.network.GetContext(), nil, nil, &method, []interface{}{owner})
+	s.requireOut(bz, err, method, true, "", common.Big0)
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+
+	bz, err := s.precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, nil)
+
+	typedData := apitypes.TypedData{
+		Types:  apitypes.Types{"EIP712Domain": eip712DomainFields},
+		Domain: s.permitDomain(s.precompile),
+	}
+	expDomainSeparator, hashErr := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	s.Require().NoError(hashErr, "failed to hash domain")
+
+	s.requireOut(bz, err, method, true, "", [32]byte(expDomainSeparator))
+}
+
+func (s *PrecompileTestSuite) TestAuthorizationState() {
+	method := s.precompile.Methods[erc20.AuthorizationStateMethod]
+	authorizer := s.keyring.GetAddr(0)
+	nonce := common.BytesToHash(crypto.Keccak256([]byte("nonce")))
+
+	bz, err := s.precompile.AuthorizationState(s.network.GetContext(), nil, nil, &method, []interface{}{authorizer, [32]byte(nonce)})
+	s.requireOut(bz, err, method, true, "", false)
+
+	s.network.App.GetErc20Keeper().SetAuthorizationUsed(s.network.GetContext(), s.precompile.Address(), authorizer, nonce)
+
+	bz, err = s.precompile.AuthorizationState(s.network.GetContext(), nil, nil, &method, []interface{}{authorizer, [32]byte(nonce)})
+	s.requireOut(bz, err, method, true, "", true)
+}
+
+var eip712DomainFields = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+}
+
+// permitDomain returns the EIP-712 domain of the permits and authorizations of
+// the given precompile, whose token has no metadata.
+func (s *PrecompileTestSuite) permitDomain(precompile *erc20.Precompile) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              s.tokenDenom,
+		Version:           erc20.DomainVersion,
+		ChainId:           math.NewHexOrDecimal256(evmtypes.GetEthChainConfig().ChainID.Int64()),
+		VerifyingContract: precompile.Address().Hex(),
+	}
+}
+
+// signTypedData signs the given EIP-712 message for the precompile with the
+// given private key and returns the v, r and s values of the signature.
+func (s *PrecompileTestSuite) signTypedData(
+	privKey cryptotypes.PrivKey, types apitypes.Types, primaryType string, message apitypes.TypedDataMessage,
+) (uint8, [32]byte, [32]byte) {
+	types["EIP712Domain"] = eip712DomainFields
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types:       types,
+		PrimaryType: primaryType,
+		Domain:      s.permitDomain(s.precompile),
+		Message:     message,
+	})
+	s.Require().NoError(err, "failed to hash typed data")
+
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected an ethsecp256k1 private key")
+	key, err := ethPrivKey.ToECDSA()
+	s.Require().NoError(err, "failed to convert private key")
+
+	sig, err := crypto.Sign(hash, key)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	return sig[64] + 27, [32]byte(sig[:32]), [32]byte(sig[32:64])
+}
+
+// permitArgs returns the arguments of the permit method signed with the
+// given private key.
+func (s *PrecompileTestSuite) permitArgs(
+	privKey cryptotypes.PrivKey, owner, spender common.Address, value *big.Int, nonce uint64, deadline *big.Int,
+) []interface{} {
+	v, r, sigS := s.signTypedData(privKey, permitTypes, "Permit", apitypes.TypedDataMessage{
+		"owner":    owner.Hex(),
+		"spender":  spender.Hex(),
+		"value":    value,
+		"nonce":    new(big.Int).SetUint64(nonce),
+		"deadline": deadline,
+	})
+
+	return []interface{}{owner, spender, value, deadline, v, r, sigS}
+}
+
+// transferWithAuthorizationArgs returns the arguments of the
+// transferWithAuthorization or receiveWithAuthorization methods signed with
+// the given private key.
+func (s *PrecompileTestSuite) transferWithAuthorizationArgs(
+	privKey cryptotypes.PrivKey, methodName string, from, to common.Address, value, validAfter, validBefore *big.Int, nonce common.Hash,
+) []interface{} {
+	primaryType := "TransferWithAuthorization"
+	if methodName == erc20.ReceiveWithAuthorizationMethod {
+		primaryType = "ReceiveWithAuthorization"
+	}
+
+	v, r, sigS := s.signTypedData(privKey, authorizationTypes, primaryType, apitypes.TypedDataMessage{
+		"from":        from.Hex(),
+		"to":          to.Hex(),
+		"value":       value,
+		"validAfter":  validAfter,
+		"validBefore": validBefore,
+		"nonce":       nonce.Hex(),
+	})
+
+	return []interface{}{from, to, value, validAfter, validBefore, [32]byte(nonce), v, r, sigS}
+}
+
+// cancelAuthorizationArgs returns the arguments of the cancelAuthorization
+// method signed with the given private key.
+func (s *PrecompileTestSuite) cancelAuthorizationArgs(
+	privKey cryptotypes.PrivKey, authorizer common.Address, nonce common.Hash,
+) []interface{} {
+	v, r, sigS := s.signTypedData(privKey, authorizationTypes, "CancelAuthorization", apitypes.TypedDataMessage{
+		"authorizer": authorizer.Hex(),
+		"nonce":      nonce.Hex(),
+	})
+
+	return []interface{}{authorizer, [32]byte(nonce), v, r, sigS}
+}
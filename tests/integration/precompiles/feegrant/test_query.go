@@ -0,0 +1,328 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	basicAllowanceTypeURL    = sdk.MsgTypeURL(&sdkfeegrant.BasicAllowance{})
+	periodicAllowanceTypeURL = sdk.MsgTypeURL(&sdkfeegrant.PeriodicAllowance{})
+)
+
+// setupAllowances stores a basic allowance restricted to MsgSend from the
+// first to the second keyring account, and a periodic allowance from the
+// third to the second keyring account.
+func (s *PrecompileTestSuite) setupAllowances(ctx sdk.Context) time.Time {
+	expiration := ctx.BlockTime().Add(time.Hour).UTC().Truncate(time.Second)
+	spendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000)))
+
+	allowedMsgAllowance, err := sdkfeegrant.NewAllowedMsgAllowance(
+		&sdkfeegrant.BasicAllowance{SpendLimit: spendLimit, Expiration: &expiration},
+		[]string{sendMsgTypeURL},
+	)
+	s.Require().NoError(err)
+	s.grantAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), allowedMsgAllowance)
+
+	periodSpendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100)))
+	s.grantAllowance(ctx, s.keyring.GetAddr(2), s.keyring.GetAddr(1), &sdkfeegrant.PeriodicAllowance{
+		Basic:            sdkfeegrant.BasicAllowance{SpendLimit: spendLimit},
+		Period:           time.Hour,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      expiration,
+	})
+
+	return expiration
+}
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(allowance feegrant.Allowance, expiration time.Time)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(feegrant.Allowance, time.Time) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			func(feegrant.Allowance, time.Time) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0)}
+			},
+			func(feegrant.Allowance, time.Time) {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - basic allowance with allowed messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(allowance feegrant.Allowance, expiration time.Time) {
+				s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+				s.Require().Equal(basicAllowanceTypeURL, allowance.AllowanceType)
+				s.Require().Len(allowance.SpendLimit, 1)
+				s.Require().Equal(s.network.GetBaseDenom(), allowance.SpendLimit[0].Denom)
+				s.Require().Equal(int64(1000), allowance.SpendLimit[0].Amount.Int64())
+				s.Require().Equal(expiration.Unix(), allowance.Expiration)
+				s.Require().Equal([]string{sendMsgTypeURL}, allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), s.keyring.GetAddr(1)}
+			},
+			func(allowance feegrant.Allowance, expiration time.Time) {
+				s.Require().Equal(periodicAllowanceTypeURL, allowance.AllowanceType)
+				s.Require().Equal(int64(0), allowance.Expiration)
+				s.Require().Equal(int64(3600), allowance.Period)
+				s.Require().Len(allowance.PeriodSpendLimit, 1)
+				s.Require().Equal(int64(100), allowance.PeriodSpendLimit[0].Amount.Int64())
+				s.Require().Len(allowance.PeriodCanSpend, 1)
+				s.Require().Equal(int64(100), allowance.PeriodCanSpend[0].Amount.Int64())
+				s.Require().Equal(expiration.Unix(), allowance.PeriodReset)
+				s.Require().Empty(allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			expiration := s.setupAllowances(ctx)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Allowance(ctx, &method, contract, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out struct {
+				Allowance feegrant.Allowance
+			}
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+			s.Require().NoError(err)
+			tc.postCheck(out.Allowance, expiration)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *feegrant.AllowancesOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*feegrant.AllowancesOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(*feegrant.AllowancesOutput) {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Empty(out.Allowances)
+			},
+			false,
+			"",
+		},
+		{
+			"success - all the allowances of the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{CountTotal: true}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Len(out.Allowances, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, allowance := range out.Allowances {
+					s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - paginated allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Len(out.Allowances, 1)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.setupAllowances(ctx)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Allowances(ctx, &method, contract, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out feegrant.AllowancesOutput
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+			s.Require().NoError(err)
+			tc.postCheck(&out)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *feegrant.AllowancesOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*feegrant.AllowancesOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(*feegrant.AllowancesOutput) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Empty(out.Allowances)
+			},
+			false,
+			"",
+		},
+		{
+			"success - allowances of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{CountTotal: true}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Len(out.Allowances, 1)
+				s.Require().Equal(uint64(1), out.PageResponse.Total)
+				s.Require().Equal(s.keyring.GetAddr(2), out.Allowances[0].Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Allowances[0].Grantee)
+				s.Require().Equal(periodicAllowanceTypeURL, out.Allowances[0].AllowanceType)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.setupAllowances(ctx)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.AllowancesByGranter(ctx, &method, contract, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out feegrant.AllowancesOutput
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz)
+			s.Require().NoError(err)
+			tc.postCheck(&out)
+		})
+	}
+}
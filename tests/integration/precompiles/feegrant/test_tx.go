@@ -0,0 +1,383 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	var (
+		ctx     sdk.Context
+		granter common.Address
+		grantee common.Address
+	)
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, grantee, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{granter, common.Address{}, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, []cmn.Coin{}, int64(-1), []string{}}
+			},
+			func() {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granter, grantee, []cmn.Coin{}, ctx.BlockTime().Add(-time.Hour).Unix(), []string{}}
+			},
+			func() {},
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - empty allowed message",
+			func() []interface{} {
+				return []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{""}}
+			},
+			func() {},
+			true,
+			"invalid allowed messages",
+		},
+		{
+			"fail - granter is the grantee",
+			func() []interface{} {
+				return []interface{}{granter, granter, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"success - grant without spend limit nor expiration",
+			func() []interface{} {
+				return []interface{}{granter, grantee, []cmn.Coin{}, int64(0), []string{}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().True(basic.SpendLimit.IsZero())
+				s.Require().Nil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - grant with spend limit, expiration and allowed messages",
+			func() []interface{} {
+				spendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+				return []interface{}{granter, grantee, spendLimit, ctx.BlockTime().Add(time.Hour).Unix(), []string{sendMsgTypeURL}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+				s.Require().NoError(err)
+				allowedMsgAllowance, ok := allowance.(*sdkfeegrant.AllowedMsgAllowance)
+				s.Require().True(ok)
+				s.Require().Equal([]string{sendMsgTypeURL}, allowedMsgAllowance.AllowedMessages)
+
+				inner, err := allowedMsgAllowance.GetAllowance()
+				s.Require().NoError(err)
+				basic, ok := inner.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1e18))), basic.SpendLimit)
+				s.Require().NotNil(basic.Expiration)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), basic.Expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = utiltx.GenerateAddress()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GrantAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event feegrant.EventGrantAllowance
+			err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeGrantAllowance, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(granter, event.Granter)
+			s.Require().Equal(grantee, event.Grantee)
+
+			tc.postCheck()
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	var (
+		ctx     sdk.Context
+		granter common.Address
+		grantee common.Address
+	)
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+	coins := func(amount int64) []cmn.Coin {
+		return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(amount)}}
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), grantee, coins(1000), int64(0), int64(3600), coins(100), []string{}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - zero period",
+			func() []interface{} {
+				return []interface{}{granter, grantee, coins(1000), int64(0), int64(0), coins(100), []string{}}
+			},
+			func() {},
+			true,
+			"invalid period",
+		},
+		{
+			"fail - period spend limit in a different denom than the spend limit",
+			func() []interface{} {
+				periodSpendLimit := []cmn.Coin{{Denom: "other", Amount: big.NewInt(100)}}
+				return []interface{}{granter, grantee, coins(1000), int64(0), int64(3600), periodSpendLimit, []string{}}
+			},
+			func() {},
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"success - grant periodic allowance",
+			func() []interface{} {
+				return []interface{}{granter, grantee, coins(1000), int64(0), int64(3600), coins(100), []string{}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+				s.Require().NoError(err)
+				periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+				s.Require().True(ok)
+				expPeriodSpendLimit := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100)))
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(expPeriodSpendLimit, periodic.PeriodSpendLimit)
+				s.Require().Equal(expPeriodSpendLimit, periodic.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = utiltx.GenerateAddress()
+			stateDB := s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.GrantPeriodicAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event feegrant.EventGrantAllowance
+			err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeGrantAllowance, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(granter, event.Granter)
+			s.Require().Equal(grantee, event.Grantee)
+
+			tc.postCheck()
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var (
+		ctx     sdk.Context
+		granter common.Address
+		grantee common.Address
+	)
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - granter is not the caller",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), grantee}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{granter, utiltx.GenerateAddress()}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - revoke allowance",
+			func() []interface{} {
+				return []interface{}{granter, grantee}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			granter = s.keyring.GetAddr(0)
+			grantee = s.keyring.GetAddr(1)
+			stateDB := s.network.GetStateDB()
+			s.grantAllowance(ctx, granter, grantee, &sdkfeegrant.BasicAllowance{})
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event feegrant.EventRevokeAllowance
+			err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeRevokeAllowance, *logs[0])
+			s.Require().NoError(err)
+			s.Require().Equal(granter, event.Granter)
+			s.Require().Equal(grantee, event.Grantee)
+
+			_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.Bytes(), grantee.Bytes())
+			s.Require().ErrorContains(err, "fee-grant not found")
+		})
+	}
+}
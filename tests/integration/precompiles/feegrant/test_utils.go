@@ -0,0 +1,16 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// grantAllowance is a helper function to store a fee allowance from the
+// granter to the grantee directly in the feegrant keeper.
+func (s *PrecompileTestSuite) grantAllowance(ctx sdk.Context, granter, grantee common.Address, allowance feegrant.FeeAllowanceI) {
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, granter.Bytes(), grantee.Bytes(), allowance)
+	s.Require().NoError(err)
+}
@@ -217,6 +217,50 @@ func (s *TestSuite) TestGetProof() {
 				},
 			},
 		},
+		{
+			"pass - Merkle-Patricia storage proofs",
+			address1,
+			[]string{"0x0"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				s.backend.Cfg.JSONRPC.EnableStorageProofs = true
+				height := bn.Int64()
+				s.backend.Ctx = rpctypes.ContextWithHeight(height)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterHeader(client, &height, nil)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, addr, height)
+				RegisterStorageProof(queryClient, addr, []string{"0x0"}, height, &evmtypes.QueryStorageProofResponse{
+					StorageHash: common.HexToHash("0x1234").Hex(),
+					Proofs: []evmtypes.StorageKeyProof{
+						{Key: "0x0", Value: common.HexToHash("0x2a").Hex(), Proof: [][]byte{{0xc0}, {0x01, 0x02}}},
+					},
+				})
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/acc/key",
+					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
+					cmtrpcclient.ABCIQueryOptions{Height: bn.Int64(), Prove: true},
+				)
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{""},
+				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:     common.HexToHash(""),
+				Nonce:        0x0,
+				StorageHash:  common.HexToHash("0x1234"),
+				StorageProof: []rpctypes.StorageResult{
+					{
+						Key:   "0x0",
+						Value: (*hexutil.Big)(big.NewInt(0x2a)),
+						Proof: []string{"0xc0", "0x0102"},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
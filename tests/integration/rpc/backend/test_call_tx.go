@@ -12,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"google.golang.org/grpc/metadata"
 
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
@@ -468,7 +470,7 @@ func (s *TestSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				s.Require().Equal(tc.expEthTx, msgEthTx)
@@ -479,6 +481,148 @@ func (s *TestSuite) TestDoCall() {
 	}
 }
 
+func (s *TestSuite) TestSimulateV1() {
+	from := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	feeRecipient := utiltx.GenerateAddress()
+	gas := hexutil.Uint64(21000)
+	nonce := hexutil.Uint64(0)
+	callArgs := evmtypes.TransactionArgs{
+		From:    &from,
+		To:      &toAddr,
+		Gas:     &gas,
+		Nonce:   &nonce,
+		Value:   (*hexutil.Big)(big.NewInt(1)),
+		ChainID: (*hexutil.Big)(s.backend.EvmChainID),
+	}
+	opts := evmtypes.SimOpts{
+		BlockStateCalls: []evmtypes.SimBlock{{Calls: []evmtypes.TransactionArgs{callArgs}}},
+	}
+	request := func() *evmtypes.QuerySimulateV1Request {
+		optsBz, err := json.Marshal(&opts)
+		s.Require().NoError(err)
+		return &evmtypes.QuerySimulateV1Request{Opts: optsBz, ChainId: s.backend.EvmChainID.Int64()}
+	}
+
+	transferLog := evmtypes.NewTransferLog(from, toAddr, big.NewInt(1))
+	simBlocks := []evmtypes.SimBlockResult{
+		{
+			Number:       2,
+			Time:         1000,
+			GasLimit:     30_000_000,
+			GasUsed:      21000,
+			FeeRecipient: feeRecipient,
+			BaseFee:      (*hexutil.Big)(big.NewInt(0)),
+			Transactions: []evmtypes.TransactionArgs{callArgs},
+			Calls: []evmtypes.SimCallResult{
+				{
+					ReturnValue: hexutil.Bytes{},
+					Logs:        []*ethtypes.Log{transferLog},
+					GasUsed:     21000,
+					Status:      1,
+				},
+			},
+		},
+	}
+	simBlocksBz, err := json.Marshal(simBlocks)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func() *cmtrpctypes.ResultBlock
+		fullTx       bool
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() *cmtrpctypes.ResultBlock {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+				return nil
+			},
+			false,
+			false,
+		},
+		{
+			"fail - simulation error",
+			func() *cmtrpctypes.ResultBlock {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				resBlock, _ := RegisterBlock(client, 1, nil)
+				RegisterSimulateV1Error(QueryClient, request())
+				return resBlock
+			},
+			false,
+			false,
+		},
+		{
+			"pass - synthetic block with transaction hashes",
+			func() *cmtrpctypes.ResultBlock {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				resBlock, _ := RegisterBlock(client, 1, nil)
+				resBlock.Block.ValidatorsHash = common.Hash{0x01}.Bytes()
+				RegisterSimulateV1(QueryClient, request(), simBlocksBz)
+				return resBlock
+			},
+			false,
+			true,
+		},
+		{
+			"pass - synthetic block with full transactions",
+			func() *cmtrpctypes.ResultBlock {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				resBlock, _ := RegisterBlock(client, 1, nil)
+				resBlock.Block.ValidatorsHash = common.Hash{0x01}.Bytes()
+				RegisterSimulateV1(QueryClient, request(), simBlocksBz)
+				return resBlock
+			},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			opts.ReturnFullTransactions = tc.fullTx
+			resBlock := tc.registerMock()
+
+			blocks, err := s.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(blocks, 1)
+
+			block := blocks[0]
+			txHash := callArgs.ToTransaction().AsTransaction().Hash()
+			s.Require().Equal(hexutil.Uint64(2), block["number"])
+			s.Require().Equal(hexutil.Uint64(1000), block["timestamp"])
+			s.Require().Equal(feeRecipient, block["miner"])
+			s.Require().Equal(common.BytesToHash(resBlock.Block.Header.Hash()), block["parentHash"])
+			s.Require().NotEqual(ethtypes.EmptyRootHash, block["transactionsRoot"])
+
+			txs := block["transactions"].([]interface{})
+			s.Require().Len(txs, 1)
+			if tc.fullTx {
+				rpcTx := txs[0].(*rpctypes.RPCTransaction)
+				s.Require().Equal(txHash, rpcTx.Hash)
+				s.Require().Equal(from, rpcTx.From)
+			} else {
+				s.Require().Equal(txHash, txs[0])
+			}
+
+			calls := block["calls"].([]evmtypes.SimCallResult)
+			s.Require().Len(calls, 1)
+			s.Require().Equal(common.BytesToHash(block["hash"].(hexutil.Bytes)), calls[0].Logs[0].BlockHash)
+			s.Require().True(ethtypes.BloomLookup(block["logsBloom"].(ethtypes.Bloom), evmtypes.TransferLogAddress))
+		})
+	}
+}
+
 func (s *TestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 	height := int64(1)
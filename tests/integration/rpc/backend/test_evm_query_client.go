@@ -67,6 +67,30 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// IntermediateRoots
+func RegisterIntermediateRoots(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, roots [][]byte) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1),
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1}). //nolint:gosec // G115
+		Return(&evmtypes.QueryIntermediateRootsResponse{Roots: roots}, nil)
+}
+
+func RegisterIntermediateRootsError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(1), mock.AnythingOfType("*types.QueryTraceBlockRequest")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -154,6 +178,17 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateV1Request, data []byte) {
+	queryClient.On("SimulateV1", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(&evmtypes.QuerySimulateV1Response{Data: data}, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateV1Request) {
+	queryClient.On("SimulateV1", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -247,6 +282,11 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageProof(queryClient *mocks.EVMQueryClient, addr common.Address, keys []string, height int64, res *evmtypes.QueryStorageProofResponse) {
+	queryClient.On("StorageProof", rpc.ContextWithHeight(height), &evmtypes.QueryStorageProofRequest{Address: addr.String(), Keys: keys}).
+		Return(res, nil)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
@@ -1,9 +1,12 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	mock "github.com/stretchr/testify/mock"
 
@@ -15,6 +18,8 @@ import (
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -298,3 +303,166 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestIntermediateRoots() {
+	msgEthTx, bz := s.buildEthereumTx()
+	emptyBlock := types.MakeBlock(1, []types.Tx{}, nil, nil)
+	emptyBlock.ChainID = ChainID.ChainID
+	filledBlock := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	filledBlock.ChainID = ChainID.ChainID
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+	root := common.HexToHash("0x1234")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		resBlock     *tmrpctypes.ResultBlock
+		expRoots     []common.Hash
+		expPass      bool
+	}{
+		{
+			"pass - no transaction returning empty array",
+			func() {},
+			&resBlockEmpty,
+			[]common.Hash{},
+			true,
+		},
+		{
+			"fail - TendermintBlockResultByNumber returns error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockResultsError(client, 1)
+			},
+			&resBlockFilled,
+			nil,
+			false,
+		},
+		{
+			"fail - IntermediateRoots query returns error",
+			func() {
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterIntermediateRootsError(queryClient)
+				RegisterConsensusParams(client, 1)
+				_, err := RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+			},
+			&resBlockFilled,
+			nil,
+			false,
+		},
+		{
+			"pass - returns the roots of the transactions",
+			func() {
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterIntermediateRoots(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, [][]byte{root.Bytes()})
+				RegisterConsensusParams(client, 1)
+				_, err := RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+			},
+			&resBlockFilled,
+			[]common.Hash{root},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			roots, err := s.backend.IntermediateRoots(1, tc.resBlock)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expRoots, roots)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestTraceCall() {
+	_, bz := s.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	s.Require().NoError(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1))
+	overrides := evmtypes.StateOverride{toAddr: {Balance: &balance}}
+	overridesBz, err := json.Marshal(overrides)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *evmtypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - header not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				height := int64(1)
+				RegisterHeaderError(client, &height)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - query error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace config and state overrides",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					ChainId:     s.backend.EvmChainID.Int64(),
+					TraceConfig: &evmtypes.TraceConfig{Tracer: "callTracer"},
+					Overrides:   overridesBz,
+				})
+			},
+			&evmtypes.TraceCallConfig{
+				TraceConfig:    evmtypes.TraceConfig{Tracer: "callTracer"},
+				StateOverrides: &overrides,
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := s.backend.TraceCall(callArgs, rpctypes.BlockNumber(1), tc.config)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// mockTxPool is a TxPool returning fixed txs.
+type mockTxPool struct {
+	pending, queued map[common.Address][]*evmtypes.MsgEthereumTx
+	err             error
+}
+
+func (p *mockTxPool) TxPoolContent() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error) {
+	return p.pending, p.queued, p.err
+}
+
+// buildSignedEthereumMsgWithNonce returns a legacy Ethereum transaction with
+// the given nonce and gas price, signed by the suite sender.
+func (s *TestSuite) buildSignedEthereumMsgWithNonce(nonce uint64, gasPrice int64) *evmtypes.MsgEthereumTx {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  s.backend.EvmChainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(1),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(gasPrice),
+	})
+	msgEthereumTx.From = s.from.Bytes()
+
+	ethSigner := ethtypes.LatestSigner(s.backend.ChainConfig())
+	err := msgEthereumTx.Sign(ethSigner, s.signer)
+	s.Require().NoError(err)
+	return msgEthereumTx
+}
+
+// msgsWithNonces returns a signed tx of the suite sender for each of the
+// given nonces.
+func (s *TestSuite) msgsWithNonces(nonces ...uint64) []*evmtypes.MsgEthereumTx {
+	msgs := make([]*evmtypes.MsgEthereumTx, 0, len(nonces))
+	for _, nonce := range nonces {
+		msgs = append(msgs, s.buildSignedEthereumMsgWithNonce(nonce, 1))
+	}
+	return msgs
+}
+
+func (s *TestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name       string
+		txPool     func() *mockTxPool
+		expPending []string
+		expQueued  []string
+		expPass    bool
+	}{
+		{
+			"fail - no app mempool",
+			func() *mockTxPool { return nil },
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - app mempool error",
+			func() *mockTxPool { return &mockTxPool{err: errors.New("mempool error")} },
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() *mockTxPool { return &mockTxPool{} },
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - pending and queued txs",
+			func() *mockTxPool {
+				return &mockTxPool{
+					pending: map[common.Address][]*evmtypes.MsgEthereumTx{s.from: s.msgsWithNonces(1, 2)},
+					queued:  map[common.Address][]*evmtypes.MsgEthereumTx{s.from: s.msgsWithNonces(4, 5)},
+				}
+			},
+			[]string{"1", "2"},
+			[]string{"4", "5"},
+			true,
+		},
+		{
+			"pass - the txs of other senders are not returned",
+			func() *mockTxPool {
+				return &mockTxPool{
+					pending: map[common.Address][]*evmtypes.MsgEthereumTx{
+						s.from:                   s.msgsWithNonces(1),
+						utiltx.GenerateAddress(): s.msgsWithNonces(7),
+					},
+				}
+			},
+			[]string{"1"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset
+			if txPool := tc.txPool(); txPool != nil {
+				s.backend.TxPool = txPool
+			}
+
+			content, err := s.backend.ContentFrom(s.from)
+			if !tc.expPass {
+				s.Require().Error(err)
+				_, err = s.backend.Status()
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(content["pending"], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				s.Require().Contains(content["pending"], nonce)
+				s.Require().Equal(s.from, content["pending"][nonce].From)
+			}
+			s.Require().Len(content["queued"], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				s.Require().Contains(content["queued"], nonce)
+			}
+
+			inspect, err := s.backend.Inspect()
+			s.Require().NoError(err)
+			for _, nonce := range tc.expPending {
+				s.Require().Contains(inspect["pending"][s.from.Hex()][nonce], "1 wei + 21000 gas × 1 wei")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestTxPoolStatus() {
+	// all the txs of the app mempool are covered, beyond the 100 txs
+	// returned by the CometBFT unconfirmed txs
+	other := utiltx.GenerateAddress()
+	otherNonces := make([]uint64, 150)
+	for i := range otherNonces {
+		otherNonces[i] = uint64(i)
+	}
+	s.backend.TxPool = &mockTxPool{
+		pending: map[common.Address][]*evmtypes.MsgEthereumTx{
+			s.from: s.msgsWithNonces(1, 2),
+			other:  s.msgsWithNonces(otherNonces...),
+		},
+		queued: map[common.Address][]*evmtypes.MsgEthereumTx{s.from: s.msgsWithNonces(4)},
+	}
+
+	status, err := s.backend.Status()
+	s.Require().NoError(err)
+	s.Require().Equal(hexutil.Uint(152), status["pending"])
+	s.Require().Equal(hexutil.Uint(1), status["queued"])
+
+	content, err := s.backend.Content()
+	s.Require().NoError(err)
+	s.Require().Len(content["pending"][s.from.Hex()], 2)
+	s.Require().Len(content["pending"][other.Hex()], 150)
+	s.Require().Len(content["queued"][s.from.Hex()], 1)
+}
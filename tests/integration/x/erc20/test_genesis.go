@@ -1,6 +1,7 @@
 package erc20
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/testutil/integration/evm/network"
@@ -108,6 +109,27 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 				},
 			),
 		},
+		{
+			name: "custom genesis with nonces and used authorizations",
+			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  osmoERC20ContractAddr,
+						Denom:         osmoDenom.IBCDenom(),
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+				Allowances: []types.Allowance{},
+				Nonces: []types.Nonce{
+					types.NewNonce(common.HexToAddress(osmoERC20ContractAddr), utiltx.GenerateAddress(), 3),
+				},
+				UsedAuthorizations: []types.UsedAuthorization{
+					types.NewUsedAuthorization(common.HexToAddress(osmoERC20ContractAddr), utiltx.GenerateAddress(), common.HexToHash("0x01")),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -136,6 +158,20 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			s.Require().Len(tc.genesisState.Allowances, 0, tc.name)
 		}
+
+		nonces := nw.App.GetErc20Keeper().GetNonces(nw.GetContext())
+		if len(nonces) > 0 {
+			s.Require().Equal(tc.genesisState.Nonces, nonces, tc.name)
+		} else {
+			s.Require().Len(tc.genesisState.Nonces, 0, tc.name)
+		}
+
+		usedAuthorizations := nw.App.GetErc20Keeper().GetUsedAuthorizations(nw.GetContext())
+		if len(usedAuthorizations) > 0 {
+			s.Require().Equal(tc.genesisState.UsedAuthorizations, usedAuthorizations, tc.name)
+		} else {
+			s.Require().Len(tc.genesisState.UsedAuthorizations, 0, tc.name)
+		}
 	}
 }
 
@@ -132,3 +132,58 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryFeeTokens() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	feeTokens := []types.FeeToken{
+		types.NewFeeToken("uatom", sdkmath.LegacyNewDecWithPrec(5, 1), ""),
+		types.NewFeeToken("uusdc", sdkmath.LegacyNewDec(5), ""),
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expRes   []types.FeeToken
+	}{
+		{
+			"pass - no fee tokens",
+			func() {},
+			nil,
+		},
+		{
+			"pass - registered fee tokens",
+			func() {
+				for _, feeToken := range feeTokens {
+					nw.App.GetFeeMarketKeeper().SetFeeToken(ctx, feeToken)
+				}
+			},
+			feeTokens,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+
+			tc.malleate()
+
+			res, err := qc.FeeTokens(ctx.Context(), &types.QueryFeeTokensRequest{})
+			s.Require().NoError(err)
+			s.Equal(tc.expRes, res.FeeTokens)
+
+			for _, feeToken := range tc.expRes {
+				res, err := qc.FeeToken(ctx.Context(), &types.QueryFeeTokenRequest{Denom: feeToken.Denom})
+				s.Require().NoError(err)
+				s.Equal(feeToken, res.FeeToken)
+			}
+
+			_, err = qc.FeeToken(ctx.Context(), &types.QueryFeeTokenRequest{Denom: "unknown"})
+			s.Error(err)
+		})
+	}
+}
@@ -3,6 +3,9 @@ package feemarket
 import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -50,3 +53,180 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRegisterFeeToken() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgRegisterFeeToken
+		expectErr bool
+	}{
+		{
+			name: "fail - invalid authority",
+			request: &types.MsgRegisterFeeToken{
+				Authority: "foobar",
+				FeeToken:  types.NewFeeToken("uusdc", math.LegacyNewDec(5), ""),
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid conversion rate",
+			request: &types.MsgRegisterFeeToken{
+				Authority: authority,
+				FeeToken:  types.NewFeeToken("uusdc", math.LegacyZeroDec(), ""),
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - EVM coin",
+			request: &types.MsgRegisterFeeToken{
+				Authority: authority,
+				FeeToken:  types.NewFeeToken(evmtypes.GetEVMCoinDenom(), math.LegacyOneDec(), ""),
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid fee token",
+			request: &types.MsgRegisterFeeToken{
+				Authority: authority,
+				FeeToken:  types.NewFeeToken("uusdc", math.LegacyNewDec(5), ""),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			_, err := nw.App.GetFeeMarketKeeper().RegisterFeeToken(ctx, tc.request)
+			feeToken, found := nw.App.GetFeeMarketKeeper().GetFeeToken(ctx, tc.request.FeeToken.Denom)
+			if tc.expectErr {
+				s.Error(err)
+				s.False(found)
+			} else {
+				s.NoError(err)
+				s.True(found)
+				s.Equal(tc.request.FeeToken, feeToken)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveFeeToken() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgRemoveFeeToken
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgRemoveFeeToken{Authority: "foobar", Denom: "uusdc"},
+			expectErr: true,
+		},
+		{
+			name:      "fail - fee token not found",
+			request:   &types.MsgRemoveFeeToken{Authority: authority, Denom: "uatom"},
+			expectErr: true,
+		},
+		{
+			name:      "pass - remove fee token",
+			request:   &types.MsgRemoveFeeToken{Authority: authority, Denom: "uusdc"},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			nw.App.GetFeeMarketKeeper().SetFeeToken(ctx, types.NewFeeToken("uusdc", math.LegacyNewDec(5), ""))
+
+			_, err := nw.App.GetFeeMarketKeeper().RemoveFeeToken(ctx, tc.request)
+			_, found := nw.App.GetFeeMarketKeeper().GetFeeToken(ctx, "uusdc")
+			if tc.expectErr {
+				s.Error(err)
+				s.True(found)
+			} else {
+				s.NoError(err)
+				s.False(found)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeTokenRate() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	rateUpdater := sdk.AccAddress("rate_updater").String()
+	rate := math.LegacyNewDecWithPrec(55, 1)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateFeeTokenRate
+		expectErr bool
+	}{
+		{
+			name:      "fail - fee token not found",
+			request:   &types.MsgUpdateFeeTokenRate{Sender: authority, Denom: "uatom", ConversionRate: rate},
+			expectErr: true,
+		},
+		{
+			name:      "fail - sender is not allowed",
+			request:   &types.MsgUpdateFeeTokenRate{Sender: sdk.AccAddress("foobar").String(), Denom: "uusdc", ConversionRate: rate},
+			expectErr: true,
+		},
+		{
+			name:      "fail - invalid conversion rate",
+			request:   &types.MsgUpdateFeeTokenRate{Sender: authority, Denom: "uusdc", ConversionRate: math.LegacyZeroDec()},
+			expectErr: true,
+		},
+		{
+			name:      "pass - authority",
+			request:   &types.MsgUpdateFeeTokenRate{Sender: authority, Denom: "uusdc", ConversionRate: rate},
+			expectErr: false,
+		},
+		{
+			name:      "pass - rate updater",
+			request:   &types.MsgUpdateFeeTokenRate{Sender: rateUpdater, Denom: "uusdc", ConversionRate: rate},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			nw.App.GetFeeMarketKeeper().SetFeeToken(ctx, types.NewFeeToken("uusdc", math.LegacyNewDec(5), rateUpdater))
+
+			_, err := nw.App.GetFeeMarketKeeper().UpdateFeeTokenRate(ctx, tc.request)
+			feeToken, found := nw.App.GetFeeMarketKeeper().GetFeeToken(ctx, "uusdc")
+			s.True(found)
+			if tc.expectErr {
+				s.Error(err)
+				s.Equal(math.LegacyNewDec(5), feeToken.ConversionRate)
+			} else {
+				s.NoError(err)
+				s.Equal(rate, feeToken.ConversionRate)
+			}
+		})
+	}
+}
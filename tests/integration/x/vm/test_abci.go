@@ -1,6 +1,14 @@
 package vm
 
 import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -24,3 +32,72 @@ func (s *KeeperTestSuite) TestEndBlock() {
 	s.Require().Equal(1, len(postEventManager.Events()))
 	s.Require().Equal(evmtypes.EventTypeBlockBloom, postEventManager.Events()[0].Type)
 }
+
+func (s *KeeperTestSuite) TestStoreBlockHash() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	keeper := s.Network.App.GetEVMKeeper()
+	hash := common.BytesToHash(tmhash.Sum([]byte("block")))
+
+	// the history storage contract is deployed at its canonical address at genesis
+	start, enabled := keeper.GetHistoryStorageStart(ctx)
+	s.Require().True(enabled)
+	codeHash := keeper.GetCodeHash(ctx, params.HistoryStorageAddress)
+	s.Require().Equal(params.HistoryStorageCode, keeper.GetCode(ctx, codeHash))
+
+	keeper.StoreBlockHash(ctx.WithHeaderHash(hash.Bytes()))
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the blocks preceding the activation are not served
+	keeper.SetState(ctx, params.HistoryStorageAddress, common.BigToHash(new(big.Int).SetUint64(start-1)), hash.Bytes())
+	s.Require().Equal(common.Hash{}, keeper.GetHistoricalBlockHash(nextCtx, start-1))
+
+	s.Require().Equal(hash, keeper.GetHistoricalBlockHash(nextCtx, height))
+	s.Require().Equal(hash, keeper.GetHashFn(nextCtx)(height))
+	s.Require().Equal(common.Hash{}, keeper.GetHistoricalBlockHash(nextCtx, height+1))
+
+	// the contract serves the hash to the next blocks
+	sender := s.Keyring.GetAddr(0)
+	input := common.BigToHash(new(big.Int).SetUint64(height)).Bytes()
+	res, err := keeper.CallEVMWithData(nextCtx, sender, &params.HistoryStorageAddress, input, false, nil)
+	s.Require().NoError(err)
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Equal(hash.Bytes(), res.Ret)
+
+	// the contract reverts for blocks out of the serve window
+	_, err = keeper.CallEVMWithData(ctx, sender, &params.HistoryStorageAddress, input, false, nil)
+	s.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+
+	// the hash is stored by the end blocker
+	s.Require().NoError(s.Network.NextBlock())
+	ctx = s.Network.GetContext()
+	stored := keeper.GetHistoricalBlockHash(ctx, uint64(ctx.BlockHeight()-1)) //nolint:gosec // G115
+	s.Require().NotEqual(common.Hash{}, stored)
+}
+
+func (s *KeeperTestSuite) TestEnableHistoryStorage() {
+	s.SetupTest()
+	// a block whose hash was not stored yet
+	ctx := s.Network.GetContext()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	keeper := s.Network.App.GetEVMKeeper()
+	hash := common.BytesToHash(tmhash.Sum([]byte("block")))
+
+	// existing chains don't store the block hashes until it is enabled
+	ctx.KVStore(s.Network.App.GetKey(evmtypes.StoreKey)).Delete(evmtypes.KeyHistoryStorageStart)
+	keeper.StoreBlockHash(ctx.WithHeaderHash(hash.Bytes()))
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.Require().Equal(common.Hash{}, keeper.GetState(ctx, params.HistoryStorageAddress, common.BigToHash(new(big.Int).SetUint64(height))))
+	s.Require().Equal(common.Hash{}, keeper.GetHistoricalBlockHash(nextCtx, height))
+
+	keeper.EnableHistoryStorage(ctx)
+	start, enabled := keeper.GetHistoryStorageStart(ctx)
+	s.Require().True(enabled)
+	s.Require().Equal(height, start)
+
+	keeper.StoreBlockHash(ctx.WithHeaderHash(hash.Bytes()))
+	s.Require().Equal(hash, keeper.GetHistoricalBlockHash(nextCtx, height))
+}
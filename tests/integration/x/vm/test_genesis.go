@@ -221,8 +221,8 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 	s.Require().NoError(s.network.NextBlock())
 
 	genState := vm.ExportGenesis(s.network.GetContext(), s.network.App.GetEVMKeeper())
-	// Exported accounts 4 default preinstalls
-	s.Require().Len(genState.Accounts, 7)
+	// Exported accounts 4 default preinstalls and the history storage contract
+	s.Require().Len(genState.Accounts, 8)
 
 	addrs := make([]string, len(genState.Accounts))
 	for i, acct := range genState.Accounts {
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -16,6 +18,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/evm/precompiles/staking"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -320,6 +323,117 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageProof() {
+	const maxSlots = 10
+
+	testCases := []struct {
+		msg     string
+		getReq  func() (*types.QueryStorageProofRequest, map[common.Hash]common.Hash)
+		expPass bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryStorageProofRequest, map[common.Hash]common.Hash) {
+				return &types.QueryStorageProofRequest{Address: invalidAddress}, nil
+			},
+			false,
+		},
+		{
+			"success - empty storage",
+			func() (*types.QueryStorageProofRequest, map[common.Hash]common.Hash) {
+				addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+				key := common.BytesToHash([]byte("key"))
+				return &types.QueryStorageProofRequest{
+					Address: addr.String(),
+					Keys:    []string{key.Hex()},
+				}, map[common.Hash]common.Hash{key: {}}
+			},
+			true,
+		},
+		{
+			"success - existing and missing keys",
+			func() (*types.QueryStorageProofRequest, map[common.Hash]common.Hash) {
+				addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+				storage := map[common.Hash]common.Hash{
+					common.BytesToHash([]byte("key1")): common.BytesToHash([]byte("value1")),
+					common.BytesToHash([]byte("key2")): common.BytesToHash([]byte("value2")),
+				}
+				for key, value := range storage {
+					s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, value.Bytes())
+				}
+				missing := common.BytesToHash([]byte("missing"))
+				storage[missing] = common.Hash{}
+
+				keys := make([]string, 0, len(storage))
+				for key := range storage {
+					keys = append(keys, key.Hex())
+				}
+				return &types.QueryStorageProofRequest{Address: addr.String(), Keys: keys}, storage
+			},
+			true,
+		},
+		{
+			"fail - storage exceeding the max slots",
+			func() (*types.QueryStorageProofRequest, map[common.Hash]common.Hash) {
+				addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+				for i := 0; i <= maxSlots; i++ {
+					key := common.BigToHash(big.NewInt(int64(i)))
+					s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, common.BigToHash(big.NewInt(1)).Bytes())
+				}
+				return &types.QueryStorageProofRequest{Address: addr.String()}, nil
+			},
+			false,
+		},
+		{
+			"fail - too many storage keys",
+			func() (*types.QueryStorageProofRequest, map[common.Hash]common.Hash) {
+				addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+				keys := make([]string, maxSlots+1)
+				for i := range keys {
+					keys[i] = common.BigToHash(big.NewInt(int64(i))).Hex()
+				}
+				return &types.QueryStorageProofRequest{Address: addr.String(), Keys: keys}, nil
+			},
+			false,
+		},
+	}
+
+	// the storage proofs are disabled by default
+	addr := s.Keyring.GetAddr(0)
+	_, err := s.Network.GetEvmClient().StorageProof(s.Network.GetContext(), &types.QueryStorageProofRequest{Address: addr.String()})
+	s.Require().Error(err)
+
+	s.Network.App.GetEVMKeeper().WithStorageProofs(maxSlots)
+	defer s.Network.App.GetEVMKeeper().WithStorageProofs(0)
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req, expStorage := tc.getReq()
+
+			res, err := s.Network.GetEvmClient().StorageProof(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(res.Proofs, len(req.Keys))
+
+			storageHash := common.HexToHash(res.StorageHash)
+			if len(expStorage) == 1 {
+				s.Require().Equal(ethtypes.EmptyRootHash, storageHash)
+			}
+			for _, proof := range res.Proofs {
+				key := common.HexToHash(proof.Key)
+				s.Require().Equal(expStorage[key].Hex(), proof.Value)
+
+				value, err := types.VerifyStorageProof(storageHash, key, proof.Proof)
+				s.Require().NoError(err)
+				s.Require().Equal(expStorage[key], value)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -1326,6 +1440,144 @@ func (s *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceCall() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	gas := hexutil.Uint64(100_000)
+	value := (*hexutil.Big)(big.NewInt(1000))
+	// PUSH1 0x2a PUSH1 0x00 SSTORE STOP
+	code := hexutil.Bytes(common.FromHex("0x602a60005500"))
+
+	testCases := []struct {
+		name        string
+		getReq      func() *types.QueryTraceCallRequest
+		expPass     bool
+		expContains []string
+	}{
+		{
+			"fail - invalid args",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - negative limit",
+			func() *types.QueryTraceCallRequest {
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Gas: &gas})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap, TraceConfig: &types.TraceConfig{Limit: -1}}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - invalid state overrides",
+			func() *types.QueryTraceCallRequest {
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Gas: &gas})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: []byte("invalid")}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - default tracer",
+			func() *types.QueryTraceCallRequest {
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Gas: &gas, Value: value})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			},
+			true,
+			[]string{`"failed":false`, `"structLogs":[]`},
+		},
+		{
+			"pass - call tracer",
+			func() *types.QueryTraceCallRequest {
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Gas: &gas, Value: value})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap, TraceConfig: &types.TraceConfig{Tracer: "callTracer"}}
+			},
+			true,
+			[]string{`"type":"CALL"`, `"value":"0x3e8"`, fmt.Sprintf(`"to":"%s"`, strings.ToLower(recipient.Hex()))},
+		},
+		{
+			"pass - code state override",
+			func() *types.QueryTraceCallRequest {
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Gas: &gas})
+				s.Require().NoError(err)
+				overrides, err := json.Marshal(types.StateOverride{recipient: {Code: &code}})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			},
+			true,
+			[]string{`"failed":false`, `"op":"SSTORE"`},
+		},
+		{
+			"pass - block number override",
+			func() *types.QueryTraceCallRequest {
+				// PUSH1 0x00 NUMBER SSTORE STOP
+				numberCode := hexutil.Bytes(common.FromHex("0x60004355"))
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &recipient, Gas: &gas})
+				s.Require().NoError(err)
+				overrides, err := json.Marshal(types.StateOverride{recipient: {Code: &numberCode}})
+				s.Require().NoError(err)
+				number := (*hexutil.Big)(big.NewInt(0x1234))
+				blockOverrides, err := json.Marshal(types.BlockOverrides{Number: number})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{
+					Args:           args,
+					GasCap:         config.DefaultGasCap,
+					Overrides:      overrides,
+					BlockOverrides: blockOverrides,
+				}
+			},
+			true,
+			[]string{`"stack":["0x0","0x1234"]`},
+		},
+		{
+			"pass - precompile tracer annotates the staking precompile call",
+			func() *types.QueryTraceCallRequest {
+				stakingABI, err := staking.LoadABI()
+				s.Require().NoError(err)
+				valAddr := s.Network.GetValidators()[0].OperatorAddress
+				input, err := stakingABI.Pack(staking.DelegateMethod, sender, valAddr, big.NewInt(1000))
+				s.Require().NoError(err)
+				precompileAddr := common.HexToAddress(types.StakingPrecompileAddress)
+				data := hexutil.Bytes(input)
+				precompileGas := hexutil.Uint64(500_000)
+				args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &precompileAddr, Gas: &precompileGas, Input: &data})
+				s.Require().NoError(err)
+				return &types.QueryTraceCallRequest{Args: args, GasCap: config.DefaultGasCap, TraceConfig: &types.TraceConfig{Tracer: "precompileTracer"}}
+			},
+			true,
+			[]string{
+				`"method":"delegate(address,string,uint256)"`,
+				`"@type":"/cosmos.staking.v1beta1.MsgDelegate"`,
+				`"type":"delegate"`,
+				fmt.Sprintf(`"%s":-1000`, strings.ToLower(sender.Hex())),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.Network.GetEvmClient().TraceCall(s.Network.GetContext(), tc.getReq())
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			for _, exp := range tc.expContains {
+				s.Require().Contains(string(res.Data), exp)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestTraceBlock() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1503,6 +1755,65 @@ func (s *KeeperTestSuite) TestTraceBlock() {
 	}
 }
 
+func (s *KeeperTestSuite) TestIntermediateRoots() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	var txs []*types.MsgEthereumTx
+	for range 2 {
+		msg, err := executeTransferCall(
+			transferParams{
+				senderKey:     senderKey,
+				contractAddr:  contractAddr,
+				recipientAddr: tx.GenerateAddress(),
+			},
+			s.Factory,
+		)
+		s.Require().NoError(err)
+		txs = append(txs, msg)
+		s.Require().NoError(s.Network.NextBlock())
+	}
+
+	intermediateRoots := func(txs []*types.MsgEthereumTx) [][]byte {
+		req := getDefaultTraceBlockRequest(s.Network)
+		req.Txs = txs
+		res, err := s.Network.GetEvmClient().IntermediateRoots(s.Network.GetContext(), &req)
+		s.Require().NoError(err)
+		return res.Roots
+	}
+
+	s.Require().Empty(intermediateRoots(nil))
+
+	roots := intermediateRoots(txs)
+	s.Require().Len(roots, 2)
+	s.Require().NotEqual(common.Hash{}.Bytes(), roots[0])
+	s.Require().NotEqual(roots[0], roots[1])
+
+	// the digests are deterministic and only depend on the preceding txs
+	s.Require().Equal(roots, intermediateRoots(txs))
+	s.Require().Equal(roots[:1], intermediateRoots(txs[:1]))
+	s.Require().NotEqual(roots[0], intermediateRoots(txs[1:])[0])
+
+	// a tx that can't be replayed fails the query
+	to := tx.GenerateAddress()
+	unsigned := types.NewTx(&types.EvmTxArgs{
+		ChainID:  types.GetEthChainConfig().ChainID,
+		To:       &to,
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	})
+	req := getDefaultTraceBlockRequest(s.Network)
+	req.Txs = []*types.MsgEthereumTx{txs[0], unsigned}
+	_, err = s.Network.GetEvmClient().IntermediateRoots(s.Network.GetContext(), &req)
+	s.Require().ErrorContains(err, unsigned.AsTransaction().Hash().Hex())
+}
+
 func (s *KeeperTestSuite) TestNonceInQuery() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1778,6 +2089,196 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	var (
+		sender    = s.Keyring.GetAddr(0)
+		recipient = tx.GenerateAddress()
+		logger    = tx.GenerateAddress()
+		reverter  = tx.GenerateAddress()
+		height    = uint64(s.Network.GetContext().BlockHeight()) //nolint:gosec // G115 // won't exceed uint64
+		gas       = hexutil.Uint64(100_000)
+		amount    = (*hexutil.Big)(big.NewInt(1000))
+		// PUSH1 0 PUSH1 0 LOG0
+		loggerCode = hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xa0}
+		// PUSH1 0 PUSH1 0 REVERT
+		reverterCode = hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xfd}
+	)
+
+	transfer := func(to common.Address) types.TransactionArgs {
+		return types.TransactionArgs{From: &sender, To: &to, Value: amount, Gas: &gas}
+	}
+
+	simulate := func(opts types.SimOpts) ([]types.SimBlockResult, error) {
+		bz, err := json.Marshal(opts)
+		s.Require().NoError(err)
+		res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.QuerySimulateV1Request{
+			Opts:   bz,
+			GasCap: config.DefaultGasCap,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var blocks []types.SimBlockResult
+		s.Require().NoError(json.Unmarshal(res.Data, &blocks))
+		return blocks, nil
+	}
+
+	testCases := []struct {
+		name      string
+		opts      types.SimOpts
+		expPass   bool
+		errMsg    string
+		postCheck func(blocks []types.SimBlockResult)
+	}{
+		{
+			"fail - empty input",
+			types.SimOpts{},
+			false,
+			"empty input",
+			nil,
+		},
+		{
+			"fail - block numbers out of order",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height))}},
+			}},
+			false,
+			"block numbers must be in order",
+			nil,
+		},
+		{
+			"fail - invalid nonce with validation",
+			types.SimOpts{
+				Validation: true,
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{func() types.TransactionArgs {
+						args := transfer(recipient)
+						nonce := hexutil.Uint64(100)
+						args.Nonce = &nonce
+						return args
+					}()}},
+				},
+			},
+			false,
+			"invalid nonce",
+			nil,
+		},
+		{
+			"pass - transfers across blocks with transfer logs",
+			types.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{transfer(recipient)}},
+					{Calls: []types.TransactionArgs{transfer(recipient), transfer(recipient)}},
+				},
+			},
+			true,
+			"",
+			func(blocks []types.SimBlockResult) {
+				s.Require().Len(blocks, 2)
+				s.Require().Equal(hexutil.Uint64(height+1), blocks[0].Number)
+				s.Require().Equal(hexutil.Uint64(height+2), blocks[1].Number)
+				s.Require().Greater(blocks[1].Time, blocks[0].Time)
+
+				nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+				s.Require().Equal(nonce, uint64(*blocks[0].Transactions[0].Nonce))
+				s.Require().Equal(nonce+1, uint64(*blocks[1].Transactions[0].Nonce))
+				s.Require().Equal(nonce+2, uint64(*blocks[1].Transactions[1].Nonce))
+
+				for _, block := range blocks {
+					for i, call := range block.Calls {
+						s.Require().Equal(hexutil.Uint64(1), call.Status)
+						s.Require().Nil(call.Error)
+						s.Require().Len(call.Logs, 1)
+						log := call.Logs[0]
+						s.Require().Equal(types.TransferLogAddress, log.Address)
+						s.Require().Equal(types.NewTransferLog(sender, recipient, amount.ToInt()).Topics, log.Topics)
+						s.Require().Equal(common.BigToHash(amount.ToInt()).Bytes(), log.Data)
+						s.Require().Equal(uint(i), log.Index)
+					}
+				}
+				s.Require().Equal(blocks[1].Calls[0].GasUsed+blocks[1].Calls[1].GasUsed, blocks[1].GasUsed)
+
+				// nothing is committed
+				s.Require().Equal(nonce, s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender))
+			},
+		},
+		{
+			"pass - state and block overrides fill the gaps",
+			types.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []types.SimBlock{
+					{
+						BlockOverrides: &types.BlockOverrides{
+							Number:       (*hexutil.Big)(new(big.Int).SetUint64(height + 3)),
+							FeeRecipient: &recipient,
+						},
+						StateOverrides: types.StateOverride{logger: {Code: &loggerCode}},
+						Calls:          []types.TransactionArgs{transfer(logger)},
+					},
+				},
+			},
+			true,
+			"",
+			func(blocks []types.SimBlockResult) {
+				s.Require().Len(blocks, 3)
+				s.Require().Empty(blocks[0].Calls)
+				s.Require().Empty(blocks[1].Calls)
+				s.Require().Equal(hexutil.Uint64(height+3), blocks[2].Number)
+				s.Require().Equal(recipient, blocks[2].FeeRecipient)
+
+				// the value transfer is logged before the logs of the callee
+				logs := blocks[2].Calls[0].Logs
+				s.Require().Len(logs, 2)
+				s.Require().Equal(types.TransferLogAddress, logs[0].Address)
+				s.Require().Equal(logger, logs[1].Address)
+			},
+		},
+		{
+			"pass - reverted call",
+			types.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{reverter: {Code: &reverterCode}},
+						Calls:          []types.TransactionArgs{transfer(reverter), transfer(recipient)},
+					},
+				},
+			},
+			true,
+			"",
+			func(blocks []types.SimBlockResult) {
+				s.Require().Len(blocks, 1)
+				reverted := blocks[0].Calls[0]
+				s.Require().Equal(hexutil.Uint64(0), reverted.Status)
+				s.Require().Empty(reverted.Logs)
+				s.Require().NotNil(reverted.Error)
+				s.Require().Equal(types.SimErrCodeReverted, reverted.Error.Code)
+				s.Require().Contains(reverted.Error.Message, vm.ErrExecutionReverted.Error())
+
+				// the following calls are still executed
+				s.Require().Equal(hexutil.Uint64(1), blocks[0].Calls[1].Status)
+				s.Require().Equal(uint(0), blocks[0].Calls[1].Logs[0].Index)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			blocks, err := simulate(tc.opts)
+			if !tc.expPass {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			tc.postCheck(blocks)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -1975,6 +2476,12 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -9,11 +9,13 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/testutil/config"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
@@ -84,6 +86,8 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 	header := s.Network.GetContext().BlockHeader()
 	h, _ := cmttypes.HeaderFromProto(&header)
 	hash := h.Hash()
+	// blocks out of the history storage window are retrieved from the staking historical info
+	outOfHistoryWindow := int64(1 + types.HistoryServeWindow)
 
 	testCases := []struct {
 		msg      string
@@ -106,7 +110,8 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 			uint64(s.Network.GetContext().BlockHeight()), //nolint:gosec // G115
 			func() sdk.Context {
 				header := tmproto.Header{}
-				header.Height = s.Network.GetContext().BlockHeight()
+				// use the requested height, as the previous blocks are served from the history storage
+				header.Height = h.Height
 				return s.Network.GetContext().WithBlockHeader(header)
 			},
 			common.Hash{},
@@ -120,31 +125,41 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 			common.BytesToHash(hash),
 		},
 		{
-			"case 2.1: height lower than current one, hist info not found",
+			"case 2.1: height out of the history storage window, hist info not found",
 			1,
 			func() sdk.Context {
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(outOfHistoryWindow)
 			},
 			common.Hash{},
 		},
 		{
-			"case 2.2: height lower than current one, invalid hist info header",
+			"case 2.2: height out of the history storage window, invalid hist info header",
 			1,
 			func() sdk.Context {
 				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 1, &stakingtypes.HistoricalInfo{}))
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(outOfHistoryWindow)
 			},
 			common.Hash{},
 		},
 		{
-			"case 2.3: height lower than current one, calculated from hist info header",
+			"case 2.3: height out of the history storage window, calculated from hist info header",
 			1,
 			func() sdk.Context {
 				histInfo := &stakingtypes.HistoricalInfo{
 					Header: header,
 				}
 				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 1, histInfo))
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(outOfHistoryWindow)
+			},
+			common.BytesToHash(hash),
+		},
+		{
+			"case 2.4: height lower than current one, retrieved from history storage",
+			5,
+			func() sdk.Context {
+				ctx := s.Network.GetContext().WithBlockHeight(10)
+				s.Network.App.GetEVMKeeper().SetState(ctx, params.HistoryStorageAddress, common.BigToHash(big.NewInt(5)), hash)
+				return ctx
 			},
 			common.BytesToHash(hash),
 		},
@@ -926,3 +941,98 @@ func (s *KeeperTestSuite) TestApplyMessageWithNegativeAmount() {
 	s.Require().Equal(balance0Before, balance0After)
 	s.Require().Equal(balance1Before, balance1After)
 }
+
+func (s *KeeperTestSuite) TestApplyMessageSetCodeTx() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+
+	target := utiltx.GenerateAddress()
+	chainID := types.GetEthChainConfig().ChainID
+
+	testCases := []struct {
+		name        string
+		authority   int
+		getAuth     func(nonce uint64) gethtypes.SetCodeAuthorization
+		expDelegate bool
+	}{
+		{
+			"pass - delegate authority code",
+			1,
+			func(nonce uint64) gethtypes.SetCodeAuthorization {
+				return gethtypes.SetCodeAuthorization{ChainID: *uint256.MustFromBig(chainID), Address: target, Nonce: nonce}
+			},
+			true,
+		},
+		{
+			"pass - authorization valid on any chain",
+			1,
+			func(nonce uint64) gethtypes.SetCodeAuthorization {
+				return gethtypes.SetCodeAuthorization{Address: target, Nonce: nonce}
+			},
+			true,
+		},
+		{
+			"pass - self sponsored authorization uses the next nonce",
+			0,
+			func(nonce uint64) gethtypes.SetCodeAuthorization {
+				return gethtypes.SetCodeAuthorization{ChainID: *uint256.MustFromBig(chainID), Address: target, Nonce: nonce + 1}
+			},
+			true,
+		},
+		{
+			"skip - wrong chain ID",
+			1,
+			func(nonce uint64) gethtypes.SetCodeAuthorization {
+				return gethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(1), Address: target, Nonce: nonce}
+			},
+			false,
+		},
+		{
+			"skip - nonce mismatch",
+			1,
+			func(nonce uint64) gethtypes.SetCodeAuthorization {
+				return gethtypes.SetCodeAuthorization{ChainID: *uint256.MustFromBig(chainID), Address: target, Nonce: nonce + 1}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest()
+			ctx := s.Network.GetContext()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+
+			sender := s.Keyring.GetKey(0)
+			authority := s.Keyring.GetKey(tc.authority)
+			nonce := evmKeeper.GetAccount(ctx, authority.Addr).Nonce
+
+			authorityKey, err := authority.Priv.(*ethsecp256k1.PrivKey).ToECDSA()
+			s.Require().NoError(err)
+			auth, err := gethtypes.SignSetCode(authorityKey, tc.getAuth(nonce))
+			s.Require().NoError(err)
+
+			recipient := s.Keyring.GetAddr(1)
+			coreMsg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+				To:                &recipient,
+				AuthorizationList: []gethtypes.SetCodeAuthorization{auth},
+			})
+			s.Require().NoError(err)
+
+			res, err := evmKeeper.ApplyMessage(ctx, *coreMsg, nil, true, false)
+			s.Require().NoError(err)
+			s.Require().False(res.Failed(), res.VmError)
+
+			acct := evmKeeper.GetAccount(ctx, authority.Addr)
+			code := evmKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+			if !tc.expDelegate {
+				s.Require().False(acct.IsContract())
+				return
+			}
+			delegate, ok := gethtypes.ParseDelegation(code)
+			s.Require().True(ok)
+			s.Require().Equal(target, delegate)
+			s.Require().Equal(auth.Nonce+1, acct.Nonce)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -18,3 +19,17 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of an eth tx indexer that also indexes
+// the logs, so that range filters don't need to read the block results.
+type EVMLogIndexer interface {
+	EVMTxIndexer
+
+	// LogIndexRange returns the first and last blocks covered by the log
+	// index, -1 if no block is covered.
+	LogIndexRange() (int64, int64, error)
+	// GetLogs returns the logs within the block range matching the addresses
+	// and topics, it fails if more than limit logs match or if more than
+	// maxScanned indexed logs are read to match them, unless it is 0.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit, maxScanned int) ([]*ethtypes.Log, error)
+}
@@ -58,6 +58,19 @@ func InitGenesis(
 			panic(fmt.Errorf("error setting allowance %s", err))
 		}
 	}
+
+	for _, nonce := range data.Nonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		owner := common.HexToAddress(nonce.Owner)
+		k.SetNonce(ctx, erc20, owner, nonce.Value)
+	}
+
+	for _, authorization := range data.UsedAuthorizations {
+		erc20 := common.HexToAddress(authorization.Erc20Address)
+		authorizer := common.HexToAddress(authorization.Authorizer)
+		nonce := common.HexToHash(authorization.Nonce)
+		k.SetAuthorizationUsed(ctx, erc20, authorizer, nonce)
+	}
 }
 
 // ExportGenesis export module status
@@ -68,5 +81,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Allowances:         k.GetAllowances(ctx),
 		NativePrecompiles:  k.GetNativePrecompiles(ctx),
 		DynamicPrecompiles: k.GetDynamicPrecompiles(ctx),
+		Nonces:             k.GetNonces(ctx),
+		UsedAuthorizations: k.GetUsedAuthorizations(ctx),
 	}
 }
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNonce returns the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNonce)
+	bz := store.Get(types.NonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNonce sets the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) SetNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	nonce uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNonce)
+	store.Set(types.NonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetNonces returns all the EIP-2612 permit nonces stored on the erc20 precompiles.
+func (k Keeper) GetNonces(ctx sdk.Context) []types.Nonce {
+	nonces := []types.Nonce{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixNonce)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixNonce):]
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		owner := common.BytesToAddress(key[common.AddressLength:])
+		nonces = append(nonces, types.NewNonce(erc20, owner, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return nonces
+}
+
+// IsAuthorizationUsed returns true if the EIP-3009 authorization with the given
+// nonce of the given authorizer has been used or canceled on the given erc20
+// precompile address.
+func (k Keeper) IsAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	return store.Has(types.UsedAuthorizationKey(erc20, authorizer, nonce))
+}
+
+// SetAuthorizationUsed marks the EIP-3009 authorization with the given nonce
+// of the given authorizer as used or canceled on the given erc20 precompile address.
+func (k Keeper) SetAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedAuthorization)
+	store.Set(types.UsedAuthorizationKey(erc20, authorizer, nonce), []byte{1})
+}
+
+// GetUsedAuthorizations returns all the used or canceled EIP-3009 authorizations
+// stored on the erc20 precompiles.
+func (k Keeper) GetUsedAuthorizations(ctx sdk.Context) []types.UsedAuthorization {
+	authorizations := []types.UsedAuthorization{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixUsedAuthorization)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixUsedAuthorization):]
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		authorizer := common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength])
+		nonce := common.BytesToHash(key[2*common.AddressLength:])
+		authorizations = append(authorizations, types.NewUsedAuthorization(erc20, authorizer, nonce))
+	}
+
+	return authorizations
+}
@@ -113,6 +113,8 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
 	k.deleteAllowances(ctx, tokenPair.GetERC20Contract())
+	// NOTE: the permit nonces and used authorizations are kept, so that the
+	// signatures can't be replayed if the token pair is registered again.
 }
 
 // deleteTokenPair deletes the token pair for the given id.
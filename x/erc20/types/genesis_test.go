@@ -311,6 +311,77 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with nonces and used authorizations",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        1,
+					},
+				},
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x0000000000000000000000000000000000000000000000000000000000000001",
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated nonces",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        1,
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid used authorization nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				UsedAuthorizations: []types.UsedAuthorization{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Authorizer:   testconstants.ExampleEvmAddressAlice,
+						Nonce:        "0x01",
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
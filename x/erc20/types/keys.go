@@ -34,6 +34,8 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixNonce
+	prefixUsedAuthorization
 )
 
 // KVStore key prefixes
@@ -45,6 +47,8 @@ var (
 	KeyPrefixAllowance          = []byte{prefixAllowance}
 	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
 	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixNonce              = []byte{prefixNonce}
+	KeyPrefixUsedAuthorization  = []byte{prefixUsedAuthorization}
 )
 
 func AllowanceKey(
@@ -54,3 +58,18 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+func NonceKey(
+	erc20 common.Address,
+	owner common.Address,
+) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
+
+func UsedAuthorizationKey(
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) []byte {
+	return append(append(erc20.Bytes(), authorizer.Bytes()...), nonce.Bytes()...)
+}
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeTokensCmd(),
+		GetFeeTokenCmd(),
 	)
 	return cmd
 }
@@ -114,3 +116,69 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeTokensCmd queries the tokens accepted to pay the fees of transactions
+func GetFeeTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tokens",
+		Short: "Get the tokens accepted to pay the fees of transactions",
+		Long:  "Get the tokens, other than the EVM coin, accepted to pay the fees of transactions, with their conversion rates.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeTokens(cmd.Context(), &types.QueryFeeTokensRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee tokens")
+	return cmd
+}
+
+// GetFeeTokenCmd queries a token accepted to pay the fees of transactions
+func GetFeeTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token DENOM",
+		Short: "Get a token accepted to pay the fees of transactions",
+		Long:  "Get a token accepted to pay the fees of transactions, with its conversion rate.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeToken(cmd.Context(), &types.QueryFeeTokenRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
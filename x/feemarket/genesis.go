@@ -24,13 +24,18 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	for _, feeToken := range data.FeeTokens {
+		k.SetFeeToken(ctx, feeToken)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:    k.GetParams(ctx),
+		BlockGas:  k.GetBlockGasWanted(ctx),
+		FeeTokens: k.GetFeeTokens(ctx),
 	}
 }
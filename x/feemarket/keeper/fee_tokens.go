@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFeeToken returns the fee token with the given denomination, and whether
+// it is registered.
+func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeTokenKey(denom))
+	if len(bz) == 0 {
+		return types.FeeToken{}, false
+	}
+
+	var feeToken types.FeeToken
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return feeToken, true
+}
+
+// SetFeeToken registers a token accepted to pay the fees of transactions, or
+// replaces the existing one with the same denomination.
+func (k Keeper) SetFeeToken(ctx sdk.Context, feeToken types.FeeToken) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&feeToken)
+	store.Set(types.FeeTokenKey(feeToken.Denom), bz)
+}
+
+// DeleteFeeToken removes the fee token with the given denomination.
+func (k Keeper) DeleteFeeToken(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeTokenKey(denom))
+}
+
+// SetFeeTokenRate updates the conversion rate of a registered fee token. It
+// can be used by oracle modules to keep the rate up to date.
+func (k Keeper) SetFeeTokenRate(ctx sdk.Context, denom string, conversionRate sdkmath.LegacyDec) error {
+	if err := types.ValidateConversionRate(conversionRate); err != nil {
+		return err
+	}
+
+	feeToken, found := k.GetFeeToken(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", denom)
+	}
+
+	feeToken.ConversionRate = conversionRate
+	k.SetFeeToken(ctx, feeToken)
+	return nil
+}
+
+// GetFeeTokens returns all the registered fee tokens.
+func (k Keeper) GetFeeTokens(ctx sdk.Context) []types.FeeToken {
+	feeTokens := []types.FeeToken{}
+
+	k.IterateFeeTokens(ctx, func(feeToken types.FeeToken) (stop bool) {
+		feeTokens = append(feeTokens, feeToken)
+		return false
+	})
+
+	return feeTokens
+}
+
+// IterateFeeTokens iterates over all the registered fee tokens.
+func (k Keeper) IterateFeeTokens(ctx sdk.Context, cb func(feeToken types.FeeToken) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFeeToken)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var feeToken types.FeeToken
+		k.cdc.MustUnmarshal(iterator.Value(), &feeToken)
+
+		if cb(feeToken) {
+			break
+		}
+	}
+}
@@ -3,12 +3,17 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -47,3 +52,49 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// FeeTokens implements the Query/FeeTokens gRPC method
+func (k Keeper) FeeTokens(c context.Context, req *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var feeTokens []types.FeeToken
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var feeToken types.FeeToken
+		if err := k.cdc.Unmarshal(value, &feeToken); err != nil {
+			return err
+		}
+		feeTokens = append(feeTokens, feeToken)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeTokensResponse{
+		FeeTokens:  feeTokens,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeToken implements the Query/FeeToken gRPC method
+func (k Keeper) FeeToken(c context.Context, req *types.QueryFeeTokenRequest) (*types.QueryFeeTokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	feeToken, found := k.GetFeeToken(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee token with denom '%s'", req.Denom)
+	}
+
+	return &types.QueryFeeTokenResponse{
+		FeeToken: feeToken,
+	}, nil
+}
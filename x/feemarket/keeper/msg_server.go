@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -27,3 +28,89 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterFeeToken implements the gRPC MsgServer interface. When a
+// RegisterFeeToken proposal passes, it registers a token accepted to pay the
+// fees of transactions, or replaces the existing one. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) RegisterFeeToken(goCtx context.Context, req *types.MsgRegisterFeeToken) (*types.MsgRegisterFeeTokenResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	if err := req.FeeToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.FeeToken.Denom == evmtypes.GetEVMCoinDenom() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFeeToken, "the EVM coin %s cannot be a fee token", req.FeeToken.Denom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetFeeToken(ctx, req.FeeToken)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeToken,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.FeeToken.Denom),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, req.FeeToken.ConversionRate.String()),
+		),
+	)
+
+	return &types.MsgRegisterFeeTokenResponse{}, nil
+}
+
+// RemoveFeeToken implements the gRPC MsgServer interface. When a
+// RemoveFeeToken proposal passes, the token is no longer accepted to pay the
+// fees of transactions. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) RemoveFeeToken(goCtx context.Context, req *types.MsgRemoveFeeToken) (*types.MsgRemoveFeeTokenResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetFeeToken(ctx, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", req.Denom)
+	}
+	k.DeleteFeeToken(ctx, req.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveFeeToken,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		),
+	)
+
+	return &types.MsgRemoveFeeTokenResponse{}, nil
+}
+
+// UpdateFeeTokenRate implements the gRPC MsgServer interface. It updates the
+// conversion rate of a fee token, and can be performed by the Cosmos SDK
+// governance module account or by the rate updater of the token.
+func (k *Keeper) UpdateFeeTokenRate(goCtx context.Context, req *types.MsgUpdateFeeTokenRate) (*types.MsgUpdateFeeTokenRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	feeToken, found := k.GetFeeToken(ctx, req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", req.Denom)
+	}
+
+	if req.Sender != k.authority.String() && (feeToken.RateUpdater == "" || req.Sender != feeToken.RateUpdater) {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "%s is not allowed to update the conversion rate of %s", req.Sender, req.Denom)
+	}
+
+	if err := k.SetFeeTokenRate(ctx, req.Denom, req.ConversionRate); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateFeeTokenRate,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, req.ConversionRate.String()),
+		),
+	)
+
+	return &types.MsgUpdateFeeTokenRateResponse{}, nil
+}
@@ -21,7 +21,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/feemarket/MsgUpdateParams"
+	updateParamsName       = "os/feemarket/MsgUpdateParams"
+	registerFeeTokenName   = "os/feemarket/MsgRegisterFeeToken"
+	removeFeeTokenName     = "os/feemarket/MsgRemoveFeeToken"
+	updateFeeTokenRateName = "os/feemarket/MsgUpdateFeeTokenRate"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -35,6 +38,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterFeeToken{},
+		&MsgRemoveFeeToken{},
+		&MsgUpdateFeeTokenRate{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -42,4 +48,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterFeeToken{}, registerFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeToken{}, removeFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgUpdateFeeTokenRate{}, updateFeeTokenRateName, nil)
 }
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrFeeTokenNotFound = errorsmod.Register(ModuleName, 2, "fee token not found")
+	ErrInvalidFeeToken  = errorsmod.Register(ModuleName, 3, "invalid fee token")
+)
@@ -2,7 +2,12 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket          = "fee_market"
+	EventTypeSetFeeToken        = "set_fee_token"
+	EventTypeRemoveFeeToken     = "remove_fee_token"
+	EventTypeUpdateFeeTokenRate = "update_fee_token_rate"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee        = "base_fee"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyConversionRate = "conversion_rate"
 )
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// erc20DenomPrefix is the denomination prefix of the tokens of the ERC20
+// contract owned token pairs of x/erc20, which hold no bank balances.
+const erc20DenomPrefix = "erc20:"
+
+// NewFeeToken returns a new instance of FeeToken.
+func NewFeeToken(denom string, conversionRate sdkmath.LegacyDec, rateUpdater string) FeeToken {
+	return FeeToken{
+		Denom:          denom,
+		ConversionRate: conversionRate,
+		RateUpdater:    rateUpdater,
+	}
+}
+
+// Validate performs a stateless validation of the fee token.
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeToken, err.Error())
+	}
+
+	if strings.HasPrefix(ft.Denom, erc20DenomPrefix) {
+		return errorsmod.Wrapf(ErrInvalidFeeToken, "the tokens of ERC20 contract owned token pairs are not supported: %s", ft.Denom)
+	}
+
+	if err := ValidateConversionRate(ft.ConversionRate); err != nil {
+		return err
+	}
+
+	if ft.RateUpdater != "" {
+		if _, err := sdk.AccAddressFromBech32(ft.RateUpdater); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFeeToken, "invalid rate updater address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateConversionRate checks that the conversion rate of a fee token is positive.
+func ValidateConversionRate(rate sdkmath.LegacyDec) error {
+	if rate.IsNil() || !rate.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidFeeToken, "conversion rate must be positive: %s", rate)
+	}
+	return nil
+}
+
+// ToFeeTokenAmount converts an amount of the EVM coin, in its base unit, to
+// the equivalent amount of the fee token. The result is not rounded.
+func (ft FeeToken) ToFeeTokenAmount(amount sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return amount.Mul(ft.ConversionRate)
+}
+
+// ToEVMCoinAmount converts an amount of the fee token to the equivalent
+// amount of the EVM coin, in its base unit. The result is not rounded.
+func (ft FeeToken) ToEVMCoinAmount(amount sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return amount.Quo(ft.ConversionRate)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type FeeTokenTestSuite struct {
+	suite.Suite
+}
+
+func TestFeeTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTokenTestSuite))
+}
+
+func (suite *FeeTokenTestSuite) TestFeeTokenValidate() {
+	testCases := []struct {
+		name     string
+		feeToken FeeToken
+		expError bool
+	}{
+		{
+			"valid",
+			NewFeeToken("uusdc", math.LegacyNewDec(5), ""),
+			false,
+		},
+		{
+			"valid with rate updater",
+			NewFeeToken("uusdc", math.LegacyNewDecWithPrec(5, 12), authtypes.NewModuleAddress(govtypes.ModuleName).String()),
+			false,
+		},
+		{
+			"empty",
+			FeeToken{},
+			true,
+		},
+		{
+			"invalid denom",
+			NewFeeToken("1usdc", math.LegacyNewDec(5), ""),
+			true,
+		},
+		{
+			"ERC20 contract owned token pair",
+			NewFeeToken("erc20:0xdAC17F958D2ee523a2206206994597C13D831ec7", math.LegacyNewDec(5), ""),
+			true,
+		},
+		{
+			"zero conversion rate",
+			NewFeeToken("uusdc", math.LegacyZeroDec(), ""),
+			true,
+		},
+		{
+			"negative conversion rate",
+			NewFeeToken("uusdc", math.LegacyNewDec(-1), ""),
+			true,
+		},
+		{
+			"invalid rate updater",
+			NewFeeToken("uusdc", math.LegacyNewDec(5), "invalid"),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.feeToken.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *FeeTokenTestSuite) TestFeeTokenConversion() {
+	feeToken := NewFeeToken("uusdc", math.LegacyNewDecWithPrec(25, 1), "")
+
+	suite.Require().Equal(math.LegacyNewDec(250), feeToken.ToFeeTokenAmount(math.LegacyNewDec(100)))
+	suite.Require().Equal(math.LegacyNewDec(100), feeToken.ToEVMCoinAmount(math.LegacyNewDec(250)))
+	suite.Require().Equal(math.LegacyNewDecWithPrec(4, 1), feeToken.ToEVMCoinAmount(math.LegacyOneDec()))
+}
@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -109,8 +110,72 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+// FeeToken defines a bank denomination, other than the EVM coin, that is
+// accepted to pay the fees of Cosmos and Ethereum transactions.
+type FeeToken struct {
+	// denom is the bank denomination of the token, e.g. an IBC voucher. The
+	// native coins of ERC20 token pairs share their bank and ERC20 balances. The
+	// tokens of ERC20 contract owned token pairs, with the "erc20:" denomination
+	// prefix, are not supported since their balances are held by the contracts.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of the token, in its base unit, that is
+	// equivalent to one base unit of the EVM coin.
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+	// rate_updater is the optional address, besides the module authority,
+	// allowed to update the conversion rate, e.g. an oracle module account.
+	RateUpdater string `protobuf:"bytes,3,opt,name=rate_updater,json=rateUpdater,proto3" json:"rate_updater,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetRateUpdater() string {
+	if m != nil {
+		return m.RateUpdater
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*FeeToken)(nil), "cosmos.evm.feemarket.v1.FeeToken")
 }
 
 func init() {
@@ -118,34 +183,40 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x7f, 0x69, 0xd2, 0x64, 0xd3, 0xfc, 0x08, 0xab, 0x20, 0x4c, 0x2b, 0x9c, 0x28, 0x20,
+	0x35, 0xaa, 0x84, 0xad, 0xaa, 0xb7, 0x22, 0x0e, 0x4d, 0xab, 0x82, 0x50, 0x91, 0x8a, 0xf9, 0x73,
+	0xe0, 0xb2, 0xda, 0x38, 0x53, 0x67, 0x95, 0xec, 0x6e, 0xe4, 0xdd, 0x58, 0xe4, 0x15, 0x38, 0xf1,
+	0x18, 0x1c, 0x7b, 0xe0, 0x21, 0x7a, 0xac, 0x38, 0x20, 0xc4, 0xa1, 0x42, 0xc9, 0xa1, 0x3c, 0x06,
+	0xb2, 0x37, 0x89, 0x73, 0xe9, 0x21, 0x17, 0xcb, 0x33, 0xdf, 0x37, 0x9f, 0x66, 0xe6, 0x9b, 0x45,
+	0xbb, 0x81, 0x54, 0x5c, 0x2a, 0x0f, 0x62, 0xee, 0x5d, 0x00, 0x70, 0x1a, 0x0d, 0x40, 0x7b, 0xf1,
+	0x7e, 0x16, 0xb8, 0xa3, 0x48, 0x6a, 0x89, 0x1f, 0x1a, 0xa2, 0x0b, 0x31, 0x77, 0x33, 0x2c, 0xde,
+	0xdf, 0xbe, 0x4f, 0x39, 0x13, 0xd2, 0x4b, 0xbf, 0x86, 0xbb, 0xfd, 0xc8, 0x70, 0x49, 0x1a, 0x79,
+	0xf3, 0x42, 0x03, 0xd5, 0x43, 0x19, 0x4a, 0x93, 0x4f, 0xfe, 0x4c, 0xb6, 0xf5, 0x37, 0x8f, 0x8a,
+	0xe7, 0x34, 0xa2, 0x5c, 0x61, 0x07, 0x55, 0x84, 0x24, 0x5d, 0xaa, 0x80, 0x5c, 0x00, 0xd8, 0x56,
+	0xd3, 0x6a, 0x97, 0xfc, 0xb2, 0x90, 0x1d, 0xaa, 0xe0, 0x14, 0x00, 0xbf, 0x40, 0x3b, 0x0b, 0x90,
+	0x04, 0x7d, 0x2a, 0x42, 0x20, 0x3d, 0x10, 0x92, 0x33, 0x41, 0xb5, 0x8c, 0xec, 0xff, 0x9a, 0x56,
+	0xbb, 0xea, 0xdb, 0x5d, 0xc3, 0x3e, 0x4e, 0x09, 0x27, 0x19, 0x8e, 0x0f, 0xd0, 0x03, 0x18, 0x52,
+	0xa5, 0x59, 0xc0, 0xf4, 0x84, 0xf0, 0xf1, 0x50, 0xb3, 0xd1, 0x90, 0x41, 0x64, 0xe7, 0xd3, 0xc2,
+	0x7a, 0x06, 0xbe, 0x59, 0x62, 0xf8, 0x09, 0xaa, 0x82, 0xa0, 0xdd, 0x21, 0x90, 0x3e, 0xb0, 0xb0,
+	0xaf, 0xed, 0x42, 0xd3, 0x6a, 0xe7, 0xfd, 0x2d, 0x93, 0x7c, 0x95, 0xe6, 0xf0, 0x31, 0x2a, 0x2d,
+	0xbb, 0x2e, 0x36, 0xad, 0x76, 0xb9, 0xd3, 0xbe, 0xba, 0x69, 0xe4, 0x7e, 0xdf, 0x34, 0x76, 0xcc,
+	0x06, 0x54, 0x6f, 0xe0, 0x32, 0xe9, 0x71, 0xaa, 0xfb, 0xee, 0x19, 0x84, 0x34, 0x98, 0x9c, 0x40,
+	0xf0, 0xed, 0xf6, 0x72, 0xcf, 0xf2, 0x37, 0xe7, 0xfd, 0xe2, 0x33, 0x54, 0xe5, 0x4c, 0x90, 0x90,
+	0x26, 0xcb, 0x63, 0x01, 0xd8, 0x9b, 0x6b, 0x2a, 0x55, 0x38, 0x13, 0x2f, 0xa9, 0x3a, 0x4f, 0x8a,
+	0xf1, 0x47, 0x84, 0x17, 0x6a, 0x2b, 0x93, 0x96, 0xd6, 0x94, 0xac, 0x19, 0xc9, 0x6c, 0x1f, 0x87,
+	0xad, 0x2f, 0xb7, 0x97, 0x7b, 0x8f, 0x57, 0x2e, 0xe7, 0xf3, 0xca, 0xed, 0x18, 0x1f, 0x5f, 0x6f,
+	0x94, 0x36, 0x6a, 0x05, 0xbf, 0xc6, 0x04, 0xd3, 0x8c, 0x0e, 0x97, 0x86, 0xb6, 0x7e, 0x5a, 0xa8,
+	0x74, 0x0a, 0xf0, 0x5e, 0x0e, 0x40, 0xe0, 0x3a, 0x2a, 0xa4, 0xe6, 0xa5, 0x36, 0x97, 0x7d, 0x13,
+	0xe0, 0xb7, 0xe8, 0x5e, 0x20, 0x45, 0x0c, 0x91, 0x62, 0x52, 0x90, 0x88, 0x6a, 0x48, 0x6d, 0x5d,
+	0xa7, 0xe7, 0xff, 0x33, 0x01, 0x9f, 0x6a, 0xc0, 0xcf, 0xd1, 0x56, 0xa2, 0x43, 0xc6, 0xa3, 0x1e,
+	0xd5, 0x73, 0xb7, 0xcb, 0x1d, 0xfb, 0xc7, 0xf7, 0x67, 0xf5, 0xf9, 0x79, 0x1e, 0xf5, 0x7a, 0x11,
+	0x28, 0xf5, 0x4e, 0x47, 0x4c, 0x84, 0x7e, 0x25, 0x61, 0x7f, 0x30, 0xe4, 0xc3, 0xa7, 0xc9, 0xb8,
+	0x8d, 0x3b, 0xc6, 0x5d, 0xcc, 0xd2, 0x39, 0xba, 0x9a, 0x3a, 0xd6, 0xf5, 0xd4, 0xb1, 0xfe, 0x4c,
+	0x1d, 0xeb, 0xeb, 0xcc, 0xc9, 0x5d, 0xcf, 0x9c, 0xdc, 0xaf, 0x99, 0x93, 0xfb, 0xb4, 0x1b, 0x32,
+	0xdd, 0x1f, 0x77, 0xdd, 0x40, 0x72, 0xef, 0x0e, 0x15, 0x3d, 0x19, 0x81, 0xea, 0x16, 0xd3, 0xd7,
+	0x70, 0xf0, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x0f, 0x32, 0x7d, 0x95, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -226,6 +297,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateUpdater) > 0 {
+		i -= len(m.RateUpdater)
+		copy(dAtA[i:], m.RateUpdater)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.RateUpdater)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -264,6 +382,25 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = len(m.RateUpdater)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
 func sovFeemarket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -499,6 +636,154 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateUpdater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateUpdater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeemarket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +23,16 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seenDenoms := make(map[string]bool, len(gs.FeeTokens))
+	for _, feeToken := range gs.FeeTokens {
+		if seenDenoms[feeToken.Denom] {
+			return errorsmod.Wrapf(ErrInvalidFeeToken, "duplicated fee token %s", feeToken.Denom)
+		}
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		seenDenoms[feeToken.Denom] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -31,6 +31,9 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// fee_tokens defines the tokens, other than the EVM coin, accepted to pay
+	// the fees of transactions.
+	FeeTokens []FeeToken `protobuf:"bytes,4,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +83,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.feemarket.v1.GenesisState")
 }
@@ -89,24 +99,26 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
+	// 298 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
 	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
 	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0xd2,
+	0x19, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0xe5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x4e, 0x5c, 0x6c,
+	0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xf2, 0x7a, 0x38,
+	0x1c, 0xa3, 0x17, 0x00, 0x56, 0xe6, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4,
+	0x18, 0x83, 0xa0, 0x3a, 0x85, 0xa4, 0xb9, 0x38, 0x93, 0x72, 0xf2, 0x93, 0xb3, 0xe3, 0xd3, 0x13,
+	0x8b, 0x25, 0x98, 0x15, 0x18, 0x35, 0x58, 0x82, 0x38, 0xc0, 0x02, 0xee, 0x89, 0xc5, 0x42, 0xde,
+	0x5c, 0x5c, 0x69, 0xa9, 0xa9, 0xf1, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xc5, 0x12, 0x2c, 0x0a, 0xcc,
+	0x1a, 0xdc, 0x46, 0x8a, 0x38, 0x2d, 0x71, 0x4b, 0x4d, 0x0d, 0x01, 0xa9, 0x44, 0xb6, 0x86, 0x33,
+	0x0d, 0x2a, 0x58, 0xec, 0xc5, 0xc2, 0xc1, 0x24, 0xc0, 0x1c, 0xc4, 0x91, 0x94, 0x58, 0x9c, 0x1a,
+	0x9f, 0x96, 0x9a, 0xea, 0xe4, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
+	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
+	0xea, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x48, 0x41, 0x56, 0x81,
+	0x14, 0x68, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80, 0x31, 0x06, 0x04, 0x00, 0x00,
+	0xff, 0xff, 0x99, 0x4e, 0xe5, 0x49, 0xac, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +195,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -259,6 +291,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type GenesisTestSuite struct {
@@ -30,6 +35,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				nil,
 			},
 			true,
 		},
@@ -49,6 +55,36 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis with fee tokens",
+			&GenesisState{
+				Params: DefaultParams(),
+				FeeTokens: []FeeToken{
+					NewFeeToken("uusdc", math.LegacyNewDec(5), ""),
+					NewFeeToken("uatom", math.LegacyNewDecWithPrec(5, 1), authtypes.NewModuleAddress(govtypes.ModuleName).String()),
+				},
+			},
+			true,
+		},
+		{
+			"duplicated fee tokens",
+			&GenesisState{
+				Params: DefaultParams(),
+				FeeTokens: []FeeToken{
+					NewFeeToken("uusdc", math.LegacyNewDec(5), ""),
+					NewFeeToken("uusdc", math.LegacyNewDec(4), ""),
+				},
+			},
+			false,
+		},
+		{
+			"invalid fee token",
+			&GenesisState{
+				Params:    DefaultParams(),
+				FeeTokens: []FeeToken{NewFeeToken("uusdc", math.LegacyZeroDec(), "")},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -20,6 +20,7 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixFeeToken
 )
 
 const (
@@ -29,9 +30,15 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixFeeToken       = []byte{prefixFeeToken}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
 )
+
+// FeeTokenKey returns the key of the fee token with the given denomination.
+func FeeTokenKey(denom string) []byte {
+	return append(KeyPrefixFeeToken, []byte(denom)...)
+}
@@ -6,7 +6,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterFeeToken{}
+	_ sdk.Msg = &MsgRemoveFeeToken{}
+	_ sdk.Msg = &MsgUpdateFeeTokenRate{}
+)
 
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
@@ -21,3 +26,52 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterFeeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.FeeToken.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterFeeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveFeeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeToken, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveFeeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateFeeTokenRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeToken, err.Error())
+	}
+
+	return ValidateConversionRate(m.ConversionRate)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateFeeTokenRate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
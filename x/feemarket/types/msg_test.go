@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
+
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -52,3 +54,129 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterFeeTokenValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterFeeToken
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgRegisterFeeToken{
+				Authority: "invalid",
+				FeeToken:  NewFeeToken("uusdc", math.LegacyNewDec(5), ""),
+			},
+			false,
+		},
+		{
+			"fail - invalid fee token",
+			&MsgRegisterFeeToken{
+				Authority: authority,
+				FeeToken:  NewFeeToken("uusdc", math.LegacyZeroDec(), ""),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgRegisterFeeToken{
+				Authority: authority,
+				FeeToken:  NewFeeToken("uusdc", math.LegacyNewDec(5), authority),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRemoveFeeTokenValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgRemoveFeeToken
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgRemoveFeeToken{Authority: "invalid", Denom: "uusdc"},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			&MsgRemoveFeeToken{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgRemoveFeeToken{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Denom: "uusdc"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateFeeTokenRateValidateBasic() {
+	sender := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateFeeTokenRate
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&MsgUpdateFeeTokenRate{Sender: "invalid", Denom: "uusdc", ConversionRate: math.LegacyNewDec(5)},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			&MsgUpdateFeeTokenRate{Sender: sender, ConversionRate: math.LegacyNewDec(5)},
+			false,
+		},
+		{
+			"fail - nil conversion rate",
+			&MsgUpdateFeeTokenRate{Sender: sender, Denom: "uusdc"},
+			false,
+		},
+		{
+			"fail - negative conversion rate",
+			&MsgUpdateFeeTokenRate{Sender: sender, Denom: "uusdc", ConversionRate: math.LegacyNewDec(-5)},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateFeeTokenRate{Sender: sender, Denom: "uusdc", ConversionRate: math.LegacyNewDec(5)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
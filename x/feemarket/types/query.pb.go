@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -275,6 +276,199 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeTokensRequest defines the request type for querying the fee tokens.
+type QueryFeeTokensRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeTokensRequest) Reset()         { *m = QueryFeeTokensRequest{} }
+func (m *QueryFeeTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensRequest) ProtoMessage()    {}
+func (*QueryFeeTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
+}
+func (m *QueryFeeTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensRequest.Merge(m, src)
+}
+func (m *QueryFeeTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokensRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokensRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeTokensResponse returns the fee tokens.
+type QueryFeeTokensResponse struct {
+	// fee_tokens are the tokens accepted to pay the fees of transactions
+	FeeTokens []FeeToken `protobuf:"bytes,1,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeTokensResponse) Reset()         { *m = QueryFeeTokensResponse{} }
+func (m *QueryFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensResponse) ProtoMessage()    {}
+func (*QueryFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
+}
+func (m *QueryFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensResponse.Merge(m, src)
+}
+func (m *QueryFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokensResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokensResponse) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *QueryFeeTokensResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeTokenRequest defines the request type for querying a fee token.
+type QueryFeeTokenRequest struct {
+	// denom is the bank denomination of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeTokenRequest) Reset()         { *m = QueryFeeTokenRequest{} }
+func (m *QueryFeeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenRequest) ProtoMessage()    {}
+func (*QueryFeeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{8}
+}
+func (m *QueryFeeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFeeTokenResponse returns a fee token.
+type QueryFeeTokenResponse struct {
+	// fee_token is the token accepted to pay the fees of transactions
+	FeeToken FeeToken `protobuf:"bytes,1,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
+}
+
+func (m *QueryFeeTokenResponse) Reset()         { *m = QueryFeeTokenResponse{} }
+func (m *QueryFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenResponse) ProtoMessage()    {}
+func (*QueryFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{9}
+}
+func (m *QueryFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokenResponse) GetFeeToken() FeeToken {
+	if m != nil {
+		return m.FeeToken
+	}
+	return FeeToken{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
@@ -282,6 +476,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeTokensRequest)(nil), "cosmos.evm.feemarket.v1.QueryFeeTokensRequest")
+	proto.RegisterType((*QueryFeeTokensResponse)(nil), "cosmos.evm.feemarket.v1.QueryFeeTokensResponse")
+	proto.RegisterType((*QueryFeeTokenRequest)(nil), "cosmos.evm.feemarket.v1.QueryFeeTokenRequest")
+	proto.RegisterType((*QueryFeeTokenResponse)(nil), "cosmos.evm.feemarket.v1.QueryFeeTokenResponse")
 }
 
 func init() {
@@ -289,36 +487,49 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x40, 0x80, 0x76, 0xb8, 0xe8, 0x58, 0x90, 0xac, 0xa6, 0x85, 0x45, 0x2d, 0x3f, 0x67,
+	0x52, 0xb8, 0x79, 0xb3, 0x31, 0x10, 0xa3, 0x07, 0xdc, 0x78, 0xd1, 0x4b, 0x33, 0x2d, 0xaf, 0x4b,
+	0x53, 0x76, 0xa7, 0x74, 0x96, 0x46, 0x62, 0xbc, 0x78, 0xf6, 0xa0, 0x31, 0x1e, 0xbc, 0x78, 0xf6,
+	0x64, 0xfc, 0x33, 0x38, 0x92, 0x78, 0x31, 0x1e, 0x88, 0xa1, 0x26, 0xfe, 0x19, 0x9a, 0xce, 0x8f,
+	0xd2, 0xad, 0x59, 0xbb, 0x5e, 0xc8, 0xf0, 0xfa, 0xcd, 0xf7, 0x7d, 0xef, 0x9b, 0xf7, 0x5a, 0xbc,
+	0x5c, 0x17, 0x32, 0x10, 0x92, 0x41, 0x37, 0x60, 0x0d, 0x80, 0x80, 0x77, 0x5a, 0x10, 0xb1, 0x6e,
+	0x99, 0x1d, 0x1d, 0x43, 0xe7, 0x84, 0xb6, 0x3b, 0x22, 0x12, 0xe4, 0xba, 0x06, 0x51, 0xe8, 0x06,
+	0x74, 0x00, 0xa2, 0xdd, 0xb2, 0x73, 0x95, 0x07, 0xcd, 0x50, 0x30, 0xf5, 0x57, 0x63, 0x9d, 0x35,
+	0x43, 0x58, 0xe3, 0x12, 0x34, 0x09, 0xeb, 0x96, 0x6b, 0x10, 0xf1, 0x32, 0x6b, 0x73, 0xbf, 0x19,
+	0xf2, 0xa8, 0x29, 0x42, 0x83, 0x2d, 0x25, 0x89, 0x5f, 0x8a, 0x68, 0x60, 0xde, 0x17, 0xbe, 0x50,
+	0x47, 0xd6, 0x3f, 0x99, 0xea, 0x4d, 0x5f, 0x08, 0xff, 0x10, 0x18, 0x6f, 0x37, 0x19, 0x0f, 0x43,
+	0x11, 0x29, 0x6e, 0xa9, 0x3f, 0x75, 0xf3, 0x98, 0x3c, 0xee, 0xcb, 0xef, 0xf1, 0x0e, 0x0f, 0xa4,
+	0x07, 0x47, 0xc7, 0x20, 0x23, 0xf7, 0x29, 0xbe, 0x16, 0xab, 0xca, 0xb6, 0x08, 0x25, 0x90, 0x0a,
+	0x9e, 0x6e, 0xab, 0xca, 0x02, 0x5a, 0x44, 0x2b, 0xb3, 0x5b, 0x45, 0x9a, 0xd0, 0x32, 0xd5, 0x17,
+	0x2b, 0xb9, 0xd3, 0xf3, 0x62, 0xe6, 0xd3, 0xaf, 0x2f, 0x6b, 0xc8, 0x33, 0x37, 0xdd, 0x39, 0x43,
+	0x5d, 0xe1, 0x12, 0x76, 0x00, 0xac, 0xa2, 0x87, 0xf3, 0xf1, 0xb2, 0x91, 0xbc, 0x8b, 0xb3, 0xfd,
+	0x8c, 0xaa, 0x0d, 0x00, 0x25, 0x9a, 0xab, 0x14, 0xbf, 0x9f, 0x17, 0x6f, 0x68, 0x5d, 0xb9, 0xdf,
+	0xa2, 0x4d, 0xc1, 0x02, 0x1e, 0x1d, 0xd0, 0x47, 0xe0, 0xf3, 0xfa, 0xc9, 0x7d, 0xa8, 0x7b, 0x33,
+	0x35, 0xcd, 0xe1, 0xce, 0x5b, 0xce, 0x43, 0x51, 0x6f, 0xed, 0xf2, 0x41, 0x77, 0xab, 0x78, 0x6e,
+	0xa4, 0x6e, 0xc4, 0xae, 0xe0, 0x49, 0x9f, 0xeb, 0xe6, 0x26, 0xbd, 0xfe, 0xd1, 0xad, 0x1a, 0xe8,
+	0x0e, 0xc0, 0x13, 0xd1, 0x82, 0xd0, 0x72, 0x90, 0x1d, 0x8c, 0x2f, 0x1f, 0xca, 0xc4, 0x71, 0xc7,
+	0xc6, 0xd1, 0x37, 0x40, 0xf5, 0x68, 0x98, 0x57, 0xa5, 0x7b, 0xdc, 0xb7, 0xbd, 0x7a, 0x43, 0x37,
+	0xdd, 0xcf, 0x08, 0xcf, 0x8f, 0x2a, 0x18, 0x37, 0x0f, 0x31, 0x6e, 0x00, 0x54, 0x23, 0x55, 0x5d,
+	0x40, 0x8b, 0x93, 0x2b, 0xb3, 0x5b, 0x4b, 0x89, 0x89, 0xdb, 0xfb, 0xc3, 0x99, 0xe7, 0x1a, 0x96,
+	0x94, 0xec, 0xc6, 0xfc, 0x4e, 0x28, 0xbf, 0xa5, 0xb1, 0x7e, 0xb5, 0x93, 0x98, 0xe1, 0x0d, 0x13,
+	0xaa, 0xd5, 0xb3, 0x81, 0xe4, 0xf1, 0xd4, 0x3e, 0x84, 0x22, 0xd0, 0xaf, 0xe4, 0xe9, 0x7f, 0xdc,
+	0xda, 0x48, 0x7e, 0x83, 0xe6, 0x1e, 0xe0, 0xdc, 0xa0, 0x39, 0x13, 0xdf, 0xff, 0xf5, 0x96, 0xb5,
+	0xbd, 0x6d, 0xfd, 0x9e, 0xc2, 0x53, 0x4a, 0x84, 0xbc, 0x46, 0x78, 0x5a, 0x4f, 0x1e, 0x59, 0x4f,
+	0x24, 0xfb, 0x7b, 0xdc, 0x9d, 0x8d, 0x74, 0x60, 0x6d, 0xdd, 0x2d, 0xbd, 0xfa, 0xfa, 0xf3, 0xdd,
+	0xc4, 0x12, 0x29, 0xb2, 0xa4, 0xc5, 0xd4, 0xa3, 0x4e, 0xde, 0x22, 0x3c, 0x63, 0xe6, 0x99, 0x8c,
+	0x91, 0x88, 0x6f, 0x83, 0xb3, 0x99, 0x12, 0x6d, 0x1c, 0xad, 0x2a, 0x47, 0xcb, 0x64, 0x29, 0xd1,
+	0x91, 0xdd, 0x21, 0xf2, 0x1e, 0xe1, 0xac, 0x9d, 0x7b, 0x32, 0x4e, 0x26, 0xbe, 0x37, 0x0e, 0x4d,
+	0x0b, 0x37, 0xb6, 0xd6, 0x94, 0xad, 0x5b, 0xc4, 0x4d, 0xb6, 0xd5, 0xbf, 0x52, 0xf5, 0xb9, 0x24,
+	0x1f, 0x10, 0xce, 0x0d, 0x56, 0x80, 0x8c, 0x51, 0x1a, 0xdd, 0x46, 0x87, 0xa5, 0xc6, 0x1b, 0x6b,
+	0xeb, 0xca, 0xda, 0x6d, 0xb2, 0xcc, 0xfe, 0xf1, 0xe5, 0x6a, 0x56, 0x8f, 0x7c, 0x44, 0x38, 0x6b,
+	0x29, 0xc6, 0x65, 0x36, 0xb2, 0x16, 0x0e, 0x4d, 0x0b, 0x37, 0xc6, 0xb6, 0x95, 0xb1, 0x4d, 0xb2,
+	0x9e, 0xc2, 0x18, 0x7b, 0xa1, 0x96, 0xec, 0x65, 0xe5, 0xde, 0xe9, 0x45, 0x01, 0x9d, 0x5d, 0x14,
+	0xd0, 0x8f, 0x8b, 0x02, 0x7a, 0xd3, 0x2b, 0x64, 0xce, 0x7a, 0x85, 0xcc, 0xb7, 0x5e, 0x21, 0xf3,
+	0xac, 0xe4, 0x37, 0xa3, 0x83, 0xe3, 0x1a, 0xad, 0x8b, 0x60, 0x98, 0xf0, 0xf9, 0x10, 0x65, 0x74,
+	0xd2, 0x06, 0x59, 0x9b, 0x56, 0x3f, 0x07, 0xdb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x15, 0x1a,
+	0xfa, 0x05, 0xea, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +550,11 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeTokens queries the tokens, other than the EVM coin, accepted to pay the
+	// fees of transactions.
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// FeeToken queries a token accepted to pay the fees of transactions.
+	FeeToken(ctx context.Context, in *QueryFeeTokenRequest, opts ...grpc.CallOption) (*QueryFeeTokenResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +592,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/FeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeToken(ctx context.Context, in *QueryFeeTokenRequest, opts ...grpc.CallOption) (*QueryFeeTokenResponse, error) {
+	out := new(QueryFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/FeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +618,11 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeTokens queries the tokens, other than the EVM coin, accepted to pay the
+	// fees of transactions.
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// FeeToken queries a token accepted to pay the fees of transactions.
+	FeeToken(context.Context, *QueryFeeTokenRequest) (*QueryFeeTokenResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +638,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (*UnimplementedQueryServer) FeeToken(ctx context.Context, req *QueryFeeTokenRequest) (*QueryFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeToken not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +703,43 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/FeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/FeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeToken(ctx, req.(*QueryFeeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +756,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "FeeToken",
+			Handler:    _Query_FeeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -644,86 +934,289 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlockGasRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlockGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryFeeTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1112,6 +1605,377 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -125,37 +126,344 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterFeeToken defines a Msg for registering or replacing a fee token.
+type MsgRegisterFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fee_token is the token accepted to pay the fees of transactions.
+	FeeToken FeeToken `protobuf:"bytes,2,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
+}
+
+func (m *MsgRegisterFeeToken) Reset()         { *m = MsgRegisterFeeToken{} }
+func (m *MsgRegisterFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeToken) ProtoMessage()    {}
+func (*MsgRegisterFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{2}
+}
+func (m *MsgRegisterFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeToken.Merge(m, src)
+}
+func (m *MsgRegisterFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterFeeToken proto.InternalMessageInfo
+
+func (m *MsgRegisterFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterFeeToken) GetFeeToken() FeeToken {
+	if m != nil {
+		return m.FeeToken
+	}
+	return FeeToken{}
+}
+
+// MsgRegisterFeeTokenResponse defines the response structure for executing a
+// MsgRegisterFeeToken message.
+type MsgRegisterFeeTokenResponse struct {
+}
+
+func (m *MsgRegisterFeeTokenResponse) Reset()         { *m = MsgRegisterFeeTokenResponse{} }
+func (m *MsgRegisterFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeTokenResponse) ProtoMessage()    {}
+func (*MsgRegisterFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{3}
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterFeeTokenResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeToken defines a Msg for removing a fee token.
+type MsgRemoveFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the bank denomination of the fee token to remove.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveFeeToken) Reset()         { *m = MsgRemoveFeeToken{} }
+func (m *MsgRemoveFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeToken) ProtoMessage()    {}
+func (*MsgRemoveFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{4}
+}
+func (m *MsgRemoveFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeToken.Merge(m, src)
+}
+func (m *MsgRemoveFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeToken proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveFeeTokenResponse defines the response structure for executing a
+// MsgRemoveFeeToken message.
+type MsgRemoveFeeTokenResponse struct {
+}
+
+func (m *MsgRemoveFeeTokenResponse) Reset()         { *m = MsgRemoveFeeTokenResponse{} }
+func (m *MsgRemoveFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokenResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{5}
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeTokenResponse proto.InternalMessageInfo
+
+// MsgUpdateFeeTokenRate defines a Msg for updating the conversion rate of a
+// fee token.
+type MsgUpdateFeeTokenRate struct {
+	// sender is the address of the governance account or of the rate updater
+	// of the fee token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the bank denomination of the fee token.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the new amount of the token, in its base unit, that is
+	// equivalent to one base unit of the EVM coin.
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+}
+
+func (m *MsgUpdateFeeTokenRate) Reset()         { *m = MsgUpdateFeeTokenRate{} }
+func (m *MsgUpdateFeeTokenRate) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeTokenRate) ProtoMessage()    {}
+func (*MsgUpdateFeeTokenRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{6}
+}
+func (m *MsgUpdateFeeTokenRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeTokenRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeTokenRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeTokenRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeTokenRate.Merge(m, src)
+}
+func (m *MsgUpdateFeeTokenRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeTokenRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeTokenRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeTokenRate proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeTokenRate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateFeeTokenRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgUpdateFeeTokenRateResponse defines the response structure for executing a
+// MsgUpdateFeeTokenRate message.
+type MsgUpdateFeeTokenRateResponse struct {
+}
+
+func (m *MsgUpdateFeeTokenRateResponse) Reset()         { *m = MsgUpdateFeeTokenRateResponse{} }
+func (m *MsgUpdateFeeTokenRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeTokenRateResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeTokenRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{7}
+}
+func (m *MsgUpdateFeeTokenRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeTokenRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeTokenRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeTokenRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeTokenRateResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeTokenRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeTokenRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeTokenRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeTokenRateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterFeeToken)(nil), "cosmos.evm.feemarket.v1.MsgRegisterFeeToken")
+	proto.RegisterType((*MsgRegisterFeeTokenResponse)(nil), "cosmos.evm.feemarket.v1.MsgRegisterFeeTokenResponse")
+	proto.RegisterType((*MsgRemoveFeeToken)(nil), "cosmos.evm.feemarket.v1.MsgRemoveFeeToken")
+	proto.RegisterType((*MsgRemoveFeeTokenResponse)(nil), "cosmos.evm.feemarket.v1.MsgRemoveFeeTokenResponse")
+	proto.RegisterType((*MsgUpdateFeeTokenRate)(nil), "cosmos.evm.feemarket.v1.MsgUpdateFeeTokenRate")
+	proto.RegisterType((*MsgUpdateFeeTokenRateResponse)(nil), "cosmos.evm.feemarket.v1.MsgUpdateFeeTokenRateResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/feemarket/v1/tx.proto", fileDescriptor_e1e58e525ba547f0) }
 
 var fileDescriptor_e1e58e525ba547f0 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x4c, 0x43, 0x68, 0x84, 0x28, 0x84, 0x1a,
-	0xaa, 0x9f, 0x5b, 0x9c, 0x0e, 0x92, 0xce, 0x2d, 0x4e, 0x87, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1,
-	0x3c, 0x7d, 0xa8, 0xd5, 0x10, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11,
-	0x55, 0x3a, 0xc1, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x90,
-	0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59,
-	0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x38,
-	0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52,
-	0x21, 0x27, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xf2, 0x7a,
-	0x38, 0xfc, 0xae, 0x07, 0xb1, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68,
-	0x31, 0x06, 0x41, 0x75, 0x5a, 0x59, 0x36, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xb3, 0xeb, 0xf9, 0x06,
-	0x2d, 0x35, 0xa4, 0x50, 0xa9, 0x40, 0x0a, 0x17, 0x34, 0x67, 0x2b, 0x49, 0x72, 0x89, 0xa3, 0x09,
-	0x05, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x55, 0x70, 0x31, 0xfb, 0x16, 0xa7, 0x0b,
-	0x65, 0x71, 0xf1, 0xa0, 0x78, 0x54, 0x03, 0xa7, 0x03, 0xd1, 0x0c, 0x92, 0x32, 0x20, 0x56, 0x25,
-	0xcc, 0x4a, 0x29, 0xd6, 0x06, 0x90, 0xbf, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f,
-	0x87, 0x0f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x31, 0x65, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x35, 0xe5, 0xf9, 0x17, 0x6c, 0x02, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3d, 0x6f, 0xd3, 0x50,
+	0x14, 0x8d, 0xa9, 0x5a, 0x91, 0x07, 0x6a, 0xa9, 0x09, 0x6a, 0xe2, 0xa8, 0x4e, 0xf0, 0x40, 0xa3,
+	0xa8, 0xd8, 0x6d, 0x40, 0x95, 0x08, 0x30, 0x34, 0x42, 0x48, 0x48, 0x44, 0x82, 0x00, 0x0b, 0x4b,
+	0xe4, 0xc6, 0x37, 0x8e, 0x89, 0x9e, 0x9f, 0xe5, 0xf7, 0x6a, 0x35, 0x12, 0x03, 0x62, 0x64, 0xe2,
+	0x17, 0x30, 0x33, 0x66, 0xe0, 0x47, 0x64, 0xac, 0x58, 0x40, 0x0c, 0x55, 0x95, 0x0c, 0x59, 0xf9,
+	0x09, 0xc8, 0x1f, 0x71, 0x8c, 0x13, 0x27, 0x2d, 0x2c, 0x51, 0x7c, 0xcf, 0xf1, 0x39, 0xf7, 0xdc,
+	0x77, 0x9f, 0x51, 0xb1, 0x45, 0x28, 0x26, 0x54, 0x01, 0x07, 0x2b, 0x6d, 0x00, 0xac, 0xda, 0x5d,
+	0x60, 0x8a, 0xb3, 0xaf, 0xb0, 0x13, 0xd9, 0xb2, 0x09, 0x23, 0xfc, 0x96, 0xcf, 0x90, 0xc1, 0xc1,
+	0x72, 0xc8, 0x90, 0x9d, 0x7d, 0x61, 0x53, 0xc5, 0x86, 0x49, 0x14, 0xef, 0xd7, 0xe7, 0x0a, 0x3b,
+	0x49, 0x6a, 0xd3, 0x17, 0x7d, 0x62, 0x20, 0xaa, 0x60, 0xaa, 0xbb, 0x30, 0xa6, 0x7a, 0x00, 0xe4,
+	0x7c, 0xa0, 0xe9, 0x3d, 0x29, 0x81, 0xb5, 0x0f, 0x65, 0x74, 0xa2, 0x13, 0xbf, 0xee, 0xfe, 0xf3,
+	0xab, 0xd2, 0x80, 0x43, 0x1b, 0x75, 0xaa, 0xbf, 0xb1, 0x34, 0x95, 0xc1, 0x0b, 0xd5, 0x56, 0x31,
+	0xe5, 0x0f, 0x50, 0x5a, 0x3d, 0x66, 0x1d, 0x62, 0x1b, 0xac, 0x97, 0xe5, 0x8a, 0x5c, 0x29, 0x5d,
+	0xcb, 0x7e, 0xff, 0x76, 0x37, 0x13, 0xc8, 0x1d, 0x6a, 0x9a, 0x0d, 0x94, 0xbe, 0x62, 0xb6, 0x61,
+	0xea, 0x8d, 0x29, 0x95, 0xaf, 0xa1, 0x35, 0xcb, 0x53, 0xc8, 0x5e, 0x29, 0x72, 0xa5, 0x6b, 0x95,
+	0x82, 0x9c, 0x90, 0x5d, 0xf6, 0x8d, 0x6a, 0xe9, 0xc1, 0x59, 0x21, 0xf5, 0x75, 0xdc, 0x2f, 0x73,
+	0x8d, 0xe0, 0xcd, 0xea, 0x83, 0x8f, 0xe3, 0x7e, 0x79, 0xaa, 0xf9, 0x69, 0xdc, 0x2f, 0xdf, 0x89,
+	0x4c, 0xe5, 0x24, 0x32, 0x97, 0x58, 0xdb, 0x52, 0x0e, 0x6d, 0xc5, 0x4a, 0x0d, 0xa0, 0x16, 0x31,
+	0x29, 0x48, 0x3f, 0x38, 0x74, 0xb3, 0x4e, 0xf5, 0x06, 0xe8, 0x06, 0x65, 0x60, 0x3f, 0x05, 0x78,
+	0x4d, 0xba, 0x60, 0xfe, 0x73, 0xd2, 0x67, 0x28, 0xdd, 0x06, 0x68, 0x32, 0x57, 0x24, 0x08, 0x7b,
+	0x3b, 0x31, 0xec, 0xc4, 0x2d, 0x1a, 0xf7, 0x6a, 0x3b, 0x28, 0x56, 0x1f, 0xcf, 0x06, 0x2e, 0x27,
+	0x07, 0x8e, 0x27, 0x90, 0xb6, 0x51, 0x7e, 0x4e, 0x39, 0x0c, 0xfe, 0x85, 0x43, 0x9b, 0x1e, 0x8e,
+	0x89, 0x03, 0xff, 0x1d, 0x3b, 0x83, 0x56, 0x35, 0x30, 0x09, 0xf6, 0x22, 0xa7, 0x1b, 0xfe, 0x43,
+	0xf5, 0xe1, 0x6c, 0x82, 0xd2, 0xa2, 0x04, 0xd1, 0x56, 0xa4, 0x3c, 0xca, 0xcd, 0x14, 0xc3, 0xee,
+	0x7f, 0x73, 0xe8, 0x56, 0x78, 0xa4, 0x21, 0xaa, 0x32, 0xe0, 0xf7, 0xd0, 0x1a, 0x05, 0x53, 0x03,
+	0x7b, 0x69, 0xfb, 0x01, 0x6f, 0x7e, 0xef, 0xfc, 0x4b, 0xb4, 0xd1, 0x22, 0xa6, 0x03, 0x36, 0x35,
+	0x88, 0xd9, 0xb4, 0x55, 0x06, 0xd9, 0x15, 0x4f, 0xb0, 0xe4, 0x9e, 0xd5, 0xaf, 0xb3, 0x42, 0xde,
+	0x17, 0xa5, 0x5a, 0x57, 0x36, 0x88, 0x82, 0x55, 0xd6, 0x91, 0x9f, 0x83, 0xae, 0xb6, 0x7a, 0x4f,
+	0xa0, 0xe5, 0x1f, 0xe5, 0xfa, 0x54, 0xc0, 0x6d, 0xad, 0xfa, 0xc8, 0x1d, 0x47, 0xe0, 0xea, 0xce,
+	0x62, 0x77, 0xd9, 0xfa, 0x46, 0x83, 0x49, 0x05, 0xb4, 0x3d, 0x17, 0x98, 0xcc, 0xa4, 0x72, 0xbe,
+	0x82, 0x56, 0xea, 0x54, 0xe7, 0xdf, 0xa1, 0xeb, 0x7f, 0x5d, 0xda, 0x52, 0xe2, 0xfe, 0xc5, 0x2e,
+	0x85, 0xb0, 0x77, 0x51, 0xe6, 0xc4, 0x93, 0x77, 0xd0, 0x8d, 0x99, 0xab, 0xb3, 0xbb, 0x48, 0x25,
+	0xce, 0x16, 0xee, 0x5f, 0x86, 0x1d, 0xfa, 0x5a, 0x68, 0x3d, 0xb6, 0xb9, 0xe5, 0xc5, 0x3a, 0x51,
+	0xae, 0x50, 0xb9, 0x38, 0x37, 0x74, 0x7c, 0x8f, 0xf8, 0x39, 0xdb, 0x26, 0x2f, 0x9f, 0x58, 0x94,
+	0x2f, 0x1c, 0x5c, 0x8e, 0x3f, 0x71, 0x17, 0x56, 0x3f, 0xb8, 0x1b, 0x55, 0x3b, 0x1c, 0x0c, 0x45,
+	0xee, 0x74, 0x28, 0x72, 0xe7, 0x43, 0x91, 0xfb, 0x3c, 0x12, 0x53, 0xa7, 0x23, 0x31, 0xf5, 0x73,
+	0x24, 0xa6, 0xde, 0xee, 0xe8, 0x06, 0xeb, 0x1c, 0x1f, 0xc9, 0x2d, 0x82, 0x95, 0x84, 0xb5, 0x62,
+	0x3d, 0x0b, 0xe8, 0xd1, 0x9a, 0xf7, 0x75, 0xbf, 0xf7, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x09,
+	0x4e, 0xdf, 0xa0, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +482,16 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterFeeToken defines a governance operation for registering a token
+	// accepted to pay the fees of transactions, or for replacing an existing
+	// one.
+	RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a token
+	// accepted to pay the fees of transactions.
+	RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error)
+	// UpdateFeeTokenRate updates the conversion rate of a fee token. It can be
+	// executed by the authority or by the rate updater of the token.
+	UpdateFeeTokenRate(ctx context.Context, in *MsgUpdateFeeTokenRate, opts ...grpc.CallOption) (*MsgUpdateFeeTokenRateResponse, error)
 }
 
 type msgClient struct {
@@ -193,12 +511,49 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error) {
+	out := new(MsgRegisterFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Msg/RegisterFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error) {
+	out := new(MsgRemoveFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Msg/RemoveFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeTokenRate(ctx context.Context, in *MsgUpdateFeeTokenRate, opts ...grpc.CallOption) (*MsgUpdateFeeTokenRateResponse, error) {
+	out := new(MsgUpdateFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Msg/UpdateFeeTokenRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterFeeToken defines a governance operation for registering a token
+	// accepted to pay the fees of transactions, or for replacing an existing
+	// one.
+	RegisterFeeToken(context.Context, *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a token
+	// accepted to pay the fees of transactions.
+	RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error)
+	// UpdateFeeTokenRate updates the conversion rate of a fee token. It can be
+	// executed by the authority or by the rate updater of the token.
+	UpdateFeeTokenRate(context.Context, *MsgUpdateFeeTokenRate) (*MsgUpdateFeeTokenRateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +563,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterFeeToken(ctx context.Context, req *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeToken(ctx context.Context, req *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeTokenRate(ctx context.Context, req *MsgUpdateFeeTokenRate) (*MsgUpdateFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokenRate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +595,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Msg/RegisterFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeToken(ctx, req.(*MsgRegisterFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Msg/RemoveFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeToken(ctx, req.(*MsgRemoveFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokenRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Msg/UpdateFeeTokenRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokenRate(ctx, req.(*MsgUpdateFeeTokenRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +657,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterFeeToken",
+			Handler:    _Msg_RegisterFeeToken_Handler,
+		},
+		{
+			MethodName: "RemoveFeeToken",
+			Handler:    _Msg_RemoveFeeToken_Handler,
+		},
+		{
+			MethodName: "UpdateFeeTokenRate",
+			Handler:    _Msg_UpdateFeeTokenRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/tx.proto",
@@ -307,26 +737,219 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokenRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokenRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokenRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokenRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokenRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokenRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -342,6 +965,84 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeTokenRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeTokenRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -513,6 +1214,533 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokenRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokenRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
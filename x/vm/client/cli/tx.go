@@ -76,6 +76,11 @@ func NewRawTxCmd() *cobra.Command {
 
 			baseDenom := types.GetEVMCoinDenom()
 
+			// the fee granter is part of the signed transaction
+			if clientCtx.FeeGranter != nil {
+				return errors.Wrap(errortypes.ErrInvalidRequest, "the fee granter must be declared in the access list of the ethereum tx")
+			}
+
 			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
 			if err != nil {
 				return err
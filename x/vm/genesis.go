@@ -61,6 +61,8 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	k.EnableHistoryStorage(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
 
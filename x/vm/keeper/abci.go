@@ -35,12 +35,14 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, and stores the block hash in the EIP-2935 history storage contract if it is enabled. The
+// EVM end block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
+	k.StoreBlockHash(infCtx)
+
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
@@ -28,10 +28,11 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 		homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// RefundGas transfers the leftover gas to the sender of the message, or to the fee granter if it paid
+// the fees, capped to half of the total gas consumed in the transaction. If the fees were paid in a
+// fee token, the leftover gas is refunded in that token at the conversion rate used to charge them,
+// rounded down. Additionally, the function sets the total gas consumed to the value returned by the
+// EVM execution, thus ignoring the previous intrinsic gas consumed during in the AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
@@ -43,9 +44,20 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		if feeToken := k.GetTransientFeeToken(ctx); feeToken != nil {
+			amount := feeToken.ToFeeTokenAmount(types.ConvertBigIntFrom18DecimalsToLegacyDec(remaining))
+			refundedCoins = sdk.NewCoins(sdk.NewCoin(feeToken.Denom, amount.TruncateInt()))
+			if refundedCoins.IsZero() {
+				return nil
+			}
+		}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		refundAddr := sdk.AccAddress(msg.From.Bytes())
+		if feePayer := k.GetTransientFeePayer(ctx); feePayer != nil {
+			refundAddr = feePayer
+		}
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundAddr, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -21,9 +22,11 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	"github.com/cosmos/evm/x/vm/statedb"
+	vmtracers "github.com/cosmos/evm/x/vm/tracers"
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -185,6 +188,74 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageProof implements the Query/StorageProof gRPC method. It is only
+// served if enabled with WithStorageProofs, and its storage root is not
+// committed to by the app hash, so its proofs are not verifiable against the
+// chain state.
+func (k Keeper) StorageProof(c context.Context, req *types.QueryStorageProofRequest) (*types.QueryStorageProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	if k.storageProofMaxSlots == 0 {
+		return nil, status.Error(codes.Unavailable, "storage proofs are disabled")
+	}
+
+	if len(req.Keys) > k.storageProofMaxSlots {
+		return nil, status.Errorf(codes.InvalidArgument, "too many storage keys, max %d", k.storageProofMaxSlots)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+
+	// build the storage trie of the account from its IAVL storage
+	var (
+		err   error
+		slots int
+	)
+	storageTrie := types.NewStorageTrie()
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		if slots++; slots > k.storageProofMaxSlots {
+			err = status.Errorf(codes.ResourceExhausted, "account storage exceeds %d slots", k.storageProofMaxSlots)
+			return false
+		}
+		if err = storageTrie.Update(key, value); err != nil {
+			err = status.Error(codes.Internal, err.Error())
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]types.StorageKeyProof, len(req.Keys))
+	for i, key := range req.Keys {
+		hexKey := common.HexToHash(key)
+		proof, err := storageTrie.Prove(hexKey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		proofs[i] = types.StorageKeyProof{
+			Key:   key,
+			Value: k.GetState(ctx, address, hexKey).Hex(),
+			Proof: proof,
+		}
+	}
+
+	return &types.QueryStorageProofResponse{
+		StorageHash: storageTrie.Hash().Hex(),
+		Proofs:      proofs,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -241,9 +312,12 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := setCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callerNonce(ctx, args.GetFrom(), cfg.StateOverrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
@@ -262,6 +336,43 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// SimulateV1 implements eth_simulateV1 rpc api.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the simulated blocks are executed on a branch of the state that is
+	// never written back
+	simCtx, _ := ctx.CacheContext()
+	blocks, err := k.simulate(simCtx, cfg, opts, req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateV1Response{Data: data}, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -319,9 +430,12 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if err := setCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callerNonce(ctx, args.GetFrom(), cfg.StateOverrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
@@ -337,6 +451,9 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		baseDenom := types.GetEVMCoinDenom()
 
 		balance := k.bankWrapper.SpendableCoin(ctx, sdk.AccAddress(args.From.Bytes()), baseDenom)
+		if override, ok := cfg.StateOverrides[args.GetFrom()]; ok && override.Balance != nil {
+			balance.Amount = sdkmath.NewIntFromBigInt((*override.Balance).ToInt())
+		}
 		available := balance.Amount
 		transfer := "0"
 		if args.Value != nil {
@@ -534,6 +651,59 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call against the state of the requested block, applying the
+// optional state and block overrides. The call is never committed.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	if err := setCallOverrides(req.Overrides, req.BlockOverrides, cfg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.callerNonce(ctx, args.GetFrom(), cfg.StateOverrides)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, &msg, req.TraceConfig, false)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -546,6 +716,45 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	ctx, cfg, err := k.blockTraceContext(c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	txsLength := len(req.Txs)
+	results := make([]*types.TxTraceResult, 0, txsLength)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for i, tx := range req.Txs {
+		result := types.TxTraceResult{}
+		ethTx := tx.AsTransaction()
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			txConfig.LogIndex = logIndex
+			result.Result = traceResult
+		}
+		results = append(results, &result)
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceBlockResponse{
+		Data: resultData,
+	}, nil
+}
+
+// blockTraceContext returns the context and the EVM config of the beginning
+// of the block of a TraceBlock request.
+func (k Keeper) blockTraceContext(c context.Context, req *types.QueryTraceBlockRequest) (sdk.Context, *statedb.EVMConfig, error) {
 	// get the context of block beginning
 	contextHeight := req.BlockNumber
 	if contextHeight < 1 {
@@ -565,7 +774,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
+		return sdk.Context{}, nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
 	// compute and use base fee of height that is being traced
@@ -574,34 +783,57 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
-	txsLength := len(req.Txs)
-	results := make([]*types.TxTraceResult, 0, txsLength)
+	return ctx, cfg, nil
+}
+
+// IntermediateRoots implements the Query/IntermediateRoots gRPC method. It
+// replays the transactions of the block like TraceBlock and returns a
+// dirty-state digest after each one of them, which chains the previous digest
+// with the transaction hash and the StateDB.DirtiesHash of the EVM state
+// changes it committed, so that the first diverging transaction of a block can
+// be found by comparing the digests of two nodes.
+//
+// NOTE: the digests are not state commitments, as the query runs on a cached
+// store of the block state, which has no working hash. They only cover the
+// accounts and storage written by the EVM StateDB, and not the other Cosmos
+// state changes, such as the fees and nonces of the ante handler, which isn't
+// replayed, and the writes of the precompiles.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryTraceBlockRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx, cfg, err := k.blockTraceContext(c, req)
+	if err != nil {
+		return nil, err
+	}
 
+	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
+	var digest common.Hash
+	digests := make([][]byte, 0, len(req.Txs))
 	for i, tx := range req.Txs {
-		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true)
+
+		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
 		if err != nil {
-			result.Error = err.Error()
-		} else {
-			txConfig.LogIndex = logIndex
-			result.Result = traceResult
+			return nil, status.Errorf(codes.Internal, "tx %s: %s", txConfig.TxHash.Hex(), err.Error())
 		}
-		results = append(results, &result)
-	}
+		res, stateDB, err := k.applyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, nil, true, cfg, txConfig, false)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "tx %s: %s", txConfig.TxHash.Hex(), err.Error())
+		}
+		txConfig.LogIndex += uint(len(res.Logs))
 
-	resultData, err := json.Marshal(results)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		digest = crypto.Keccak256Hash(digest.Bytes(), txConfig.TxHash.Bytes(), stateDB.DirtiesHash().Bytes())
+		digests = append(digests, digest.Bytes())
 	}
 
-	return &types.QueryTraceBlockResponse{
-		Data: resultData,
+	return &types.QueryIntermediateRootsResponse{
+		Roots: digests,
 	}, nil
 }
 
@@ -614,6 +846,23 @@ func (k *Keeper) traceTx(
 	tx *ethtypes.Transaction,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
+) (*any, uint, error) {
+	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage)
+}
+
+// traceMsg traces the execution of a message with the tracer of the trace
+// config, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg *core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
 ) (*any, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -623,10 +872,6 @@ func (k *Keeper) traceTx(
 		err              error
 		timeout          = defaultTraceTimeout
 	)
-	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -663,7 +908,17 @@ func (k *Keeper) traceTx(
 		TxHash:    txConfig.TxHash,
 	}
 
-	if traceConfig.Tracer != "" {
+	switch traceConfig.Tracer {
+	case "":
+	case vmtracers.PrecompileTracerName:
+		// the precompiles notify the tracer carried by the execution context
+		var precompileTracer cmn.PrecompileTracer
+		if tracer, precompileTracer, err = vmtracers.NewPrecompileTracer(tCtx, jsonTracerConfig,
+			types.GetEthChainConfig()); err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
+		ctx = cmn.WithPrecompileTracer(ctx, precompileTracer)
+	default:
 		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, jsonTracerConfig,
 			types.GetEthChainConfig()); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
@@ -735,6 +990,48 @@ func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.Q
 	return &types.QueryConfigResponse{Config: config}, nil
 }
 
+// setCallOverrides decodes the optional json encoded state and block overrides
+// of a request into the EVM config used to execute the call.
+func setCallOverrides(stateOverrides, blockOverridesBz []byte, cfg *statedb.EVMConfig) error {
+	if len(stateOverrides) > 0 {
+		var overrides types.StateOverride
+		if err := json.Unmarshal(stateOverrides, &overrides); err != nil {
+			return err
+		}
+		if err := overrides.Validate(); err != nil {
+			return err
+		}
+		cfg.StateOverrides = overrides
+	}
+
+	if len(blockOverridesBz) > 0 {
+		var blockOverrides types.BlockOverrides
+		if err := json.Unmarshal(blockOverridesBz, &blockOverrides); err != nil {
+			return err
+		}
+		// the coinbase and base fee are also part of the config, so keep
+		// them consistent with the overridden block context
+		if blockOverrides.FeeRecipient != nil {
+			cfg.CoinBase = *blockOverrides.FeeRecipient
+		}
+		if blockOverrides.BaseFee != nil {
+			cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+		}
+		cfg.BlockOverrides = &blockOverrides
+	}
+
+	return nil
+}
+
+// callerNonce returns the nonce of the caller, taking into account the
+// state overrides of the call.
+func (k Keeper) callerNonce(ctx sdk.Context, from common.Address, overrides types.StateOverride) uint64 {
+	if override, ok := overrides[from]; ok && override.Nonce != nil {
+		return uint64(*override.Nonce)
+	}
+	return k.GetNonce(ctx, from)
+}
+
 // buildTraceCtx builds a context for simulating or tracing transactions by:
 // 1. assigning a new infinite gas meter with the provided gasLimit
 // 2. calling BuildEvmExecutionCtx to set up gas configs consistent with Ethereum transaction execution.
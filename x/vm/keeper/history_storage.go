@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EnableHistoryStorage deploys the EIP-2935 history storage contract at its
+// canonical address and starts storing the block hashes in it from the
+// current block. It is called by InitGenesis, and must be called by an upgrade
+// handler on existing chains, as the evmd one does, since storing the block
+// hashes changes the state at the end of every block. It is a no-op if the
+// history storage is already enabled.
+func (k *Keeper) EnableHistoryStorage(ctx sdk.Context) {
+	if _, enabled := k.GetHistoryStorageStart(ctx); enabled {
+		return
+	}
+
+	k.deployHistoryStorage(ctx)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyHistoryStorageStart, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))) //#nosec G115 -- the block height is positive
+}
+
+// GetHistoryStorageStart returns the first block whose hash is stored in the
+// EIP-2935 history storage contract, and false if it is not enabled.
+func (k *Keeper) GetHistoryStorageStart(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyHistoryStorageStart)
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// StoreBlockHash stores the hash of the current block in the EIP-2935 history
+// storage contract if it is enabled. As with the EIP-2935 system call, the
+// hash of block n is stored at the slot n % HistoryServeWindow of the
+// contract.
+//
+// The EIP-2935 system call stores the parent hash at the beginning of the
+// block, but the header of the FinalizeBlock context has no parent hash. The
+// hash is stored at the end of the block instead, which results in the same
+// state for the transactions of the next block, as none runs in between. This
+// also keeps the hash of block n - HistoryServeWindow available to the
+// transactions of block n, as the contract serves it.
+func (k *Keeper) StoreBlockHash(ctx sdk.Context) {
+	if _, enabled := k.GetHistoryStorageStart(ctx); !enabled {
+		return
+	}
+
+	headerHash := ctx.HeaderHash()
+	if len(headerHash) == 0 {
+		return
+	}
+
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- the block height is positive
+	k.SetState(ctx, params.HistoryStorageAddress, historyStorageSlot(height), common.BytesToHash(headerHash).Bytes())
+}
+
+// GetHistoricalBlockHash returns the hash of the given block from the EIP-2935
+// history storage contract. It returns an empty hash if the block is not in
+// the serve window of the current block or precedes the history storage
+// activation. The oldest block of the window is excluded, as its slot is
+// overwritten at the end of the current block.
+func (k *Keeper) GetHistoricalBlockHash(ctx sdk.Context, height uint64) common.Hash {
+	start, enabled := k.GetHistoryStorageStart(ctx)
+	current := uint64(ctx.BlockHeight()) //#nosec G115 -- the block height is positive
+	if !enabled || height < start || height >= current || current-height >= types.HistoryServeWindow {
+		return common.Hash{}
+	}
+	return k.GetState(ctx, params.HistoryStorageAddress, historyStorageSlot(height))
+}
+
+// deployHistoryStorage sets the code of the EIP-2935 history storage contract
+// at its canonical address if it is not set yet.
+func (k *Keeper) deployHistoryStorage(ctx sdk.Context) {
+	address := params.HistoryStorageAddress
+	if !types.IsEmptyCodeHash(k.GetCodeHash(ctx, address).Bytes()) {
+		return
+	}
+
+	// the address may already have an account if it received funds
+	accAddress := sdk.AccAddress(address.Bytes())
+	if !k.accountKeeper.HasAccount(ctx, accAddress) {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, accAddress))
+	}
+
+	codeHash := crypto.Keccak256(params.HistoryStorageCode)
+	k.SetCodeHash(ctx, address.Bytes(), codeHash)
+	k.SetCode(ctx, codeHash, params.HistoryStorageCode)
+}
+
+// historyStorageSlot returns the storage slot of the history storage contract
+// holding the hash of the given block.
+func historyStorageSlot(height uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(height % types.HistoryServeWindow))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/holiman/uint256"
 
 	"github.com/cosmos/evm/utils"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/evm/x/vm/wrappers"
@@ -75,6 +76,11 @@ type Keeper struct {
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// storageProofMaxSlots is the max number of storage slots of an account for
+	// the StorageProof query to build its storage trie. The query is disabled
+	// if it is zero.
+	storageProofMaxSlots int
 }
 
 // NewKeeper generates new evm module keeper
@@ -182,6 +188,14 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxIndex))
 }
 
+// WithStorageProofs enables the StorageProof query for the accounts with up to
+// maxSlots storage slots, and for up to maxSlots storage keys. The storage trie
+// is built on demand for each query, so the query is disabled by default.
+func (k *Keeper) WithStorageProofs(maxSlots int) *Keeper {
+	k.storageProofMaxSlots = maxSlots
+	return k
+}
+
 // ----------------------------------------------------------------------------
 // Hooks
 // ----------------------------------------------------------------------------
@@ -372,6 +386,55 @@ func (k Keeper) SetTransientGasUsed(ctx sdk.Context, gasUsed uint64) {
 	store.Set(types.KeyPrefixTransientGasUsed, bz)
 }
 
+// SetTransientFeePayer sets the account that paid the fees of the current
+// cosmos tx when it differs from the sender, i.e. a fee granter. An empty
+// address clears it. Called in the ante handler.
+func (k Keeper) SetTransientFeePayer(ctx sdk.Context, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	if feePayer.Empty() {
+		store.Delete(types.KeyPrefixTransientFeePayer)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeePayer, feePayer.Bytes())
+}
+
+// GetTransientFeePayer returns the account that paid the fees of the current
+// cosmos tx if it differs from the sender, or nil otherwise.
+func (k Keeper) GetTransientFeePayer(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientFeePayer)
+	if len(bz) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// SetTransientFeeToken sets the fee token in which the fees of the current
+// cosmos tx were paid, with the conversion rate applied, when they weren't
+// paid in the EVM coin. A nil fee token clears it. Called in the ante handler.
+func (k Keeper) SetTransientFeeToken(ctx sdk.Context, feeToken *feemarkettypes.FeeToken) {
+	store := ctx.TransientStore(k.transientKey)
+	if feeToken == nil {
+		store.Delete(types.KeyPrefixTransientFeeToken)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeeToken, k.cdc.MustMarshal(feeToken))
+}
+
+// GetTransientFeeToken returns the fee token in which the fees of the current
+// cosmos tx were paid, or nil if they were paid in the EVM coin.
+func (k Keeper) GetTransientFeeToken(ctx sdk.Context) *feemarkettypes.FeeToken {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientFeeToken)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var feeToken feemarkettypes.FeeToken
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return &feeToken
+}
+
 // AddTransientGasUsed accumulate gas used by each eth msgs included in current cosmos tx.
 func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, error) {
 	result := k.GetTransientGasUsed(ctx) + gasUsed
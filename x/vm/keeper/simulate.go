@@ -0,0 +1,411 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulatedBlockTime is the number of seconds between two simulated blocks
+// when the timestamp of a block is not overridden.
+const simulatedBlockTime = 1
+
+// simulator executes the calls of an eth_simulateV1 request block by block on
+// top of the state of the given context. All the state changes, including the
+// state overrides, are committed to the context, which must be a branch of the
+// query state that is discarded afterwards.
+type simulator struct {
+	k    *Keeper
+	ctx  sdk.Context
+	cfg  *statedb.EVMConfig
+	opts types.SimOpts
+
+	// gasRemaining is the gas budget left for the remaining calls, unless
+	// the budget is unlimited.
+	gasRemaining uint64
+	unlimitedGas bool
+}
+
+// simulate runs the simulation and returns the resulting blocks, including
+// the empty blocks filling the gaps between block number overrides.
+func (k *Keeper) simulate(ctx sdk.Context, cfg *statedb.EVMConfig, opts types.SimOpts, gasCap uint64) ([]types.SimBlockResult, error) {
+	ctx = k.SetConsensusParamsInCtx(ctx)
+	headers, err := simulatedHeaders(ctx, opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &simulator{
+		k:            k,
+		ctx:          ctx,
+		cfg:          cfg,
+		opts:         opts,
+		gasRemaining: gasCap,
+		unlimitedGas: gasCap == 0,
+	}
+
+	results := make([]types.SimBlockResult, 0, len(headers))
+	for _, header := range headers {
+		block := types.SimBlock{BlockOverrides: header.overrides}
+		if header.index >= 0 {
+			block = opts.BlockStateCalls[header.index]
+			block.BlockOverrides = header.overrides
+		}
+		result, err := s.processBlock(block)
+		if err != nil {
+			if header.index >= 0 {
+				return nil, fmt.Errorf("block %d: %w", header.index, err)
+			}
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// simulatedHeader is the sanitized block overrides of a simulated block and
+// the index of its input block, or -1 for the blocks filling the gaps.
+type simulatedHeader struct {
+	overrides *types.BlockOverrides
+	index     int
+}
+
+// simulatedHeaders sets the number and timestamp of the simulated blocks,
+// checking they are strictly increasing, and fills the gaps between block
+// number overrides with empty blocks.
+func simulatedHeaders(ctx sdk.Context, blocks []types.SimBlock) ([]simulatedHeader, error) {
+	var (
+		prevNumber = uint64(ctx.BlockHeight())             //#nosec G115 -- block height is positive
+		prevTime   = uint64(ctx.BlockHeader().Time.Unix()) //#nosec G115 -- block time is positive
+		headers    = make([]simulatedHeader, 0, len(blocks))
+	)
+
+	for i, block := range blocks {
+		overrides := types.BlockOverrides{}
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		number := prevNumber + 1
+		if overrides.Number != nil {
+			n := overrides.Number.ToInt()
+			if !n.IsUint64() || n.Uint64() <= prevNumber {
+				return nil, fmt.Errorf("block numbers must be in order: %s <= %d", n, prevNumber)
+			}
+			number = n.Uint64()
+		}
+		if number-prevNumber+uint64(len(headers)) > types.MaxSimulateBlocks {
+			return nil, fmt.Errorf("too many blocks: more than %d", types.MaxSimulateBlocks)
+		}
+
+		// fill the gap with empty blocks
+		for n := prevNumber + 1; n < number; n++ {
+			prevTime += simulatedBlockTime
+			timestamp := hexutil.Uint64(prevTime)
+			headers = append(headers, simulatedHeader{
+				overrides: &types.BlockOverrides{
+					Number: (*hexutil.Big)(new(big.Int).SetUint64(n)),
+					Time:   &timestamp,
+				},
+				index: -1,
+			})
+		}
+
+		timestamp := prevTime + simulatedBlockTime
+		if overrides.Time != nil {
+			if uint64(*overrides.Time) <= prevTime {
+				return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*overrides.Time), prevTime)
+			}
+			timestamp = uint64(*overrides.Time)
+		}
+
+		overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(number))
+		overrides.Time = (*hexutil.Uint64)(&timestamp)
+		headers = append(headers, simulatedHeader{overrides: &overrides, index: i})
+
+		prevNumber, prevTime = number, timestamp
+	}
+	return headers, nil
+}
+
+// processBlock applies the state overrides of the block and executes its
+// calls sequentially.
+func (s *simulator) processBlock(block types.SimBlock) (types.SimBlockResult, error) {
+	cfg := *s.cfg
+	cfg.BlockOverrides = block.BlockOverrides
+	cfg.StateOverrides = nil
+	if block.BlockOverrides.FeeRecipient != nil {
+		cfg.CoinBase = *block.BlockOverrides.FeeRecipient
+	}
+	switch {
+	case block.BlockOverrides.BaseFee != nil:
+		cfg.BaseFee = block.BlockOverrides.BaseFee.ToInt()
+	case !s.opts.Validation:
+		// calls are free unless the validation is enabled
+		cfg.BaseFee = new(big.Int)
+	}
+
+	if len(block.StateOverrides) > 0 {
+		stateDB := statedb.New(s.ctx, s.k, statedb.NewEmptyTxConfig(common.Hash{}))
+		if err := stateDB.ApplyStateOverride(block.StateOverrides); err != nil {
+			return types.SimBlockResult{}, err
+		}
+		if err := stateDB.Commit(); err != nil {
+			return types.SimBlockResult{}, err
+		}
+	}
+
+	var (
+		number   = block.BlockOverrides.Number.ToInt().Uint64()
+		gasLimit = cosmosevmtypes.BlockGasLimit(s.ctx)
+		gasUsed  uint64
+		logIndex uint
+	)
+	if gasLimit == 0 {
+		// the block gas is not limited by the consensus params
+		gasLimit = math.MaxUint64
+	}
+
+	result := types.SimBlockResult{
+		Number:       hexutil.Uint64(number),
+		Time:         *block.BlockOverrides.Time,
+		GasLimit:     hexutil.Uint64(gasLimit),
+		FeeRecipient: cfg.CoinBase,
+		Transactions: make([]types.TransactionArgs, 0, len(block.Calls)),
+		Calls:        make([]types.SimCallResult, 0, len(block.Calls)),
+	}
+	if cfg.BaseFee != nil {
+		result.BaseFee = (*hexutil.Big)(cfg.BaseFee)
+	}
+
+	for i, args := range block.Calls {
+		if err := s.sanitizeCall(&args, gasLimit-gasUsed); err != nil {
+			return types.SimBlockResult{}, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		tx := args.ToTransaction()
+		txConfig := statedb.NewTxConfig(common.Hash{}, common.HexToHash(tx.Hash), uint(i), logIndex)
+		callResult, err := s.processCall(args, &cfg, txConfig)
+		if err != nil {
+			return types.SimBlockResult{}, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		for _, log := range callResult.Logs {
+			log.BlockNumber = number
+			log.TxHash = txConfig.TxHash
+			log.TxIndex = txConfig.TxIndex
+			log.Index = logIndex
+			logIndex++
+		}
+
+		gasUsed += uint64(callResult.GasUsed)
+		result.Transactions = append(result.Transactions, args)
+		result.Calls = append(result.Calls, callResult)
+	}
+
+	result.GasUsed = hexutil.Uint64(gasUsed)
+	return result, nil
+}
+
+// sanitizeCall fills in the sender, nonce, gas limit and chain id of a call
+// that doesn't set them and, if the validation is enabled, checks the nonce.
+func (s *simulator) sanitizeCall(args *types.TransactionArgs, blockGasRemaining uint64) error {
+	from := args.GetFrom()
+	args.From = &from
+
+	nonce := s.k.GetNonce(s.ctx, from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	} else if s.opts.Validation && uint64(*args.Nonce) != nonce {
+		return fmt.Errorf("invalid nonce for %s: got %d, expected %d", from.Hex(), uint64(*args.Nonce), nonce)
+	}
+
+	if args.Gas == nil {
+		gas := blockGasRemaining
+		if !s.unlimitedGas && gas > s.gasRemaining {
+			gas = s.gasRemaining
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	if uint64(*args.Gas) > blockGasRemaining {
+		return fmt.Errorf("block gas limit reached: %d > %d", uint64(*args.Gas), blockGasRemaining)
+	}
+	if !s.unlimitedGas && uint64(*args.Gas) > s.gasRemaining {
+		return fmt.Errorf("gas budget exhausted: %d > %d", uint64(*args.Gas), s.gasRemaining)
+	}
+
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(types.GetEthChainConfig().ChainID)
+	}
+	return nil
+}
+
+// processCall executes a single call, increasing the sender nonce and, if the
+// validation is enabled, charging the fees for the gas used.
+func (s *simulator) processCall(args types.TransactionArgs, cfg *statedb.EVMConfig, txConfig statedb.TxConfig) (types.SimCallResult, error) {
+	msg, err := args.ToMessage(0, cfg.BaseFee, !s.opts.Validation, true)
+	if err != nil {
+		return types.SimCallResult{}, err
+	}
+
+	if s.opts.Validation {
+		if cfg.BaseFee != nil && msg.GasFeeCap.Cmp(cfg.BaseFee) < 0 {
+			return types.SimCallResult{}, fmt.Errorf("max fee per gas less than block base fee: %s < %s", msg.GasFeeCap, cfg.BaseFee)
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+		cost.Add(cost, msg.Value)
+		if balance := s.k.GetBalance(s.ctx, msg.From); balance.ToBig().Cmp(cost) < 0 {
+			return types.SimCallResult{}, fmt.Errorf("insufficient funds for gas * price + value: address %s have %s want %s", msg.From.Hex(), balance, cost)
+		}
+	}
+
+	var (
+		tracer    *tracing.Hooks
+		transfers *transferTracer
+	)
+	if s.opts.TraceTransfers {
+		transfers = &transferTracer{}
+		tracer = transfers.Hooks()
+	}
+
+	res, err := s.k.ApplyMessageWithConfig(s.ctx, msg, tracer, true, cfg, txConfig, false)
+	if err != nil {
+		return types.SimCallResult{}, err
+	}
+
+	// the ante handler is skipped, so the sender nonce and fees are handled
+	// here. Contract creations and set code calls already increase the nonce.
+	stateDB := statedb.New(s.ctx, s.k, txConfig)
+	if msg.To != nil && msg.SetCodeAuthorizations == nil {
+		stateDB.SetNonce(msg.From, stateDB.GetNonce(msg.From)+1, tracing.NonceChangeEoACall)
+	}
+	if s.opts.Validation {
+		fees := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), msg.GasPrice)
+		stateDB.SubBalance(msg.From, uint256.MustFromBig(fees), tracing.BalanceDecreaseGasBuy)
+	}
+	if err := stateDB.Commit(); err != nil {
+		return types.SimCallResult{}, err
+	}
+
+	if !s.unlimitedGas {
+		s.gasRemaining -= res.GasUsed
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if transfers != nil {
+		logs = transfers.mergeLogs(logs)
+	}
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	result := types.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := types.NewExecErrorWithReason(res.Ret)
+			result.Error = &types.SimCallError{
+				Message: revertErr.Error(),
+				Code:    types.SimErrCodeReverted,
+				Data:    revertErr.ErrorData().(string),
+			}
+		} else {
+			result.Error = &types.SimCallError{
+				Message: res.VmError,
+				Code:    types.SimErrCodeVMError,
+			}
+		}
+	}
+	return result, nil
+}
+
+// transferLog is a native value transfer log and the number of logs emitted
+// by the call before it.
+type transferLog struct {
+	position int
+	log      *ethtypes.Log
+}
+
+// transferTracer records the native value transfers of a call as logs,
+// dropping the transfers of the call frames that are reverted.
+type transferTracer struct {
+	stateDB   interface{ Logs() []*ethtypes.Log }
+	frames    [][]transferLog
+	transfers []transferLog
+}
+
+// Hooks returns the tracing hooks of the tracer.
+func (t *transferTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: t.onTxStart,
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *transferTracer) onTxStart(vmCtx *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.stateDB, _ = vmCtx.StateDB.(interface{ Logs() []*ethtypes.Log })
+}
+
+func (t *transferTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if value == nil || value.Sign() == 0 || vm.OpCode(typ) == vm.DELEGATECALL {
+		return
+	}
+
+	position := 0
+	if t.stateDB != nil {
+		position = len(t.stateDB.Logs())
+	}
+	last := len(t.frames) - 1
+	t.frames[last] = append(t.frames[last], transferLog{
+		position: position,
+		log:      types.NewTransferLog(from, to, value),
+	})
+}
+
+func (t *transferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	last := len(t.frames) - 1
+	frame := t.frames[last]
+	t.frames = t.frames[:last]
+	if reverted {
+		return
+	}
+	if last == 0 {
+		t.transfers = append(t.transfers, frame...)
+		return
+	}
+	t.frames[last-1] = append(t.frames[last-1], frame...)
+}
+
+// mergeLogs interleaves the recorded transfer logs with the logs emitted by
+// the call, in execution order.
+func (t *transferTracer) mergeLogs(logs []*ethtypes.Log) []*ethtypes.Log {
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.transfers))
+	i := 0
+	for _, transfer := range t.transfers {
+		for ; i < transfer.position && i < len(logs); i++ {
+			merged = append(merged, logs[i])
+		}
+		merged = append(merged, transfer.log)
+	}
+	return append(merged, logs[i:]...)
+}
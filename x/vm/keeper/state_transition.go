@@ -54,6 +54,7 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	ethCfg := types.GetEthChainConfig()
 	txCtx := core.NewEVMTxContext(&msg)
@@ -111,8 +112,13 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 			return common.BytesToHash(headerHash)
 
 		case ctx.BlockHeight() > h:
-			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
-			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// Case 2: if the chain is not the current height we need to retrieve the hash from the EIP-2935
+			// history storage. This only applies if the current height is greater than the requested height.
+			if hash := k.GetHistoricalBlockHash(ctx, height); hash != (common.Hash{}) {
+				return hash
+			}
+
+			// Blocks that precede the history storage are retrieved from the staking historical info.
 			histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if err != nil {
 				k.Logger(ctx).Debug("error while getting historical info", "height", h, "error", err.Error())
@@ -335,12 +341,24 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer *tracing
 //
 // If commit is true, the `StateDB` will be committed, otherwise discarded.
 func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, tracer *tracing.Hooks, commit bool, cfg *statedb.EVMConfig, txConfig statedb.TxConfig, internal bool) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig, internal)
+	return res, err
+}
+
+// applyMessageWithConfig implements ApplyMessageWithConfig and also returns
+// the StateDB the message was applied to.
+func (k *Keeper) applyMessageWithConfig(ctx sdk.Context, msg core.Message, tracer *tracing.Hooks, commit bool, cfg *statedb.EVMConfig, txConfig statedb.TxConfig, internal bool) (*types.MsgEthereumTxResponse, *statedb.StateDB, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.StateOverrides != nil {
+		if err := stateDB.ApplyStateOverride(cfg.StateOverrides); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.GasLimit
@@ -366,27 +384,38 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	contractCreation := msg.To == nil
 	isLondon := ethCfg.IsLondon(evm.Context.BlockNumber)
 
+	// EIP-7702 set code txs cannot create contracts and must carry at least one
+	// authorization. Checked here as well because eth_call doesn't go through the Ante Handler.
+	if msg.SetCodeAuthorizations != nil {
+		if contractCreation {
+			return nil, nil, errorsmod.Wrapf(core.ErrSetCodeTxCreate, "sender %s", msg.From)
+		}
+		if len(msg.SetCodeAuthorizations) == 0 {
+			return nil, nil, errorsmod.Wrapf(core.ErrEmptyAuthList, "sender %s", msg.From)
+		}
+	}
+
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, ethCfg, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas -= intrinsicGas
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	rules := ethCfg.Rules(evm.Context.BlockNumber, true, evm.Context.Time)
 	stateDB.Prepare(rules, msg.From, common.Address{}, msg.To, evm.ActivePrecompiles(), msg.AccessList)
 
 	convertedValue, err := utils.Uint256FromBigInt(msg.Value)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if contractCreation {
@@ -397,6 +426,23 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 		ret, _, leftoverGas, vmErr = evm.Create(sender.Address(), msg.Data, leftoverGas, convertedValue)
 		stateDB.SetNonce(sender.Address(), msg.Nonce+1, tracing.NonceChangeContractCreator)
 	} else {
+		if msg.SetCodeAuthorizations != nil {
+			// the sender nonce is increased before the authorizations are applied, so an
+			// authorization signed by the sender must use the next nonce. This is a no-op
+			// for txs, as the nonce is already increased on the Ante Handler.
+			stateDB.SetNonce(sender.Address(), msg.Nonce+1, tracing.NonceChangeEoACall)
+			for _, auth := range msg.SetCodeAuthorizations {
+				// invalid authorizations are skipped, as defined by EIP-7702
+				_ = k.applyAuthorization(stateDB, &auth)
+			}
+		}
+
+		// warm the delegation target of the recipient, now that the final state
+		// of the delegations is known
+		if target, ok := stateDB.GetDelegation(*msg.To); ok {
+			stateDB.AddAddressToAccessList(target)
+		}
+
 		ret, leftoverGas, vmErr = evm.Call(sender.Address(), *msg.To, msg.Data, leftoverGas, convertedValue)
 	}
 
@@ -413,7 +459,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 
 	// calculate gas refund
 	if msg.GasLimit < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	// refund gas
 	temporaryGasUsed := msg.GasLimit - leftoverGas
@@ -432,7 +478,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+			return nil, nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
 	}
 
@@ -444,11 +490,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if !minimumGasUsed.TruncateInt().IsUint64() {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
 	}
 
 	if msg.GasLimit < leftoverGas {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
 	}
 
 	gasUsed := math.LegacyNewDec(int64(temporaryGasUsed)) //#nosec G115 -- int overflow is not a concern here
@@ -469,7 +515,47 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 		Ret:     ret,
 		Logs:    types.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, stateDB, nil
+}
+
+// applyAuthorization validates the given EIP-7702 authorization against the
+// current state and, if valid, installs the delegation designator on the
+// authority account and increases its nonce.
+func (k *Keeper) applyAuthorization(stateDB *statedb.StateDB, auth *ethtypes.SetCodeAuthorization) error {
+	// verify the chain ID is zero or equal to the current chain ID
+	if !auth.ChainID.IsZero() && auth.ChainID.CmpBig(types.GetEthChainConfig().ChainID) != 0 {
+		return core.ErrAuthorizationWrongChainID
+	}
+	// limit the nonce to 2^64-1 per EIP-2681
+	if auth.Nonce+1 < auth.Nonce {
+		return core.ErrAuthorizationNonceOverflow
+	}
+	authority, err := auth.Authority()
+	if err != nil {
+		return errorsmod.Wrap(core.ErrAuthorizationInvalidSignature, err.Error())
+	}
+
+	// the authority is added to the access list even if the authorization is invalid
+	stateDB.AddAddressToAccessList(authority)
+
+	// the authority must not have code, unless it is an existing delegation
+	code := stateDB.GetCode(authority)
+	if _, ok := ethtypes.ParseDelegation(code); len(code) != 0 && !ok {
+		return core.ErrAuthorizationDestinationHasCode
+	}
+	if stateDB.GetNonce(authority) != auth.Nonce {
+		return core.ErrAuthorizationNonceMismatch
+	}
+
+	// refund the new account cost charged in the intrinsic gas if the account
+	// already exists
+	if stateDB.Exist(authority) {
+		stateDB.AddRefund(params.CallNewAccountGas - params.TxAuthTupleGas)
+	}
+
+	stateDB.SetNonce(authority, auth.Nonce+1, tracing.NonceChangeAuthorization)
+	stateDB.SetDelegation(authority, auth.Address)
+	return nil
 }
 
 // SetConsensusParamsInCtx will return the original context if consensus params already exist in it, otherwise, it will
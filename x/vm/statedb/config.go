@@ -44,4 +44,9 @@ type EVMConfig struct {
 	CoinBase                common.Address
 	BaseFee                 *big.Int
 	EnablePreimageRecording bool
+	// BlockOverrides and StateOverrides are only set for simulated calls
+	// (eth_call and eth_estimateGas) to alter the block context and the
+	// state the message is executed on.
+	BlockOverrides *types.BlockOverrides
+	StateOverrides types.StateOverride
 }
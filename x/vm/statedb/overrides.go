@@ -0,0 +1,57 @@
+package statedb
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+// ApplyStateOverride overrides the fields of the specified accounts before
+// executing a simulated call (eth_call, eth_estimateGas). The accounts are
+// applied in address order so the journal is deterministic.
+func (s *StateDB) ApplyStateOverride(override types.StateOverride) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	addrs := make([]common.Address, 0, len(override))
+	for addr := range override {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	for _, addr := range addrs {
+		account := override[addr]
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			balance, overflow := uint256.FromBig((*account.Balance).ToInt())
+			if overflow || (*account.Balance).ToInt().Sign() < 0 {
+				return fmt.Errorf("invalid balance override for account %s", addr.Hex())
+			}
+			s.SetBalance(addr, balance)
+		}
+		if account.State != nil {
+			s.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			diff := Storage(*account.StateDiff)
+			for _, key := range diff.SortedKeys() {
+				s.SetState(addr, key, diff[key])
+			}
+		}
+	}
+	return nil
+}
@@ -73,6 +73,9 @@ type stateObject struct {
 	dirtyCode      bool
 	selfDestructed bool
 	newContract    bool
+	// storageOverridden marks the storage as fully replaced (see SetStorage),
+	// so slots that are not cached are empty instead of loaded from the keeper
+	storageOverridden bool
 }
 
 // newObject creates a state object.
@@ -231,6 +234,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.storageOverridden {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
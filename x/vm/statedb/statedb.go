@@ -459,6 +459,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int, reason tr
 	return stateObject.SubBalance(amount)
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64, reason tracing.NonceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -478,6 +486,23 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) []byte {
 	return prev
 }
 
+// GetDelegation returns the address the code of the given account is
+// delegated to, if the account code is an EIP-7702 delegation designator.
+func (s *StateDB) GetDelegation(addr common.Address) (common.Address, bool) {
+	return ethtypes.ParseDelegation(s.GetCode(addr))
+}
+
+// SetDelegation installs an EIP-7702 delegation designator to the target as
+// the code of the given account. Delegating to the zero address clears the
+// account code instead.
+func (s *StateDB) SetDelegation(addr, target common.Address) {
+	if target == (common.Address{}) {
+		s.SetCode(addr, nil)
+		return
+	}
+	s.SetCode(addr, ethtypes.AddressToDelegation(target))
+}
+
 // SetState sets the contract state.
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.Hash {
 	if stateObject := s.getOrNewStateObject(addr); stateObject != nil {
@@ -486,6 +511,22 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.H
 	return common.Hash{}
 }
 
+// SetStorage replaces the entire storage of the account with the given one,
+// any slot that is not present is considered empty. It's only meant to be used
+// to override the state of simulated calls and must not be committed.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	stateObject.originStorage = make(Storage)
+	stateObject.dirtyStorage = make(Storage)
+	stateObject.storageOverridden = true
+	for _, key := range storage.SortedKeys() {
+		stateObject.SetState(key, storage[key])
+	}
+}
+
 // SelfDestruct marks the given account as self-destructed.
 // This clears the account balance.
 //
@@ -679,6 +720,36 @@ func (s *StateDB) CommitWithCacheCtx() error {
 	return s.commitWithCtx(s.cacheCtx)
 }
 
+// DirtiesHash returns a deterministic digest of the dirty states that
+// Commit writes to the keeper: the nonce, balance and code hash of every dirty
+// account, followed by its dirty storage slots, in address and key order.
+// Self-destructed accounts only commit to their address.
+func (s *StateDB) DirtiesHash() common.Hash {
+	hasher := crypto.NewKeccakState()
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		hasher.Write(addr.Bytes())
+		if obj.selfDestructed {
+			hasher.Write([]byte{1})
+			continue
+		}
+		hasher.Write([]byte{0})
+		hasher.Write(sdk.Uint64ToBigEndian(obj.account.Nonce))
+		balance := obj.account.Balance.Bytes32()
+		hasher.Write(balance[:])
+		hasher.Write(obj.CodeHash())
+		for _, key := range obj.dirtyStorage.SortedKeys() {
+			value := obj.dirtyStorage[key]
+			hasher.Write(key.Bytes())
+			hasher.Write(value.Bytes())
+		}
+	}
+
+	var hash common.Hash
+	_, _ = hasher.Read(hash[:])
+	return hash
+}
+
 // commitWithCtx writes the dirty states to keeper
 // using the provided context
 func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
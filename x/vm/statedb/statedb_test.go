@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/evm/x/vm/types/mocks"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -219,6 +221,84 @@ func (suite *StateDBTestSuite) TestAccountOverride() {
 	suite.Require().Equal(uint64(0), db.GetNonce(address))
 }
 
+func (suite *StateDBTestSuite) TestStateOverride() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	ctx := sdk.Context{}
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(ctx, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	db.SetState(address2, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	nonce := hexutil.Uint64(5)
+	code := hexutil.Bytes("hello world")
+	balance := (*hexutil.Big)(big.NewInt(100))
+	state := map[common.Hash]common.Hash{key2: value2}
+	stateDiff := map[common.Hash]common.Hash{key2: value2}
+
+	testCases := []struct {
+		name     string
+		override types.StateOverride
+		expPass  bool
+		malleate func(*statedb.StateDB)
+	}{
+		{
+			"fail - both state and stateDiff",
+			types.StateOverride{address: {State: &state, StateDiff: &stateDiff}},
+			false,
+			nil,
+		},
+		{
+			"pass - account fields",
+			types.StateOverride{address: {Nonce: &nonce, Code: &code, Balance: &balance}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(uint64(5), db.GetNonce(address))
+				suite.Require().Equal([]byte(code), db.GetCode(address))
+				suite.Require().Equal(uint256.NewInt(100), db.GetBalance(address))
+				suite.Require().Equal(value1, db.GetState(address, key1))
+			},
+		},
+		{
+			"pass - state replaces the whole storage",
+			types.StateOverride{address: {State: &state}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+				suite.Require().Equal(value2, db.GetState(address, key2))
+				// other accounts are untouched
+				suite.Require().Equal(value1, db.GetState(address2, key1))
+			},
+		},
+		{
+			"pass - stateDiff keeps the existing storage",
+			types.StateOverride{address: {StateDiff: &stateDiff}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(value1, db.GetState(address, key1))
+				suite.Require().Equal(value2, db.GetState(address, key2))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			db := statedb.New(ctx, keeper, emptyTxConfig)
+			err := db.ApplyStateOverride(tc.override)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.malleate(db)
+		})
+	}
+}
+
 func (suite *StateDBTestSuite) TestDBError() {
 	testCases := []struct {
 		name     string
@@ -690,6 +770,39 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestDirtiesHash() {
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+
+	newDB := func() *statedb.StateDB {
+		return statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	}
+
+	db := newDB()
+	emptyHash := db.DirtiesHash()
+
+	db.SetState(address, key, value)
+	db.AddBalance(address2, uint256.NewInt(10), tracing.BalanceChangeUnspecified)
+	hash := db.DirtiesHash()
+	suite.Require().NotEqual(emptyHash, hash)
+
+	// the hash doesn't depend on the order of the changes
+	db = newDB()
+	db.AddBalance(address2, uint256.NewInt(10), tracing.BalanceChangeUnspecified)
+	db.SetState(address, key, value)
+	suite.Require().Equal(hash, db.DirtiesHash())
+
+	// reverted changes are not committed
+	snapshot := db.Snapshot()
+	db.SetNonce(address3, 1, tracing.NonceChangeUnspecified)
+	suite.Require().NotEqual(hash, db.DirtiesHash())
+	db.RevertToSnapshot(snapshot)
+	suite.Require().Equal(hash, db.DirtiesHash())
+
+	db.SetState(address, key, common.BigToHash(big.NewInt(3)))
+	suite.Require().NotEqual(hash, db.DirtiesHash())
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	stDB, ok := db.(*statedb.StateDB)
@@ -0,0 +1,255 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	// register the callTracer wrapped by the precompile tracer
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrecompileTracerName is the name of the precompile tracer in the tracers
+// directory.
+const PrecompileTracerName = "precompileTracer"
+
+func init() {
+	ethtracers.DefaultDirectory.Register(PrecompileTracerName, func(ctx *ethtracers.Context, cfg json.RawMessage, chainConfig *ethparams.ChainConfig) (*ethtracers.Tracer, error) {
+		tracer, _, err := NewPrecompileTracer(ctx, cfg, chainConfig)
+		return tracer, err
+	}, false)
+}
+
+// PrecompileCall annotates a precompile call frame with the Cosmos SDK side of
+// its execution.
+type PrecompileCall struct {
+	Method        string                      `json:"method"`
+	Args          map[string]interface{}      `json:"args,omitempty"`
+	Msgs          []CosmosMsg                 `json:"cosmosMsgs,omitempty"`
+	Events        []CosmosEvent               `json:"cosmosEvents,omitempty"`
+	BalanceDeltas map[common.Address]*big.Int `json:"balanceDeltas,omitempty"`
+}
+
+// CosmosMsg is an sdk.Msg executed by a precompile.
+type CosmosMsg struct {
+	Type string          `json:"@type"`
+	Msg  json.RawMessage `json:"value"`
+}
+
+// CosmosEvent is a Cosmos event emitted by a precompile.
+type CosmosEvent struct {
+	Type       string         `json:"type"`
+	Attributes []KeyValuePair `json:"attributes"`
+}
+
+// KeyValuePair is an attribute of a Cosmos event.
+type KeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// callNode mirrors a call frame of the wrapped callTracer.
+type callNode struct {
+	precompile *PrecompileCall
+	calls      []*callNode
+}
+
+// precompileTracer is a callTracer that annotates the precompile call frames
+// with the decoded ABI method, the sdk.Msgs executed, the Cosmos events emitted
+// and the signed EVM balance deltas of the precompile.
+type precompileTracer struct {
+	callTracer *ethtracers.Tracer
+
+	mu    sync.Mutex
+	root  *callNode
+	stack []*callNode
+}
+
+var _ cmn.PrecompileTracer = &precompileTracer{}
+
+// NewPrecompileTracer returns a tracer accepting the callTracer config and
+// returning its output, with a "precompile" field on the precompile frames.
+// The returned precompile tracer must be set on the context of the traced
+// execution with cmn.WithPrecompileTracer to be notified by the precompiles.
+func NewPrecompileTracer(ctx *ethtracers.Context, cfg json.RawMessage, chainConfig *ethparams.ChainConfig) (*ethtracers.Tracer, cmn.PrecompileTracer, error) {
+	callTracer, err := ethtracers.DefaultDirectory.New("callTracer", ctx, cfg, chainConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := &precompileTracer{callTracer: callTracer}
+	hooks := *callTracer.Hooks
+	hooks.OnEnter = t.OnEnter
+	hooks.OnExit = t.OnExit
+
+	return &ethtracers.Tracer{
+		Hooks:     &hooks,
+		GetResult: t.GetResult,
+		Stop:      callTracer.Stop,
+	}, t, nil
+}
+
+func (t *precompileTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.callTracer.OnEnter(depth, typ, from, to, input, gas, value)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	node := &callNode{}
+	if depth == 0 {
+		t.root = node
+		t.stack = []*callNode{node}
+		return
+	}
+	if len(t.stack) == 0 {
+		return
+	}
+	parent := t.stack[len(t.stack)-1]
+	parent.calls = append(parent.calls, node)
+	t.stack = append(t.stack, node)
+}
+
+func (t *precompileTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	t.callTracer.OnExit(depth, output, gasUsed, err, reverted)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if depth > 0 && len(t.stack) > 1 {
+		t.stack = t.stack[:len(t.stack)-1]
+	}
+}
+
+// current returns the annotation of the call being executed.
+func (t *precompileTracer) current() *PrecompileCall {
+	if len(t.stack) == 0 {
+		return nil
+	}
+	node := t.stack[len(t.stack)-1]
+	if node.precompile == nil {
+		node.precompile = &PrecompileCall{}
+	}
+	return node.precompile
+}
+
+// OnPrecompileCall implements the cmn.PrecompileTracer interface.
+func (t *precompileTracer) OnPrecompileCall(_ common.Address, method *abi.Method, args []interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	call := t.current()
+	if call == nil {
+		return
+	}
+	call.Method = method.Sig
+	if len(args) == 0 {
+		return
+	}
+	call.Args = make(map[string]interface{}, len(args))
+	for i, arg := range args {
+		name := method.Inputs[i].Name
+		if name == "" {
+			name = method.Inputs[i].Type.String()
+		}
+		call.Args[name] = arg
+	}
+}
+
+// OnCosmosMsg implements the cmn.PrecompileTracer interface.
+func (t *precompileTracer) OnCosmosMsg(msg sdk.Msg) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	call := t.current()
+	if call == nil {
+		return
+	}
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		// keep the message type even if its fields can't be encoded
+		bz = []byte("null")
+	}
+	call.Msgs = append(call.Msgs, CosmosMsg{Type: sdk.MsgTypeURL(msg), Msg: bz})
+}
+
+// OnCosmosEvents implements the cmn.PrecompileTracer interface.
+func (t *precompileTracer) OnCosmosEvents(events sdk.Events) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	call := t.current()
+	if call == nil {
+		return
+	}
+	for _, event := range events {
+		attrs := make([]KeyValuePair, len(event.Attributes))
+		for i, attr := range event.Attributes {
+			attrs[i] = KeyValuePair{Key: attr.Key, Value: attr.Value}
+		}
+		call.Events = append(call.Events, CosmosEvent{Type: event.Type, Attributes: attrs})
+	}
+}
+
+// OnBalanceChange implements the cmn.PrecompileTracer interface.
+func (t *precompileTracer) OnBalanceChange(entry cmn.BalanceChangeEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	call := t.current()
+	if call == nil {
+		return
+	}
+	if call.BalanceDeltas == nil {
+		call.BalanceDeltas = make(map[common.Address]*big.Int)
+	}
+	delta, ok := call.BalanceDeltas[entry.Account]
+	if !ok {
+		delta = new(big.Int)
+		call.BalanceDeltas[entry.Account] = delta
+	}
+	if entry.Op == cmn.Sub {
+		delta.Sub(delta, entry.Amount.ToBig())
+	} else {
+		delta.Add(delta, entry.Amount.ToBig())
+	}
+}
+
+// GetResult returns the callTracer result with the precompile annotations.
+func (t *precompileTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.callTracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.root == nil {
+		return res, nil
+	}
+	var frame map[string]interface{}
+	if err := json.Unmarshal(res, &frame); err != nil {
+		return nil, err
+	}
+	annotate(frame, t.root)
+	return json.Marshal(frame)
+}
+
+// annotate sets the precompile annotations of the call tree on the callTracer
+// frames. The frames missing from the callTracer output, as with the
+// onlyTopCall option, are skipped.
+func annotate(frame map[string]interface{}, node *callNode) {
+	if node.precompile != nil {
+		frame["precompile"] = node.precompile
+	}
+	calls, _ := frame["calls"].([]interface{})
+	for i, child := range node.calls {
+		if i >= len(calls) {
+			return
+		}
+		if childFrame, ok := calls[i].(map[string]interface{}); ok {
+			annotate(childFrame, child)
+		}
+	}
+}
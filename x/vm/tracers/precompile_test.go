@@ -0,0 +1,86 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPrecompileTracer(t *testing.T) {
+	var (
+		sender     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		precompile = common.HexToAddress("0x0000000000000000000000000000000000000800")
+	)
+
+	uint256Ty, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	method := abi.NewMethod("delegate", "delegate", abi.Function, "nonpayable", false, false,
+		abi.Arguments{{Name: "amount", Type: uint256Ty}}, nil)
+
+	tracer, registered, err := NewPrecompileTracer(&ethtracers.Context{}, nil, params.TestChainConfig)
+	require.NoError(t, err)
+	// the tracer is also available by name, without the precompile annotations
+	_, err = ethtracers.DefaultDirectory.New(PrecompileTracerName, &ethtracers.Context{}, nil, params.TestChainConfig)
+	require.NoError(t, err)
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{To: &contract, Gas: 100000})
+	tracer.OnTxStart(&tracing.VMContext{}, tx, sender)
+	tracer.OnEnter(0, byte(vm.CALL), sender, contract, nil, 100000, big.NewInt(0))
+	tracer.OnEnter(1, byte(vm.CALL), contract, contract, nil, 1000, big.NewInt(0))
+	tracer.OnExit(1, nil, 100, nil, false)
+	tracer.OnEnter(1, byte(vm.CALL), contract, precompile, nil, 50000, big.NewInt(0))
+
+	// the precompile notifies the tracer carried by the context
+	registered.OnPrecompileCall(precompile, &method, []interface{}{big.NewInt(7)})
+	registered.OnCosmosMsg(&banktypes.MsgSend{FromAddress: "from", ToAddress: "to"})
+	registered.OnCosmosEvents(sdk.Events{sdk.NewEvent("delegate", sdk.NewAttribute("amount", "7"))})
+	registered.OnBalanceChange(cmn.NewBalanceChangeEntry(contract, uint256.NewInt(7), cmn.Sub))
+	registered.OnBalanceChange(cmn.NewBalanceChangeEntry(contract, uint256.NewInt(2), cmn.Add))
+
+	tracer.OnExit(1, nil, 30000, nil, false)
+	tracer.OnExit(0, nil, 40000, nil, false)
+	tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: 40000}, nil)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var frame struct {
+		To    common.Address `json:"to"`
+		Calls []struct {
+			To         common.Address  `json:"to"`
+			Precompile *PrecompileCall `json:"precompile"`
+		} `json:"calls"`
+		Precompile *PrecompileCall `json:"precompile"`
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+	require.Equal(t, contract, frame.To)
+	require.Nil(t, frame.Precompile)
+	require.Len(t, frame.Calls, 2)
+	require.Nil(t, frame.Calls[0].Precompile)
+
+	call := frame.Calls[1].Precompile
+	require.Equal(t, precompile, frame.Calls[1].To)
+	require.NotNil(t, call)
+	require.Equal(t, "delegate(uint256)", call.Method)
+	require.Equal(t, float64(7), call.Args["amount"])
+	require.Equal(t, []CosmosEvent{{Type: "delegate", Attributes: []KeyValuePair{{Key: "amount", Value: "7"}}}}, call.Events)
+	require.Equal(t, big.NewInt(-5), call.BalanceDeltas[contract])
+	require.Len(t, call.Msgs, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", call.Msgs[0].Type)
+	require.JSONEq(t, `{"from_address":"from","to_address":"to","amount":[]}`, string(call.Msgs[0].Msg))
+}
@@ -3,8 +3,22 @@ package types
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// FeeTokenAccessAddress is the access list address under which the fee token
+// of an Ethereum transaction is declared, which is the x/feemarket module
+// address.
+var FeeTokenAccessAddress = common.BytesToAddress(authtypes.NewModuleAddress(feemarkettypes.ModuleName))
+
 // AccessList is an EIP-2930 access list that represents the slice of
 // the protobuf AccessTuples.
 type AccessList []AccessTuple
@@ -53,3 +67,80 @@ func (al AccessList) ToEthAccessList() *ethtypes.AccessList {
 
 	return &ethAccessList
 }
+
+// NewFeeGranterAccessTuple returns the access tuple declaring the fee granter of
+// an Ethereum transaction, which pays the fees from its x/feegrant allowance to
+// the sender. The fee granter is declared as the storage key of the feegrant
+// precompile, so that it is covered by the transaction signature.
+func NewFeeGranterAccessTuple(feeGranter sdk.AccAddress) ethtypes.AccessTuple {
+	return ethtypes.AccessTuple{
+		Address:     common.HexToAddress(FeegrantPrecompileAddress),
+		StorageKeys: []common.Hash{common.BytesToHash(feeGranter)},
+	}
+}
+
+// GetFeeGranter returns the fee granter declared in the access list of an
+// Ethereum transaction, or nil if the sender pays the fees.
+func GetFeeGranter(accessList ethtypes.AccessList) (sdk.AccAddress, error) {
+	feegrantAddress := common.HexToAddress(FeegrantPrecompileAddress)
+
+	var feeGranter sdk.AccAddress
+	for _, tuple := range accessList {
+		if tuple.Address != feegrantAddress {
+			continue
+		}
+		for _, key := range tuple.StorageKeys {
+			if feeGranter != nil {
+				return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "the access list declares more than one fee granter")
+			}
+			// the address must be left padded with zeros
+			addr := common.BytesToAddress(key.Bytes())
+			if common.BytesToHash(addr.Bytes()) != key {
+				return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee granter %s", key)
+			}
+			feeGranter = addr.Bytes()
+		}
+	}
+	return feeGranter, nil
+}
+
+// FeeTokenStorageKey returns the access list storage key declaring the fee
+// token with the given denomination, which is the Keccak-256 hash of the
+// denomination.
+func FeeTokenStorageKey(denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom))
+}
+
+// NewFeeTokenAccessTuple returns the access tuple declaring the fee token of an
+// Ethereum transaction, which pays the fees in that token of the x/feemarket
+// fee token registry instead of the EVM coin. The fee token is declared as a
+// storage key of the x/feemarket module address, so that it is covered by the
+// transaction signature.
+func NewFeeTokenAccessTuple(denom string) ethtypes.AccessTuple {
+	return ethtypes.AccessTuple{
+		Address:     FeeTokenAccessAddress,
+		StorageKeys: []common.Hash{FeeTokenStorageKey(denom)},
+	}
+}
+
+// GetFeeTokenStorageKey returns the storage key of the fee token declared in
+// the access list of an Ethereum transaction, and whether a fee token is
+// declared.
+func GetFeeTokenStorageKey(accessList ethtypes.AccessList) (common.Hash, bool, error) {
+	var (
+		feeTokenKey common.Hash
+		found       bool
+	)
+	for _, tuple := range accessList {
+		if tuple.Address != FeeTokenAccessAddress {
+			continue
+		}
+		for _, key := range tuple.StorageKeys {
+			if found {
+				return common.Hash{}, false, errorsmod.Wrap(errortypes.ErrInvalidRequest, "the access list declares more than one fee token")
+			}
+			feeTokenKey, found = key, true
+		}
+	}
+	return feeTokenKey, found, nil
+}
@@ -5,6 +5,8 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (suite *TxDataTestSuite) TestTestNewAccessList() {
@@ -38,3 +40,97 @@ func (suite *TxDataTestSuite) TestAccessListToEthAccessList() {
 
 	suite.Require().Equal(&ethAccessList, actual)
 }
+
+func (suite *TxDataTestSuite) TestGetFeeGranter() {
+	feegrantAddress := common.HexToAddress(types.FeegrantPrecompileAddress)
+	feeGranter := sdk.AccAddress(suite.addr.Bytes())
+
+	testCases := []struct {
+		name          string
+		accessList    ethtypes.AccessList
+		expFeeGranter sdk.AccAddress
+		expError      bool
+	}{
+		{
+			"no fee granter",
+			ethtypes.AccessList{{Address: suite.addr, StorageKeys: []common.Hash{{0}}}},
+			nil,
+			false,
+		},
+		{
+			"feegrant precompile without storage keys",
+			ethtypes.AccessList{{Address: feegrantAddress}},
+			nil,
+			false,
+		},
+		{
+			"fee granter",
+			ethtypes.AccessList{types.NewFeeGranterAccessTuple(feeGranter)},
+			feeGranter,
+			false,
+		},
+		{
+			"more than one fee granter",
+			ethtypes.AccessList{types.NewFeeGranterAccessTuple(feeGranter), types.NewFeeGranterAccessTuple(sdk.AccAddress{1})},
+			nil,
+			true,
+		},
+		{
+			"invalid fee granter",
+			ethtypes.AccessList{{Address: feegrantAddress, StorageKeys: []common.Hash{common.HexToHash("0x1000000000000000000000000000000000000000000000000000000000000001")}}},
+			nil,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		feeGranter, err := types.GetFeeGranter(tc.accessList)
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expFeeGranter, feeGranter, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestGetFeeTokenStorageKey() {
+	testCases := []struct {
+		name       string
+		accessList ethtypes.AccessList
+		expKey     common.Hash
+		expFound   bool
+		expError   bool
+	}{
+		{
+			"no fee token",
+			ethtypes.AccessList{{Address: suite.addr, StorageKeys: []common.Hash{{0}}}},
+			common.Hash{},
+			false,
+			false,
+		},
+		{
+			"fee token",
+			ethtypes.AccessList{types.NewFeeTokenAccessTuple("uusdc")},
+			types.FeeTokenStorageKey("uusdc"),
+			true,
+			false,
+		},
+		{
+			"more than one fee token",
+			ethtypes.AccessList{types.NewFeeTokenAccessTuple("uusdc"), types.NewFeeTokenAccessTuple("uatom")},
+			common.Hash{},
+			false,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		key, found, err := types.GetFeeTokenStorageKey(tc.accessList)
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expFound, found, tc.name)
+		suite.Require().Equal(tc.expKey, key, tc.name)
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// AuthorizationList is an EIP-7702 authorization list that represents the
+// slice of the protobuf SetCodeAuthorizations.
+type AuthorizationList []SetCodeAuthorization
+
+// NewAuthorizationList creates a new protobuf-compatible AuthorizationList
+// from an ethereum core authorization list.
+func NewAuthorizationList(ethAuthList []ethtypes.SetCodeAuthorization) AuthorizationList {
+	if ethAuthList == nil {
+		return nil
+	}
+
+	al := make(AuthorizationList, 0, len(ethAuthList))
+	for _, auth := range ethAuthList {
+		chainID := sdkmath.NewIntFromBigInt(auth.ChainID.ToBig())
+		al = append(al, SetCodeAuthorization{
+			ChainID: &chainID,
+			Address: auth.Address.String(),
+			Nonce:   auth.Nonce,
+			V:       []byte{auth.V},
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		})
+	}
+
+	return al
+}
+
+// ToEthAuthorizationList is an utility function to convert the protobuf
+// compatible AuthorizationList to the eth core authorization list from
+// go-ethereum.
+func (al AuthorizationList) ToEthAuthorizationList() []ethtypes.SetCodeAuthorization {
+	ethAuthList := make([]ethtypes.SetCodeAuthorization, 0, len(al))
+
+	for _, auth := range al {
+		ethAuth := ethtypes.SetCodeAuthorization{
+			Address: common.HexToAddress(auth.Address),
+			Nonce:   auth.Nonce,
+		}
+		if auth.ChainID != nil {
+			ethAuth.ChainID.SetFromBig(auth.ChainID.BigInt())
+		}
+		if len(auth.V) > 0 {
+			ethAuth.V = auth.V[len(auth.V)-1]
+		}
+		ethAuth.R.SetBytes(auth.R)
+		ethAuth.S.SetBytes(auth.S)
+
+		ethAuthList = append(ethAuthList, ethAuth)
+	}
+
+	return ethAuthList
+}
+
+// Validate performs a stateless validation of the authorizations. Checks that
+// depend on the state, like the authority nonce or signature recovery, are
+// performed when the authorizations are applied and invalid entries are
+// skipped, as defined by EIP-7702.
+func (al AuthorizationList) Validate() error {
+	for i, auth := range al {
+		if auth.ChainID == nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "chain ID cannot be nil (index %d)", i)
+		}
+		if auth.ChainID.IsNegative() || auth.ChainID.BigInt().BitLen() > 256 {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "chain ID out of bound %s (index %d)", auth.ChainID, i)
+		}
+		if err := types.ValidateAddress(auth.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid address (index %d): %s", i, err)
+		}
+		if new(big.Int).SetBytes(auth.V).BitLen() > 8 {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "signature value v out of bound (index %d)", i)
+		}
+		if new(big.Int).SetBytes(auth.R).BitLen() > 256 || new(big.Int).SetBytes(auth.S).BitLen() > 256 {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "signature values r, s out of bound (index %d)", i)
+		}
+	}
+
+	return nil
+}
+
+// uint256FromBig converts the given big integer into a uint256 integer,
+// returning nil when the value is nil. Values out of bound are truncated and
+// must be rejected by the caller through validation.
+func uint256FromBig(i *big.Int) *uint256.Int {
+	if i == nil {
+		return nil
+	}
+	u, _ := uint256.FromBig(i)
+	return u
+}
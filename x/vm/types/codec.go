@@ -48,6 +48,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterInterface(
 		"os.vm.v1.TxData",
 		(*TxData)(nil),
+		&SetCodeTx{},
 		&DynamicFeeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
@@ -10,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 
 	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -32,6 +34,7 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrInvalidAuthorization
 )
 
 var (
@@ -92,10 +95,24 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrInvalidAuthorization returns an error if an EIP-7702 authorization list is invalid
+	ErrInvalidAuthorization = errorsmod.Register(ModuleName, codeErrInvalidAuthorization, "invalid set code authorization")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
 
+var (
+	// ErrNonceGap is returned when the tx nonce is higher than the account nonce.
+	// Such transactions are queued by the app mempool until the gap is filled.
+	ErrNonceGap = errorsmod.Wrap(errortypes.ErrInvalidSequence, "tx nonce is higher than account nonce")
+
+	// ErrNonceLow is returned when the tx nonce is lower than the account nonce.
+	// Such transactions are only accepted by the app mempool as replacements of
+	// pending ones.
+	ErrNonceLow = errorsmod.Wrap(errortypes.ErrInvalidSequence, "tx nonce is lower than account nonce")
+)
+
 // RevertReasonBytes converts a message to ABI-encoded revert bytes.
 func RevertReasonBytes(reason string) ([]byte, error) {
 	typ, err := abi.NewType("string", "", nil)
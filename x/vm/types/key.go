@@ -27,6 +27,7 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixHistoryStorageStart
 )
 
 // prefix bytes for the EVM transient store
@@ -35,6 +36,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
+	prefixTransientFeeToken
 )
 
 // KVStore key prefixes
@@ -43,14 +46,20 @@ var (
 	KeyPrefixStorage  = []byte{prefixStorage}
 	KeyPrefixParams   = []byte{prefixParams}
 	KeyPrefixCodeHash = []byte{prefixCodeHash}
+
+	// KeyHistoryStorageStart is the key of the first block whose hash is
+	// stored in the EIP-2935 history storage contract.
+	KeyHistoryStorageStart = []byte{prefixHistoryStorageStart}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
+	KeyPrefixTransientFeeToken = []byte{prefixTransientFeeToken}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
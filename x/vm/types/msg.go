@@ -84,6 +84,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      &gtc,
+			GasFeeCap:      &gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: NewAuthorizationList(tx.AuthorizationList),
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -357,16 +373,17 @@ func (msg MsgEthereumTx) AsMessage(baseFee *big.Int) (*core.Message, error) {
 		gasPrice = bigMin(gasPrice.Add(gasTipCap, baseFee), gasFeeCap)
 	}
 	ethMsg := core.Message{
-		From:       msg.GetSender(),
-		To:         txData.GetTo(),
-		Nonce:      txData.GetNonce(),
-		Value:      txData.GetValue(),
-		GasLimit:   txData.GetGas(),
-		GasPrice:   gasPrice,
-		GasFeeCap:  gasFeeCap,
-		GasTipCap:  gasTipCap,
-		Data:       txData.GetData(),
-		AccessList: txData.GetAccessList(),
+		From:                  msg.GetSender(),
+		To:                    txData.GetTo(),
+		Nonce:                 txData.GetNonce(),
+		Value:                 txData.GetValue(),
+		GasLimit:              txData.GetGas(),
+		GasPrice:              gasPrice,
+		GasFeeCap:             gasFeeCap,
+		GasTipCap:             gasTipCap,
+		Data:                  txData.GetData(),
+		AccessList:            txData.GetAccessList(),
+		SetCodeAuthorizations: txData.GetAuthorizationList(),
 	}
 	return &ethMsg, nil
 }
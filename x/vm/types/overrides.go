@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate returns an error if any of the overridden accounts sets both
+// the full state and a state diff.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override for a message call.
+type BlockOverrides struct {
+	Number       *hexutil.Big    `json:"number"`
+	Time         *hexutil.Uint64 `json:"time"`
+	FeeRecipient *common.Address `json:"feeRecipient"`
+	BaseFee      *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Apply overrides the given EVM block context with the fields that are set.
+func (o *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if o == nil {
+		return
+	}
+	if o.Number != nil {
+		blockCtx.BlockNumber = o.Number.ToInt()
+	}
+	if o.Time != nil {
+		blockCtx.Time = uint64(*o.Time)
+	}
+	if o.FeeRecipient != nil {
+		blockCtx.Coinbase = *o.FeeRecipient
+	}
+	if o.BaseFee != nil {
+		blockCtx.BaseFee = o.BaseFee.ToInt()
+	}
+}
@@ -13,6 +13,10 @@ const (
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000807"
+	FeegrantPrecompileAddress     = "0x0000000000000000000000000000000000000808"
+	ICAPrecompileAddress          = "0x0000000000000000000000000000000000000809"
+	EVMGovPrecompileAddress       = "0x000000000000000000000000000000000000080a"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -29,4 +33,8 @@ var AvailableStaticPrecompiles = []string{
 	BankPrecompileAddress,
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
+	ICAPrecompileAddress,
+	EVMGovPrecompileAddress,
 }
@@ -9,6 +9,10 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// HistoryServeWindow is the number of block hashes served by the EIP-2935
+// history storage contract, which keeps them in a ring buffer.
+const HistoryServeWindow = 8191
+
 var DefaultPreinstalls = []Preinstall{
 	{
 		Name:    "Create2",
@@ -606,6 +606,171 @@ func (m *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryStorageProofRequest is the request type for the Query/StorageProof RPC
+// method.
+type QueryStorageProofRequest struct {
+	// address is the ethereum hex address to compute the storage root of.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// keys defines the storage keys to prove.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *QueryStorageProofRequest) Reset()         { *m = QueryStorageProofRequest{} }
+func (m *QueryStorageProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProofRequest) ProtoMessage()    {}
+func (*QueryStorageProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+}
+func (m *QueryStorageProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProofRequest.Merge(m, src)
+}
+func (m *QueryStorageProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProofRequest proto.InternalMessageInfo
+
+// StorageKeyProof is the Merkle-Patricia proof of a storage key.
+type StorageKeyProof struct {
+	// key defines the storage key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value defines the storage value associated with the key.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proof defines the RLP encoded trie nodes from the storage root to the
+	// key, as returned by `eth_getProof`.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *StorageKeyProof) Reset()         { *m = StorageKeyProof{} }
+func (m *StorageKeyProof) String() string { return proto.CompactTextString(m) }
+func (*StorageKeyProof) ProtoMessage()    {}
+func (*StorageKeyProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+}
+func (m *StorageKeyProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageKeyProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageKeyProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageKeyProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageKeyProof.Merge(m, src)
+}
+func (m *StorageKeyProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageKeyProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageKeyProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageKeyProof proto.InternalMessageInfo
+
+func (m *StorageKeyProof) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageKeyProof) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *StorageKeyProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryStorageProofResponse is the response type for the Query/StorageProof
+// RPC method.
+type QueryStorageProofResponse struct {
+	// storage_hash is the root hash of the Merkle-Patricia trie of the account
+	// storage, computed as in Ethereum. It is not committed to by the app hash
+	// or any block header, so the proofs are only consistent with this root.
+	StorageHash string `protobuf:"bytes,1,opt,name=storage_hash,json=storageHash,proto3" json:"storage_hash,omitempty"`
+	// proofs defines the proofs of the requested storage keys.
+	Proofs []StorageKeyProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *QueryStorageProofResponse) Reset()         { *m = QueryStorageProofResponse{} }
+func (m *QueryStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProofResponse) ProtoMessage()    {}
+func (*QueryStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+}
+func (m *QueryStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProofResponse.Merge(m, src)
+}
+func (m *QueryStorageProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProofResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProofResponse) GetStorageHash() string {
+	if m != nil {
+		return m.StorageHash
+	}
+	return ""
+}
+
+func (m *QueryStorageProofResponse) GetProofs() []StorageKeyProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	// address is the ethereum hex address to query the code for.
@@ -616,7 +781,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +821,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +869,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +910,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +961,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1000,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,13 +1046,19 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the json encoded state overrides (balance, nonce, code, state
+	// and state diff per account) applied before executing the call
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the json encoded block overrides (number, time, fee
+	// recipient and base fee) applied to the block context of the call
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,6 +1115,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -959,7 +1144,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,6 +1194,127 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// QuerySimulateV1Request defines SimulateV1 request
+type QuerySimulateV1Request struct {
+	// opts is the json encoded simulation options (block state calls, trace
+	// transfers, validation and return full transactions), using the same json
+	// format as the json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the gas budget shared by all the simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QuerySimulateV1Request) Reset()         { *m = QuerySimulateV1Request{} }
+func (m *QuerySimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Request) ProtoMessage()    {}
+func (*QuerySimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+}
+func (m *QuerySimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Request.Merge(m, src)
+}
+func (m *QuerySimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Request proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// QuerySimulateV1Response defines SimulateV1 response
+type QuerySimulateV1Response struct {
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QuerySimulateV1Response) Reset()         { *m = QuerySimulateV1Response{} }
+func (m *QuerySimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Response) ProtoMessage()    {}
+func (*QuerySimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+}
+func (m *QuerySimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Response.Merge(m, src)
+}
+func (m *QuerySimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Response proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -1036,7 +1342,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1444,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,38 +1480,38 @@ func (m *QueryTraceTxResponse) GetData() []byte {
 	return nil
 }
 
-// QueryTraceBlockRequest defines TraceTx request
-type QueryTraceBlockRequest struct {
-	// txs is an array of messages in the block
-	Txs []*MsgEthereumTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
-	// trace_config holds extra parameters to trace functions.
-	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
-	// block_number of the traced block
-	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// block_hash (hex) of the traced block
-	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block_time of the traced block
-	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
-	// proposer_address is the address of the requested block
-	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
-	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// block_max_gas of the traced block
-	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
-}
-
-func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{} }
-func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTraceBlockRequest) ProtoMessage()    {}
-func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,5,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// overrides is the json encoded state overrides (balance, nonce, code, state
+	// and state diff per account) applied before tracing the call
+	Overrides []byte `protobuf:"bytes,6,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the json encoded block overrides (number, time, fee
+	// recipient and base fee) applied to the block context of the call
+	BlockOverrides []byte `protobuf:"bytes,7,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
 }
-func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTraceBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTraceBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1215,37 +1521,185 @@ func (m *QueryTraceBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryTraceBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTraceBlockRequest.Merge(m, src)
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
 }
-func (m *QueryTraceBlockRequest) XXX_Size() int {
+func (m *QueryTraceCallRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTraceBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTraceBlockRequest.DiscardUnknown(m)
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTraceBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
 
-func (m *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
+func (m *QueryTraceCallRequest) GetArgs() []byte {
 	if m != nil {
-		return m.Txs
+		return m.Args
 	}
 	return nil
 }
 
-func (m *QueryTraceBlockRequest) GetTraceConfig() *TraceConfig {
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
 	if m != nil {
-		return m.TraceConfig
+		return m.GasCap
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryTraceBlockRequest) GetBlockNumber() int64 {
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
 	if m != nil {
-		return m.BlockNumber
+		return m.ProposerAddress
 	}
-	return 0
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// QueryTraceBlockRequest defines TraceTx request
+type QueryTraceBlockRequest struct {
+	// txs is an array of messages in the block
+	Txs []*MsgEthereumTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// block_number of the traced block
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the traced block
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the traced block
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the address of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the traced block
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{} }
+func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceBlockRequest) ProtoMessage()    {}
+func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
+}
+func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceBlockRequest.Merge(m, src)
+}
+func (m *QueryTraceBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceBlockRequest proto.InternalMessageInfo
+
+func (m *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryTraceBlockRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceBlockRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
 }
 
 func (m *QueryTraceBlockRequest) GetBlockHash() string {
@@ -1293,7 +1747,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,6 +1783,54 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryIntermediateRootsResponse defines IntermediateRoots response
+type QueryIntermediateRootsResponse struct {
+	// roots are the dirty-state digests after each transaction of the block,
+	// chaining the hashes of the EVM StateDB changes of the transactions. They
+	// are not state roots and don't cover the Cosmos state changes.
+	Roots [][]byte `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (m *QueryIntermediateRootsResponse) Reset()         { *m = QueryIntermediateRootsResponse{} }
+func (m *QueryIntermediateRootsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateRootsResponse) ProtoMessage()    {}
+func (*QueryIntermediateRootsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
+}
+func (m *QueryIntermediateRootsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateRootsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediateRootsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateRootsResponse.Merge(m, src)
+}
+func (m *QueryIntermediateRootsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateRootsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateRootsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediateRootsResponse proto.InternalMessageInfo
+
+func (m *QueryIntermediateRootsResponse) GetRoots() [][]byte {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1338,7 +1840,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1879,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1917,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{34}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1956,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{35}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,6 +1998,9 @@ func init() {
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
 	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
 	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryStorageProofRequest)(nil), "cosmos.evm.vm.v1.QueryStorageProofRequest")
+	proto.RegisterType((*StorageKeyProof)(nil), "cosmos.evm.vm.v1.StorageKeyProof")
+	proto.RegisterType((*QueryStorageProofResponse)(nil), "cosmos.evm.vm.v1.QueryStorageProofResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
@@ -1504,10 +2009,15 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
+	proto.RegisterType((*QuerySimulateV1Request)(nil), "cosmos.evm.vm.v1.QuerySimulateV1Request")
+	proto.RegisterType((*QuerySimulateV1Response)(nil), "cosmos.evm.vm.v1.QuerySimulateV1Response")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "cosmos.evm.vm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "cosmos.evm.vm.v1.QueryTraceCallResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryIntermediateRootsResponse)(nil), "cosmos.evm.vm.v1.QueryIntermediateRootsResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
@@ -1517,109 +2027,130 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1624 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0xf8, 0xe2, 0xec, 0x37, 0xb1, 0xc3, 0x42,
-	0x7e, 0x12, 0x76, 0x49, 0x4a, 0x2b, 0x95, 0x1e, 0xda, 0x24, 0x0a, 0x81, 0x02, 0x15, 0xdd, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0xaf, 0xe2, 0xdd, 0x31, 0x3b, 0xeb, 0xd4, 0x81, 0xd2,
-	0x43, 0xa5, 0x22, 0x28, 0x17, 0xa4, 0xde, 0x5b, 0x8e, 0xbd, 0xb5, 0x37, 0xfe, 0x05, 0x8e, 0x48,
-	0xbd, 0x54, 0x3d, 0xd0, 0x0a, 0x2a, 0xb5, 0x7f, 0x43, 0x4f, 0xd5, 0xfc, 0x58, 0xdb, 0xeb, 0xf5,
-	0xda, 0xa1, 0xa2, 0xb7, 0x4a, 0x56, 0x32, 0x3f, 0xde, 0xbc, 0xf7, 0x79, 0x6f, 0xde, 0xbc, 0xf7,
-	0xb1, 0xc1, 0x8c, 0x45, 0xa8, 0x4b, 0xa8, 0x81, 0xf7, 0x5d, 0x83, 0x7d, 0xd6, 0x8c, 0x5b, 0x0d,
-	0xec, 0x1f, 0xe8, 0x75, 0x9f, 0x04, 0x04, 0x4e, 0x8a, 0x5d, 0x1d, 0xef, 0xbb, 0x3a, 0xfb, 0xac,
-	0xa9, 0xc7, 0x90, 0xeb, 0x78, 0xc4, 0xe0, 0x7f, 0x85, 0x90, 0xba, 0x22, 0x55, 0x94, 0x11, 0xc5,
-	0xe2, 0xb4, 0xb1, 0xbf, 0x56, 0xc6, 0x01, 0x5a, 0x33, 0xea, 0xc8, 0x76, 0x3c, 0x14, 0x38, 0xc4,
-	0x93, 0xb2, 0x6a, 0xcc, 0x1c, 0x53, 0x2d, 0xf6, 0xa6, 0x63, 0x7b, 0x41, 0x53, 0x6e, 0x4d, 0xd9,
-	0xc4, 0x26, 0x7c, 0x68, 0xb0, 0x91, 0x5c, 0x9d, 0xb1, 0x09, 0xb1, 0x6b, 0xd8, 0x40, 0x75, 0xc7,
-	0x40, 0x9e, 0x47, 0x02, 0x6e, 0x89, 0xca, 0xdd, 0xa2, 0xdc, 0xe5, 0xb3, 0x72, 0xe3, 0xa6, 0x11,
-	0x38, 0x2e, 0xa6, 0x01, 0x72, 0xeb, 0x42, 0x40, 0x9b, 0x02, 0xf0, 0x43, 0x86, 0x76, 0x8b, 0x78,
-	0x37, 0x1d, 0xdb, 0xc4, 0xb7, 0x1a, 0x98, 0x06, 0xda, 0x35, 0x70, 0x3c, 0xb2, 0x4a, 0xeb, 0xc4,
-	0xa3, 0x18, 0xbe, 0x09, 0x46, 0x2d, 0xbe, 0x92, 0x57, 0xe6, 0x94, 0xa5, 0xdc, 0xfa, 0xac, 0xde,
-	0x1d, 0x1a, 0x7d, 0xab, 0x8a, 0x1c, 0x4f, 0x1e, 0x93, 0xc2, 0xda, 0xdb, 0x52, 0xdb, 0x86, 0x65,
-	0x91, 0x86, 0x17, 0x48, 0x23, 0x30, 0x0f, 0x32, 0xa8, 0x52, 0xf1, 0x31, 0xa5, 0x5c, 0xdd, 0x98,
-	0x19, 0x4e, 0x2f, 0x66, 0xef, 0x3f, 0x2e, 0x0e, 0xfd, 0xf9, 0xb8, 0x38, 0xa4, 0x59, 0x60, 0x2a,
-	0x7a, 0x54, 0x22, 0xc9, 0x83, 0x4c, 0x19, 0xd5, 0x90, 0x67, 0xe1, 0xf0, 0xac, 0x9c, 0xc2, 0xff,
-	0x83, 0x31, 0x8b, 0x54, 0x70, 0xa9, 0x8a, 0x68, 0x35, 0x3f, 0xcc, 0xf7, 0xb2, 0x6c, 0xe1, 0x32,
-	0xa2, 0x55, 0x38, 0x05, 0x46, 0x3c, 0xc2, 0x0e, 0xa5, 0xe6, 0x94, 0xa5, 0xb4, 0x29, 0x26, 0xda,
-	0xbb, 0x60, 0x5a, 0x7a, 0xcb, 0x9c, 0xf9, 0x07, 0x28, 0xef, 0x29, 0x40, 0xed, 0xa5, 0x41, 0x82,
-	0x9d, 0x07, 0x47, 0x44, 0x9c, 0x4a, 0x51, 0x4d, 0x13, 0x62, 0x75, 0x43, 0x2c, 0x42, 0x15, 0x64,
-	0x29, 0x33, 0xca, 0xf0, 0x0d, 0x73, 0x7c, 0xad, 0x39, 0x53, 0x81, 0x84, 0xd6, 0x92, 0xd7, 0x70,
-	0xcb, 0xd8, 0x97, 0x1e, 0x4c, 0xc8, 0xd5, 0x0f, 0xf8, 0xa2, 0x76, 0x15, 0xcc, 0x70, 0x1c, 0x1f,
-	0xa3, 0x9a, 0x53, 0x41, 0x01, 0xf1, 0xbb, 0x9c, 0x39, 0x05, 0xc6, 0x2d, 0xe2, 0x75, 0xe3, 0xc8,
-	0xb1, 0xb5, 0x8d, 0x98, 0x57, 0x0f, 0x15, 0x30, 0x9b, 0xa0, 0x4d, 0x3a, 0xb6, 0x08, 0x8e, 0x86,
-	0xa8, 0xa2, 0x1a, 0x43, 0xb0, 0xaf, 0xd1, 0xb5, 0x30, 0x89, 0x36, 0xc5, 0x3d, 0xbf, 0xca, 0xf5,
-	0x9c, 0x97, 0x49, 0xd4, 0x3a, 0x3a, 0x28, 0x89, 0xb4, 0xab, 0xd2, 0xd8, 0x47, 0x01, 0xf1, 0x91,
-	0x3d, 0xd8, 0x18, 0x9c, 0x04, 0xa9, 0x3d, 0x7c, 0x20, 0xf3, 0x8d, 0x0d, 0x3b, 0xcc, 0xaf, 0x4a,
-	0xf3, 0x2d, 0x65, 0xd2, 0xfc, 0x14, 0x18, 0xd9, 0x47, 0xb5, 0x46, 0x68, 0x5c, 0x4c, 0xb4, 0xb7,
-	0xc0, 0xa4, 0x4c, 0xa5, 0xca, 0x2b, 0x39, 0xb9, 0x08, 0x8e, 0x75, 0x9c, 0x93, 0x26, 0x20, 0x48,
-	0xb3, 0xdc, 0xe7, 0xa7, 0xc6, 0x4d, 0x3e, 0xd6, 0x6e, 0xcb, 0x17, 0xbf, 0xdb, 0xbc, 0x46, 0x6c,
-	0x1a, 0x9a, 0x80, 0x20, 0xcd, 0x5f, 0x8c, 0xd0, 0xcf, 0xc7, 0xf0, 0x12, 0x00, 0xed, 0xda, 0xc5,
-	0x7d, 0xcb, 0xad, 0x2f, 0x84, 0x4f, 0x9e, 0x15, 0x3a, 0x5d, 0x94, 0x49, 0x59, 0xe8, 0xf4, 0x1b,
-	0xed, 0x50, 0x99, 0x1d, 0x27, 0x3b, 0x40, 0x3e, 0x50, 0x64, 0x60, 0x43, 0xe3, 0x12, 0xe7, 0x32,
-	0x48, 0xd7, 0x88, 0xcd, 0xbc, 0x4b, 0x2d, 0xe5, 0xd6, 0x4f, 0xc4, 0xcb, 0xca, 0x35, 0x62, 0x9b,
-	0x5c, 0x04, 0xee, 0xf4, 0x00, 0xb5, 0x38, 0x10, 0x94, 0xb0, 0xd3, 0x89, 0xaa, 0x55, 0xf9, 0x6e,
-	0x20, 0x1f, 0xb9, 0x61, 0x1c, 0x34, 0x53, 0x02, 0x0c, 0x57, 0x25, 0xc0, 0x77, 0xc0, 0x68, 0x9d,
-	0xaf, 0xc8, 0xca, 0x97, 0x8f, 0x43, 0x14, 0x27, 0x36, 0xc7, 0x9e, 0x3e, 0x2f, 0x0e, 0x7d, 0xff,
-	0xc7, 0x8f, 0x2b, 0x8a, 0x29, 0x8f, 0x68, 0x4f, 0x14, 0x70, 0x64, 0x3b, 0xa8, 0x6e, 0xa1, 0x5a,
-	0xad, 0x23, 0xdc, 0xc8, 0xb7, 0x69, 0x78, 0x31, 0x6c, 0x0c, 0x4f, 0x82, 0x8c, 0x8d, 0x68, 0xc9,
-	0x42, 0x75, 0xf9, 0x46, 0x46, 0x6d, 0x44, 0xb7, 0x50, 0x1d, 0x7e, 0x0a, 0x26, 0xeb, 0x3e, 0xa9,
-	0x13, 0x8a, 0xfd, 0xd6, 0x3b, 0x63, 0x6f, 0x64, 0x7c, 0x73, 0xfd, 0xaf, 0xe7, 0x45, 0xdd, 0x76,
-	0x82, 0x6a, 0xa3, 0xac, 0x5b, 0xc4, 0x35, 0x64, 0xf3, 0x10, 0xff, 0xce, 0xd1, 0xca, 0x9e, 0x11,
-	0x1c, 0xd4, 0x31, 0xd5, 0xb7, 0xda, 0x0f, 0xdc, 0x3c, 0x1a, 0xea, 0x0a, 0x1f, 0xe7, 0x34, 0xc8,
-	0x5a, 0xac, 0x6a, 0x97, 0x9c, 0x4a, 0x3e, 0x3d, 0xa7, 0x2c, 0xa5, 0xcc, 0x0c, 0x9f, 0x5f, 0xa9,
-	0x68, 0xbb, 0xe0, 0xf8, 0x36, 0x0d, 0x1c, 0x17, 0x05, 0x78, 0x07, 0xb5, 0xa3, 0x31, 0x09, 0x52,
-	0x36, 0x12, 0xe0, 0xd3, 0x26, 0x1b, 0xb2, 0x15, 0x1f, 0x07, 0x1c, 0xf7, 0xb8, 0xc9, 0x86, 0x4c,
-	0xeb, 0xbe, 0x5b, 0xc2, 0xbe, 0x4f, 0xc4, 0x83, 0x1e, 0x33, 0x33, 0xfb, 0xee, 0x36, 0x9b, 0x6a,
-	0x0f, 0xd2, 0x61, 0x16, 0xf8, 0xc8, 0xc2, 0xbb, 0xcd, 0x30, 0x28, 0x6b, 0x20, 0xe5, 0xd2, 0xb0,
-	0xb7, 0x14, 0xe3, 0x11, 0xbe, 0x4e, 0xed, 0xed, 0xa0, 0x8a, 0x7d, 0xdc, 0x70, 0x77, 0x9b, 0x26,
-	0x93, 0x85, 0xef, 0x81, 0xf1, 0x80, 0x29, 0x29, 0xc9, 0xbe, 0x94, 0x4a, 0xea, 0x4b, 0xdc, 0x94,
-	0xec, 0x4b, 0xb9, 0xa0, 0x3d, 0x81, 0x5b, 0x60, 0xbc, 0xee, 0xe3, 0x0a, 0xb6, 0x30, 0xa5, 0xc4,
-	0xa7, 0xf9, 0x34, 0x4f, 0xc1, 0x81, 0xd6, 0x23, 0x87, 0x58, 0x5d, 0x2d, 0xd7, 0x88, 0xb5, 0x17,
-	0x56, 0xb0, 0x11, 0x1e, 0xc6, 0x1c, 0x5f, 0x13, 0xf5, 0x0b, 0xce, 0x02, 0x20, 0x44, 0xf8, 0x33,
-	0x1b, 0xe5, 0x11, 0x19, 0xe3, 0x2b, 0xbc, 0x33, 0x5d, 0x0e, 0xb7, 0x59, 0x83, 0xce, 0x67, 0xb8,
-	0x1b, 0xaa, 0x2e, 0xba, 0xb7, 0x1e, 0x76, 0x6f, 0x7d, 0x37, 0xec, 0xde, 0x9b, 0x13, 0x2c, 0xcd,
-	0x1e, 0xfd, 0x5a, 0x54, 0x44, 0xaa, 0x09, 0x4d, 0x6c, 0xbb, 0x67, 0xb6, 0x64, 0xff, 0x9d, 0x6c,
-	0x19, 0x8b, 0x64, 0x0b, 0xd4, 0xc0, 0x84, 0xf0, 0xc1, 0x45, 0xcd, 0x12, 0x4b, 0x10, 0xd0, 0x11,
-	0x86, 0xeb, 0xa8, 0xb9, 0x83, 0xe8, 0xfb, 0xe9, 0xec, 0xf0, 0x64, 0xca, 0xcc, 0x06, 0xcd, 0x92,
-	0xe3, 0x55, 0x70, 0x53, 0x5b, 0x91, 0xc5, 0xb1, 0x95, 0x0a, 0xed, 0xca, 0x55, 0x41, 0x01, 0x0a,
-	0x1f, 0x08, 0x1b, 0x6b, 0x4f, 0x52, 0xe0, 0x7f, 0x6d, 0xe1, 0x4d, 0xa6, 0xb5, 0x23, 0x75, 0x82,
-	0x66, 0x58, 0x3f, 0x06, 0xa7, 0x4e, 0xd0, 0xa4, 0xaf, 0x21, 0x75, 0xfe, 0xbb, 0xf5, 0x43, 0xde,
-	0xba, 0x76, 0x0e, 0x9c, 0x8c, 0x5d, 0x5c, 0x9f, 0x8b, 0x3e, 0xd1, 0xea, 0xf5, 0x14, 0x5f, 0xc2,
-	0xb8, 0xcd, 0x4a, 0xa7, 0xa2, 0xcb, 0x52, 0xc5, 0x05, 0x90, 0x65, 0x85, 0xbf, 0x74, 0x13, 0xcb,
-	0x5e, 0xba, 0x39, 0xfd, 0xcb, 0xf3, 0xe2, 0x09, 0xe1, 0x21, 0xad, 0xec, 0xe9, 0x0e, 0x31, 0x5c,
-	0x14, 0x54, 0xf5, 0x2b, 0x5e, 0xc0, 0x7a, 0x3c, 0x3f, 0xad, 0x15, 0x25, 0xbb, 0xd9, 0xa9, 0x91,
-	0x32, 0xaa, 0x5d, 0x77, 0xbc, 0x1d, 0x44, 0x6f, 0xf8, 0x4e, 0x8b, 0x5a, 0x68, 0x16, 0x28, 0x24,
-	0x09, 0x48, 0xc3, 0x1b, 0x60, 0xc2, 0x75, 0x3c, 0xe6, 0x74, 0xa9, 0xce, 0x36, 0xa4, 0xf5, 0x59,
-	0x76, 0x4b, 0xc9, 0x08, 0x72, 0x6e, 0x5b, 0xd5, 0xfa, 0xd7, 0x47, 0xc1, 0x08, 0xb7, 0x02, 0xbf,
-	0x52, 0x40, 0x46, 0x12, 0x2c, 0x38, 0x1f, 0xcf, 0xc2, 0x1e, 0x0c, 0x5a, 0x5d, 0x18, 0x24, 0x26,
-	0x70, 0x6a, 0x67, 0xbf, 0xfc, 0xe9, 0xf7, 0x6f, 0x86, 0xe7, 0xe1, 0x69, 0x23, 0xf6, 0xed, 0x42,
-	0x92, 0x2c, 0xe3, 0x8e, 0x4c, 0x9a, 0xbb, 0xf0, 0x5b, 0x05, 0x4c, 0x44, 0x78, 0x2c, 0x3c, 0x9b,
-	0x60, 0xa6, 0x17, 0x5f, 0x56, 0x57, 0x0f, 0x27, 0x2c, 0x91, 0xad, 0x73, 0x64, 0xab, 0x70, 0x25,
-	0x8e, 0x2c, 0xa4, 0xcc, 0x31, 0x80, 0x3f, 0x28, 0x60, 0xb2, 0x9b, 0x92, 0x42, 0x3d, 0xc1, 0x6c,
-	0x02, 0x13, 0x56, 0x8d, 0x43, 0xcb, 0x4b, 0xa4, 0x17, 0x39, 0xd2, 0x0b, 0x70, 0x3d, 0x8e, 0x74,
-	0x3f, 0x3c, 0xd3, 0x06, 0xdb, 0xc9, 0xb2, 0xef, 0xc2, 0x7b, 0x0a, 0xc8, 0x48, 0xf2, 0x99, 0x78,
-	0xb5, 0x51, 0x5e, 0x9b, 0x78, 0xb5, 0x5d, 0x1c, 0x56, 0x5b, 0xe5, 0xb0, 0x16, 0xe0, 0x99, 0x38,
-	0x2c, 0x49, 0x66, 0x69, 0x47, 0xe8, 0x1e, 0x2a, 0x20, 0x23, 0x69, 0x68, 0x22, 0x90, 0x28, 0xe7,
-	0x4d, 0x04, 0xd2, 0xc5, 0x66, 0xb5, 0x35, 0x0e, 0xe4, 0x2c, 0x5c, 0x8e, 0x03, 0xa1, 0x42, 0xb4,
-	0x8d, 0xc3, 0xb8, 0xb3, 0x87, 0x0f, 0xee, 0xc2, 0xdb, 0x20, 0xcd, 0xd8, 0x2a, 0xd4, 0x12, 0x53,
-	0xa6, 0x45, 0x81, 0xd5, 0xd3, 0x7d, 0x65, 0x24, 0x86, 0x65, 0x8e, 0xe1, 0x34, 0x3c, 0xd5, 0x2b,
-	0x9b, 0x2a, 0x91, 0x48, 0x7c, 0x06, 0x46, 0x05, 0x61, 0x83, 0x67, 0x12, 0x34, 0x47, 0x78, 0xa1,
-	0x3a, 0x3f, 0x40, 0x4a, 0x22, 0x98, 0xe3, 0x08, 0x54, 0x98, 0x8f, 0x23, 0x10, 0x64, 0x10, 0x36,
-	0x41, 0x46, 0x72, 0x41, 0x38, 0x17, 0xd7, 0x19, 0xa5, 0x89, 0xea, 0xe2, 0xa0, 0x4e, 0x16, 0xda,
-	0xd5, 0xb8, 0xdd, 0x19, 0xa8, 0xc6, 0xed, 0xe2, 0xa0, 0x5a, 0xb2, 0x98, 0xb9, 0x2f, 0x40, 0xae,
-	0x83, 0xcc, 0x1d, 0xc2, 0x7a, 0x0f, 0x9f, 0x7b, 0xb0, 0x41, 0x6d, 0x81, 0xdb, 0x9e, 0x83, 0x85,
-	0x1e, 0xb6, 0xa5, 0x38, 0x2b, 0x91, 0xf0, 0x73, 0x90, 0x91, 0x5d, 0x3e, 0x31, 0xf7, 0xa2, 0x84,
-	0x30, 0x31, 0xf7, 0xba, 0xc8, 0x42, 0x3f, 0xef, 0x45, 0x8b, 0x0f, 0x9a, 0xf0, 0xbe, 0x02, 0x40,
-	0xbb, 0xfd, 0xc0, 0xa5, 0x7e, 0xaa, 0x3b, 0xa9, 0x85, 0xba, 0x7c, 0x08, 0x49, 0x89, 0x63, 0x9e,
-	0xe3, 0x28, 0xc2, 0xd9, 0x24, 0x1c, 0xbc, 0x27, 0xb2, 0x40, 0xc8, 0x16, 0xd6, 0xa7, 0x1a, 0x74,
-	0x76, 0xbe, 0x3e, 0xd5, 0x20, 0xd2, 0x09, 0xfb, 0x05, 0x22, 0xec, 0x90, 0x2c, 0xf3, 0x25, 0x7f,
-	0x39, 0x93, 0xf8, 0xa6, 0x3a, 0x7e, 0x0b, 0x4a, 0xcc, 0xfc, 0xe8, 0x6f, 0x43, 0xfd, 0x32, 0x5f,
-	0x10, 0x2c, 0xf8, 0x9d, 0x02, 0x8e, 0xc5, 0x7a, 0x29, 0x4c, 0x2a, 0xc4, 0x49, 0x6d, 0x59, 0x3d,
-	0x7f, 0xf8, 0x03, 0x12, 0xda, 0x22, 0x87, 0x76, 0x0a, 0x16, 0xe3, 0xd0, 0x22, 0xed, 0x7b, 0xf3,
-	0xe2, 0xd3, 0x17, 0x05, 0xe5, 0xd9, 0x8b, 0x82, 0xf2, 0xdb, 0x8b, 0x82, 0xf2, 0xe8, 0x65, 0x61,
-	0xe8, 0xd9, 0xcb, 0xc2, 0xd0, 0xcf, 0x2f, 0x0b, 0x43, 0x9f, 0xcc, 0xc5, 0x09, 0x14, 0x53, 0xd2,
-	0x64, 0x6a, 0x38, 0x7d, 0x2a, 0x8f, 0x72, 0xba, 0xf6, 0xc6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x62, 0xca, 0x4c, 0x14, 0x00, 0x00,
+	// 1965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x51, 0x6f, 0x1b, 0x59,
+	0x15, 0xce, 0xc4, 0x4e, 0x1c, 0x9f, 0x38, 0x6d, 0x7a, 0x9b, 0xb2, 0xae, 0x49, 0x6c, 0x67, 0xda,
+	0x34, 0x69, 0x9a, 0xb5, 0x37, 0x61, 0x59, 0x89, 0xf2, 0xb0, 0x34, 0x51, 0x37, 0x5b, 0xda, 0xb2,
+	0x65, 0x88, 0xf6, 0x01, 0x09, 0x59, 0xd7, 0xf6, 0xad, 0x3d, 0x8a, 0x67, 0xae, 0x77, 0xee, 0xd8,
+	0x38, 0xbb, 0x14, 0x24, 0x04, 0xab, 0x5d, 0xed, 0xcb, 0x4a, 0xbc, 0x21, 0x01, 0xfb, 0xc8, 0x1b,
+	0xbc, 0xf1, 0xc8, 0x23, 0xfb, 0xb8, 0x12, 0x2f, 0xc0, 0x43, 0x41, 0x2d, 0x12, 0xfc, 0x06, 0x1e,
+	0x10, 0xba, 0xf7, 0x9e, 0xb1, 0x67, 0x3c, 0x1e, 0x3b, 0x45, 0x8b, 0x04, 0x12, 0x52, 0xd4, 0xde,
+	0x7b, 0xe6, 0xdc, 0x73, 0xbe, 0x73, 0xee, 0x39, 0xe7, 0x9e, 0x93, 0xc0, 0x7a, 0x83, 0x0b, 0x87,
+	0x8b, 0x2a, 0xeb, 0x3b, 0x55, 0xf9, 0xb3, 0x5f, 0x7d, 0xa7, 0xc7, 0xbc, 0xb3, 0x4a, 0xd7, 0xe3,
+	0x3e, 0x27, 0xab, 0xfa, 0x6b, 0x85, 0xf5, 0x9d, 0x8a, 0xfc, 0xd9, 0x2f, 0x5c, 0xa2, 0x8e, 0xed,
+	0xf2, 0xaa, 0xfa, 0x57, 0x33, 0x15, 0x76, 0x51, 0x44, 0x9d, 0x0a, 0xa6, 0x4f, 0x57, 0xfb, 0xfb,
+	0x75, 0xe6, 0xd3, 0xfd, 0x6a, 0x97, 0xb6, 0x6c, 0x97, 0xfa, 0x36, 0x77, 0x91, 0xb7, 0x10, 0x53,
+	0x27, 0x45, 0xeb, 0x6f, 0x57, 0x63, 0xdf, 0xfc, 0x01, 0x7e, 0x5a, 0x6b, 0xf1, 0x16, 0x57, 0xcb,
+	0xaa, 0x5c, 0x21, 0x75, 0xbd, 0xc5, 0x79, 0xab, 0xc3, 0xaa, 0xb4, 0x6b, 0x57, 0xa9, 0xeb, 0x72,
+	0x5f, 0x69, 0x12, 0xf8, 0xb5, 0x84, 0x5f, 0xd5, 0xae, 0xde, 0x7b, 0x5c, 0xf5, 0x6d, 0x87, 0x09,
+	0x9f, 0x3a, 0x5d, 0xcd, 0x60, 0xae, 0x01, 0xf9, 0xa6, 0x44, 0x7b, 0xc4, 0xdd, 0xc7, 0x76, 0xcb,
+	0x62, 0xef, 0xf4, 0x98, 0xf0, 0xcd, 0x07, 0x70, 0x39, 0x42, 0x15, 0x5d, 0xee, 0x0a, 0x46, 0xbe,
+	0x0c, 0x8b, 0x0d, 0x45, 0xc9, 0x1b, 0x65, 0x63, 0x67, 0xf9, 0x60, 0xa3, 0x32, 0xee, 0x9a, 0xca,
+	0x51, 0x9b, 0xda, 0x2e, 0x1e, 0x43, 0x66, 0xf3, 0x2b, 0x28, 0xed, 0x4e, 0xa3, 0xc1, 0x7b, 0xae,
+	0x8f, 0x4a, 0x48, 0x1e, 0x32, 0xb4, 0xd9, 0xf4, 0x98, 0x10, 0x4a, 0x5c, 0xd6, 0x0a, 0xb6, 0xb7,
+	0x97, 0x3e, 0xf8, 0xa4, 0x34, 0xf7, 0xf7, 0x4f, 0x4a, 0x73, 0x66, 0x03, 0xd6, 0xa2, 0x47, 0x11,
+	0x49, 0x1e, 0x32, 0x75, 0xda, 0xa1, 0x6e, 0x83, 0x05, 0x67, 0x71, 0x4b, 0xbe, 0x08, 0xd9, 0x06,
+	0x6f, 0xb2, 0x5a, 0x9b, 0x8a, 0x76, 0x7e, 0x5e, 0x7d, 0x5b, 0x92, 0x84, 0x37, 0xa9, 0x68, 0x93,
+	0x35, 0x58, 0x70, 0xb9, 0x3c, 0x94, 0x2a, 0x1b, 0x3b, 0x69, 0x4b, 0x6f, 0xcc, 0xd7, 0xe1, 0x2a,
+	0x5a, 0x2b, 0x8d, 0xf9, 0x37, 0x50, 0xbe, 0x6f, 0x40, 0x61, 0x92, 0x04, 0x04, 0xbb, 0x05, 0x17,
+	0xb4, 0x9f, 0x6a, 0x51, 0x49, 0x2b, 0x9a, 0x7a, 0x47, 0x13, 0x49, 0x01, 0x96, 0x84, 0x54, 0x2a,
+	0xf1, 0xcd, 0x2b, 0x7c, 0xc3, 0xbd, 0x14, 0x41, 0xb5, 0xd4, 0x9a, 0xdb, 0x73, 0xea, 0xcc, 0x43,
+	0x0b, 0x56, 0x90, 0xfa, 0x0d, 0x45, 0x34, 0xef, 0xc3, 0xba, 0xc2, 0xf1, 0x36, 0xed, 0xd8, 0x4d,
+	0xea, 0x73, 0x6f, 0xcc, 0x98, 0x4d, 0xc8, 0x35, 0xb8, 0x3b, 0x8e, 0x63, 0x59, 0xd2, 0xee, 0xc4,
+	0xac, 0xfa, 0xc8, 0x80, 0x8d, 0x04, 0x69, 0x68, 0xd8, 0x36, 0x5c, 0x0c, 0x50, 0x45, 0x25, 0x06,
+	0x60, 0x3f, 0x47, 0xd3, 0x82, 0x20, 0x3a, 0xd4, 0xf7, 0xfc, 0x22, 0xd7, 0xf3, 0x0a, 0x06, 0xd1,
+	0xf0, 0xe8, 0xac, 0x20, 0x32, 0xef, 0xa3, 0xb2, 0x6f, 0xf9, 0xdc, 0xa3, 0xad, 0xd9, 0xca, 0xc8,
+	0x2a, 0xa4, 0x4e, 0xd9, 0x19, 0xc6, 0x9b, 0x5c, 0x86, 0xd4, 0xef, 0xa1, 0xfa, 0xa1, 0x30, 0x54,
+	0xbf, 0x06, 0x0b, 0x7d, 0xda, 0xe9, 0x05, 0xca, 0xf5, 0xc6, 0xb4, 0x20, 0x1f, 0xe6, 0x7e, 0xe4,
+	0x71, 0xfe, 0x78, 0xb6, 0x7e, 0x02, 0xe9, 0x53, 0x76, 0x26, 0xf2, 0xf3, 0xe5, 0xd4, 0x4e, 0xd6,
+	0x52, 0xeb, 0x10, 0x82, 0xb7, 0xe0, 0x22, 0x8a, 0xbb, 0xcf, 0xce, 0x94, 0xc4, 0x00, 0xb0, 0x31,
+	0x04, 0x3c, 0x82, 0x33, 0x1f, 0x82, 0x23, 0xa9, 0x5d, 0x79, 0x20, 0x9f, 0x2a, 0xa7, 0x76, 0x72,
+	0x96, 0xde, 0x98, 0x3f, 0xc0, 0x8c, 0x89, 0x82, 0x44, 0xbb, 0x36, 0x21, 0x27, 0x34, 0x5d, 0x27,
+	0x21, 0x06, 0x19, 0xd2, 0x54, 0x1e, 0xbe, 0x0e, 0x8b, 0x4a, 0x90, 0x06, 0xbc, 0x7c, 0xb0, 0x19,
+	0x2f, 0x24, 0x63, 0x80, 0x0f, 0xd3, 0x9f, 0x3e, 0x2d, 0xcd, 0x59, 0x78, 0xcc, 0x7c, 0x0d, 0x56,
+	0x31, 0xe1, 0x9a, 0x2f, 0x14, 0x0a, 0xdb, 0x70, 0x29, 0x74, 0x0e, 0x01, 0x13, 0x48, 0xcb, 0x0a,
+	0xa1, 0x4e, 0xe5, 0x2c, 0xb5, 0x36, 0xdf, 0xc5, 0xba, 0x78, 0x32, 0x78, 0xc0, 0x5b, 0x22, 0x50,
+	0x41, 0x20, 0x1d, 0x32, 0x49, 0xad, 0xc9, 0x1b, 0x00, 0xa3, 0x0a, 0xaf, 0x9c, 0xb7, 0x7c, 0x70,
+	0x23, 0xb0, 0x47, 0x3e, 0x07, 0x15, 0xfd, 0x98, 0xe0, 0x73, 0x50, 0x79, 0x34, 0x0a, 0x28, 0x2b,
+	0x74, 0x32, 0x04, 0xf2, 0x43, 0x03, 0xc3, 0x2f, 0x50, 0x8e, 0x38, 0x6f, 0x42, 0xba, 0xc3, 0x5b,
+	0xd2, 0x3a, 0xe9, 0xb3, 0x2b, 0x71, 0x9f, 0x3d, 0xe0, 0x2d, 0x4b, 0xb1, 0x90, 0xe3, 0x09, 0xa0,
+	0xb6, 0x67, 0x82, 0xd2, 0x7a, 0xc2, 0xa8, 0x86, 0xef, 0xc3, 0x23, 0xea, 0x51, 0x27, 0xf0, 0x83,
+	0x69, 0x21, 0xc0, 0x80, 0x8a, 0x00, 0xbf, 0x0a, 0x8b, 0x5d, 0x45, 0xc1, 0xf7, 0x21, 0x1f, 0x87,
+	0xa8, 0x4f, 0x1c, 0x66, 0xe5, 0x6d, 0xfe, 0xf2, 0x6f, 0xbf, 0xde, 0x35, 0x2c, 0x3c, 0x62, 0xfe,
+	0xd3, 0x80, 0x0b, 0x77, 0xfd, 0xf6, 0x11, 0xed, 0x74, 0x42, 0xee, 0xa6, 0x5e, 0x4b, 0x04, 0x17,
+	0x23, 0xd7, 0xe4, 0x25, 0xc8, 0xb4, 0xa8, 0xa8, 0x35, 0x68, 0x17, 0x2b, 0xc9, 0x62, 0x8b, 0x8a,
+	0x23, 0xda, 0x25, 0xdf, 0x81, 0xd5, 0xae, 0xc7, 0xbb, 0x5c, 0x30, 0x6f, 0x58, 0x8d, 0x64, 0x25,
+	0xc9, 0x1d, 0x1e, 0xfc, 0xe3, 0x69, 0xa9, 0xd2, 0xb2, 0xfd, 0x76, 0xaf, 0x5e, 0x69, 0x70, 0xa7,
+	0x8a, 0x4f, 0xac, 0xfe, 0xef, 0x65, 0xd1, 0x3c, 0xad, 0xfa, 0x67, 0x5d, 0x26, 0x2a, 0x47, 0xa3,
+	0x32, 0x68, 0x5d, 0x0c, 0x64, 0x05, 0x25, 0xec, 0x2a, 0x2c, 0x35, 0xe4, 0xdb, 0x56, 0xb3, 0x9b,
+	0xf9, 0x74, 0xd9, 0xd8, 0x49, 0x59, 0x19, 0xb5, 0xbf, 0xd7, 0x24, 0xeb, 0x90, 0xe5, 0x7d, 0xe6,
+	0x79, 0x76, 0x93, 0x89, 0xfc, 0x82, 0xc2, 0x3a, 0x22, 0xc8, 0x22, 0x59, 0xef, 0xf0, 0xc6, 0x69,
+	0x6d, 0xc4, 0xb3, 0xa8, 0x78, 0x2e, 0x28, 0xf2, 0x5b, 0x01, 0xd5, 0x3c, 0x81, 0xcb, 0x77, 0x85,
+	0x6f, 0x3b, 0xd4, 0x67, 0xc7, 0x74, 0xe4, 0xd4, 0x55, 0x48, 0xb5, 0xa8, 0xf6, 0x41, 0xda, 0x92,
+	0x4b, 0x49, 0xf1, 0x98, 0xaf, 0xcc, 0xcf, 0x59, 0x72, 0x29, 0xc1, 0xf5, 0x9d, 0x1a, 0xf3, 0x3c,
+	0xae, 0xab, 0x67, 0xd6, 0xca, 0xf4, 0x9d, 0xbb, 0x72, 0x6b, 0xfe, 0xd6, 0x80, 0x2f, 0xe8, 0x5c,
+	0xb5, 0x9d, 0x5e, 0x87, 0xfa, 0xec, 0xed, 0xfd, 0x90, 0x7b, 0x79, 0xd7, 0x1f, 0xba, 0x57, 0xae,
+	0xff, 0x0b, 0xdd, 0x6b, 0xbe, 0x0c, 0x2f, 0xc5, 0x0c, 0x18, 0x65, 0x6e, 0x93, 0xfa, 0x34, 0xb0,
+	0x40, 0xae, 0xcd, 0x0f, 0xd3, 0x41, 0xf6, 0x78, 0xb4, 0xc1, 0x4e, 0x06, 0x81, 0xb5, 0xfb, 0x90,
+	0x72, 0x44, 0xd0, 0xb9, 0x94, 0xe2, 0x91, 0xf9, 0x50, 0xb4, 0xee, 0xfa, 0x6d, 0xe6, 0xb1, 0x9e,
+	0x73, 0x32, 0xb0, 0x24, 0x2f, 0xf9, 0x1a, 0xe4, 0x7c, 0x29, 0xa4, 0x86, 0x5d, 0x4f, 0x2a, 0xa9,
+	0xeb, 0x51, 0xaa, 0xb0, 0xeb, 0x59, 0xf6, 0x47, 0x1b, 0x72, 0x04, 0xb9, 0xae, 0xc7, 0x9a, 0xac,
+	0xc1, 0x84, 0xe0, 0x9e, 0xc8, 0xa7, 0x55, 0xea, 0xce, 0xd4, 0x1e, 0x39, 0x24, 0x0b, 0xaa, 0x8e,
+	0x20, 0x7c, 0x1f, 0x17, 0x94, 0x7f, 0x96, 0x15, 0x4d, 0xbf, 0x8e, 0x64, 0x03, 0x40, 0xb3, 0xa8,
+	0xf2, 0xb4, 0xa8, 0x42, 0x20, 0xab, 0x28, 0xaa, 0xde, 0xbe, 0x19, 0x7c, 0x96, 0xed, 0x5f, 0x3e,
+	0xa3, 0xcc, 0x28, 0x54, 0x74, 0x6f, 0x58, 0x09, 0x7a, 0xc3, 0xca, 0x49, 0xd0, 0x1b, 0x1e, 0xae,
+	0xc8, 0xf4, 0xfc, 0xf8, 0xcf, 0x25, 0x43, 0xa7, 0xa8, 0x96, 0x24, 0x3f, 0x4f, 0x0c, 0x83, 0xa5,
+	0xff, 0x4c, 0x18, 0x64, 0xa3, 0x59, 0x66, 0xc2, 0x8a, 0xb6, 0xc1, 0xa1, 0x83, 0x9a, 0xcc, 0x08,
+	0x08, 0xb9, 0xe1, 0x21, 0x1d, 0x1c, 0x53, 0xf1, 0xf5, 0xf4, 0xd2, 0xfc, 0x6a, 0xca, 0x5a, 0xf2,
+	0x07, 0x35, 0xdb, 0x6d, 0xb2, 0x81, 0xb9, 0x8b, 0x4f, 0xef, 0x30, 0x14, 0xa6, 0xc4, 0xcd, 0xef,
+	0xe6, 0xe1, 0xca, 0x88, 0xf9, 0x7f, 0xb0, 0x0c, 0x8d, 0x47, 0xeb, 0xc2, 0x0b, 0x47, 0x6b, 0xa4,
+	0x90, 0x2d, 0x9e, 0xa3, 0x90, 0x65, 0x26, 0x16, 0xb2, 0x3d, 0xac, 0x38, 0x21, 0x47, 0x4e, 0xf1,
+	0xfb, 0x6f, 0x52, 0x61, 0xf6, 0x43, 0x29, 0x2a, 0x94, 0xb2, 0xfe, 0x20, 0x78, 0xef, 0x66, 0xa7,
+	0xac, 0x3f, 0x10, 0x9f, 0x43, 0xca, 0xfe, 0x3f, 0xdb, 0xce, 0x99, 0x6d, 0xc3, 0xc2, 0x1c, 0xbe,
+	0xb8, 0x29, 0x17, 0xfd, 0x1a, 0x14, 0x15, 0xfb, 0x3d, 0xd7, 0x67, 0x9e, 0xc3, 0x9a, 0x36, 0xf5,
+	0x99, 0xc5, 0xb9, 0x2f, 0xc2, 0x1d, 0xb1, 0x27, 0x09, 0xea, 0xc6, 0x73, 0x96, 0xde, 0x98, 0x57,
+	0x86, 0x9d, 0xbf, 0x60, 0x6f, 0x30, 0x36, 0x9a, 0x51, 0xd7, 0xa2, 0x64, 0x14, 0xf2, 0x2a, 0x2c,
+	0xc9, 0x06, 0xa7, 0xf6, 0x98, 0x61, 0x67, 0x7d, 0x78, 0xf5, 0x4f, 0x4f, 0x4b, 0x57, 0xb4, 0x67,
+	0x44, 0xf3, 0xb4, 0x62, 0xf3, 0xaa, 0x43, 0xfd, 0x76, 0xe5, 0x9e, 0xeb, 0xcb, 0x8e, 0x5f, 0x9d,
+	0x36, 0x4b, 0x38, 0xeb, 0x1c, 0x77, 0x78, 0x9d, 0x76, 0x1e, 0xda, 0xee, 0x31, 0x15, 0x8f, 0x3c,
+	0x7b, 0x38, 0x68, 0x98, 0x0d, 0x44, 0x3f, 0x81, 0x01, 0x15, 0xdf, 0x81, 0x15, 0xc7, 0x76, 0xa5,
+	0xb3, 0x6a, 0x5d, 0xf9, 0x01, 0xb5, 0x6f, 0xc8, 0xdb, 0x4d, 0x46, 0xb0, 0xec, 0x8c, 0x44, 0x1d,
+	0xfc, 0xf1, 0x32, 0x2c, 0x28, 0x2d, 0xe4, 0xc7, 0x06, 0x64, 0x70, 0xdc, 0x22, 0x5b, 0xf1, 0xe8,
+	0x9d, 0x30, 0x4f, 0x17, 0x6e, 0xcc, 0x62, 0xd3, 0x38, 0xcd, 0x5b, 0x3f, 0xfc, 0xfd, 0x5f, 0x7f,
+	0x32, 0xbf, 0x45, 0xae, 0x55, 0x63, 0xbf, 0x6b, 0xc0, 0x91, 0xab, 0xfa, 0x1e, 0x06, 0xdb, 0x13,
+	0xf2, 0x73, 0x03, 0x56, 0x22, 0x53, 0x2d, 0xb9, 0x95, 0xa0, 0x66, 0xd2, 0xf4, 0x5c, 0xd8, 0x3b,
+	0x1f, 0x33, 0x22, 0x3b, 0x50, 0xc8, 0xf6, 0xc8, 0x6e, 0x1c, 0x59, 0x30, 0x40, 0xc7, 0x00, 0xfe,
+	0xca, 0x80, 0xd5, 0xf1, 0x01, 0x95, 0x54, 0x12, 0xd4, 0x26, 0xcc, 0xc5, 0x85, 0xea, 0xb9, 0xf9,
+	0x11, 0xe9, 0x6d, 0x85, 0xf4, 0x55, 0x72, 0x10, 0x47, 0xda, 0x0f, 0xce, 0x8c, 0xc0, 0x86, 0x67,
+	0xee, 0x27, 0xe4, 0x7d, 0x03, 0x32, 0x38, 0x8a, 0x26, 0x5e, 0x6d, 0x74, 0xca, 0x4d, 0xbc, 0xda,
+	0xb1, 0x89, 0xd6, 0xdc, 0x53, 0xb0, 0x6e, 0x90, 0xeb, 0x71, 0x58, 0x38, 0xda, 0x8a, 0x90, 0xeb,
+	0x3e, 0x32, 0x20, 0x83, 0x63, 0x56, 0x22, 0x90, 0xe8, 0x04, 0x9c, 0x08, 0x64, 0x6c, 0xb6, 0x35,
+	0xf7, 0x15, 0x90, 0x5b, 0xe4, 0x66, 0x1c, 0x08, 0xce, 0x81, 0x23, 0x1c, 0xd5, 0xf7, 0x4e, 0xd9,
+	0xd9, 0x13, 0xf2, 0x53, 0x03, 0x72, 0xe1, 0x79, 0x92, 0xec, 0x4e, 0xd7, 0x15, 0x9e, 0x8c, 0x0b,
+	0xb7, 0xce, 0xc5, 0x7b, 0x6e, 0x70, 0x35, 0x35, 0x66, 0x86, 0x5c, 0xf5, 0x2e, 0xa4, 0xe5, 0xc8,
+	0x48, 0xcc, 0xc4, 0x78, 0x1e, 0xce, 0xa1, 0x85, 0x6b, 0x53, 0x79, 0x10, 0xc3, 0x4d, 0x85, 0xe1,
+	0x1a, 0xd9, 0x9c, 0x14, 0xea, 0xcd, 0xc8, 0x35, 0x7d, 0x17, 0x16, 0xf5, 0xd4, 0x44, 0xae, 0x27,
+	0x48, 0x8e, 0x0c, 0x67, 0x85, 0xad, 0x19, 0x5c, 0x88, 0xa0, 0xac, 0x10, 0x14, 0x48, 0x3e, 0x8e,
+	0x40, 0x4f, 0x64, 0x64, 0x00, 0x19, 0x1c, 0xc8, 0x48, 0x39, 0x2e, 0x33, 0x3a, 0xab, 0x15, 0xb6,
+	0x67, 0x3d, 0xcf, 0x81, 0x5e, 0x53, 0xe9, 0x5d, 0x27, 0x85, 0xb8, 0x5e, 0xe6, 0xb7, 0x6b, 0x0d,
+	0xa9, 0xee, 0xfb, 0xb0, 0x1c, 0x1a, 0x85, 0xce, 0xa1, 0x7d, 0x82, 0xcd, 0x13, 0x66, 0x29, 0xf3,
+	0x86, 0xd2, 0x5d, 0x26, 0xc5, 0x09, 0xba, 0x91, 0x5d, 0xd6, 0x6f, 0xf2, 0x81, 0x01, 0x30, 0x1a,
+	0x37, 0xc8, 0x4e, 0x52, 0x74, 0x8d, 0x8f, 0x54, 0x85, 0x9b, 0xe7, 0xe0, 0x44, 0x2c, 0x5b, 0x0a,
+	0x4b, 0x89, 0x6c, 0x4c, 0x88, 0x42, 0xe4, 0xae, 0xf5, 0xf7, 0xc9, 0xf7, 0x20, 0x83, 0xdd, 0x6b,
+	0x62, 0x8e, 0x46, 0x07, 0x9d, 0xc4, 0x1c, 0x1d, 0x6b, 0x82, 0xa7, 0x5d, 0x84, 0x6e, 0xa1, 0xfc,
+	0x01, 0xf9, 0x91, 0x01, 0xd9, 0x61, 0x1b, 0x47, 0xb6, 0xa7, 0x49, 0x0e, 0x5f, 0xc7, 0xce, 0x6c,
+	0x46, 0x04, 0x71, 0x5d, 0x81, 0x28, 0x92, 0xf5, 0x24, 0x10, 0x2a, 0x1e, 0xe4, 0x7d, 0x8c, 0xba,
+	0x0c, 0x32, 0x55, 0x7c, 0xb8, 0x83, 0x4c, 0xbc, 0x8f, 0x78, 0xcb, 0x32, 0xed, 0x3e, 0x34, 0x12,
+	0xd5, 0xfa, 0x90, 0x9f, 0x19, 0x70, 0x29, 0xd6, 0xc1, 0xbc, 0x00, 0xa2, 0x57, 0x12, 0x38, 0x13,
+	0xbb, 0xa2, 0x69, 0x45, 0xdd, 0x0e, 0x1d, 0xaa, 0xa9, 0x6e, 0x49, 0xc6, 0x0b, 0x76, 0x44, 0x53,
+	0x1e, 0x97, 0x70, 0x23, 0x35, 0xe5, 0x71, 0x89, 0x34, 0x56, 0xd3, 0xe2, 0x25, 0x68, 0xb8, 0x64,
+	0xad, 0xc2, 0x36, 0xfa, 0x7a, 0x62, 0x15, 0x0c, 0xfd, 0xa1, 0x21, 0xb1, 0x56, 0x45, 0xff, 0xf0,
+	0x30, 0xad, 0x56, 0xe9, 0x3e, 0x9f, 0xfc, 0xc2, 0x80, 0x4b, 0xb1, 0xd6, 0x8c, 0x24, 0xbd, 0xeb,
+	0x49, 0x5d, 0x5e, 0xe2, 0xed, 0x24, 0x76, 0x7d, 0xe6, 0xb6, 0x82, 0xb6, 0x49, 0x4a, 0x71, 0x68,
+	0x91, 0x6e, 0xf0, 0xf0, 0xf6, 0xa7, 0xcf, 0x8a, 0xc6, 0x67, 0xcf, 0x8a, 0xc6, 0x5f, 0x9e, 0x15,
+	0x8d, 0x8f, 0x9f, 0x17, 0xe7, 0x3e, 0x7b, 0x5e, 0x9c, 0xfb, 0xc3, 0xf3, 0xe2, 0xdc, 0xb7, 0xcb,
+	0xf1, 0x3e, 0x5e, 0x0a, 0x19, 0x48, 0x31, 0xaa, 0x8b, 0xaf, 0x2f, 0xaa, 0xa9, 0xe1, 0x4b, 0xff,
+	0x0a, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x4c, 0xfb, 0xb7, 0xa9, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1646,6 +2177,13 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageProof queries the Merkle-Patricia storage root of an account and
+	// the proofs of the given storage keys against it. The storage root is
+	// built on demand and is NOT committed to by the app hash, so the proofs
+	// are not verifiable against the chain state. It is only served by the
+	// nodes enabling the storage proofs, for the accounts with up to the
+	// configured max number of storage slots.
+	StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
@@ -1654,11 +2192,17 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// IntermediateRoots implements the `debug_intermediateRoots` rpc api
+	IntermediateRoots(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryIntermediateRootsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -1725,6 +2269,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error) {
+	out := new(QueryStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/StorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Code", in, out, opts...)
@@ -1761,6 +2314,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error) {
+	out := new(QuerySimulateV1Response)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceTx", in, out, opts...)
@@ -1770,6 +2332,15 @@ func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error) {
 	out := new(QueryTraceBlockResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceBlock", in, out, opts...)
@@ -1779,6 +2350,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) IntermediateRoots(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryIntermediateRootsResponse, error) {
+	out := new(QueryIntermediateRootsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/IntermediateRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/BaseFee", in, out, opts...)
@@ -1820,6 +2400,13 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageProof queries the Merkle-Patricia storage root of an account and
+	// the proofs of the given storage keys against it. The storage root is
+	// built on demand and is NOT committed to by the app hash, so the proofs
+	// are not verifiable against the chain state. It is only served by the
+	// nodes enabling the storage proofs, for the accounts with up to the
+	// configured max number of storage slots.
+	StorageProof(context.Context, *QueryStorageProofRequest) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
@@ -1828,11 +2415,17 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *QuerySimulateV1Request) (*QuerySimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// IntermediateRoots implements the `debug_intermediateRoots` rpc api
+	IntermediateRoots(context.Context, *QueryTraceBlockRequest) (*QueryIntermediateRootsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -1865,6 +2458,9 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (*UnimplementedQueryServer) StorageProof(ctx context.Context, req *QueryStorageProofRequest) (*QueryStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProof not implemented")
+}
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -1877,12 +2473,21 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *QuerySimulateV1Request) (*QuerySimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) IntermediateRoots(ctx context.Context, req *QueryTraceBlockRequest) (*QueryIntermediateRootsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediateRoots not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1987,6 +2592,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/StorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProof(ctx, req.(*QueryStorageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -2059,6 +2682,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*QuerySimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2077,6 +2718,24 @@ func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceBlockRequest)
 	if err := dec(in); err != nil {
@@ -2095,6 +2754,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IntermediateRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediateRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/IntermediateRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediateRoots(ctx, req.(*QueryTraceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -2173,6 +2850,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "StorageProof",
+			Handler:    _Query_StorageProof_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -2189,14 +2870,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "IntermediateRoots",
+			Handler:    _Query_IntermediateRoots_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2611,7 +3304,7 @@ func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageProofRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2621,16 +3314,25 @@ func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageProofRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -2641,7 +3343,7 @@ func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *StorageKeyProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2651,18 +3353,138 @@ func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorageKeyProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorageKeyProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StorageHash) > 0 {
+		i -= len(m.StorageHash)
+		copy(dAtA[i:], m.StorageHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
 		copy(dAtA[i:], m.Code)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
 		i--
@@ -2838,6 +3660,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2907,6 +3743,83 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3035,7 +3948,7 @@ func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3045,53 +3958,30 @@ func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
 		i--
-		dAtA[i] = 0x42
-	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err8 != nil {
-		return 0, err8
+		dAtA[i] = 0x3a
 	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x28
-	}
 	if m.TraceConfig != nil {
 		{
 			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
@@ -3102,26 +3992,36 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3131,12 +4031,12 @@ func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3151,7 +4051,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3161,21 +4061,169 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateRootsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateRootsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateRootsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roots[iNdEx])
+			copy(dAtA[i:], m.Roots[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Roots[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -3451,6 +4499,67 @@ func (m *QueryStorageResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStorageProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StorageKeyProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorageProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCodeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3553,6 +4662,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3576,35 +4693,19 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTraceTxRequest) Size() (n int) {
+func (m *QuerySimulateV1Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Predecessors) > 0 {
-		for _, e := range m.Predecessors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
+	l = len(m.Opts)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
 	l = len(m.ProposerAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -3612,13 +4713,10 @@ func (m *QueryTraceTxRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
-	}
 	return n
 }
 
-func (m *QueryTraceTxResponse) Size() (n int) {
+func (m *QuerySimulateV1Response) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3631,22 +4729,26 @@ func (m *QueryTraceTxResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTraceBlockRequest) Size() (n int) {
+func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.TraceConfig != nil {
 		l = m.TraceConfig.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.BlockNumber != 0 {
 		n += 1 + sovQuery(uint64(m.BlockNumber))
 	}
@@ -3669,7 +4771,7 @@ func (m *QueryTraceBlockRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryTraceBlockResponse) Size() (n int) {
+func (m *QueryTraceTxResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3682,26 +4784,140 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryBaseFeeResponse) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
+	l = len(m.Args)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateRootsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, b := range m.Roots {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
@@ -4845,7 +6061,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4868,10 +6084,10 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4906,6 +6122,38 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4927,7 +6175,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *StorageKeyProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4950,15 +6198,79 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageKeyProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageKeyProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4985,10 +6297,8 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5011,7 +6321,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5034,15 +6344,15 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5070,11 +6380,11 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.StorageHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5101,10 +6411,8 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proofs = append(m.Proofs, StorageKeyProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5129,7 +6437,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5152,17 +6460,17 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5172,61 +6480,23 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5249,7 +6519,7 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5272,12 +6542,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5299,7 +6603,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5322,15 +6626,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5357,7 +6693,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5382,7 +6721,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5405,17 +6744,17 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5425,50 +6764,31 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
-			}
-			m.GasCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5478,45 +6798,78 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5538,7 +6891,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5561,17 +6914,17 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.Gas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5581,14 +6934,78 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5615,16 +7032,893 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5634,23 +7928,25 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5673,7 +7969,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5696,17 +7992,17 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5716,33 +8012,31 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			var msglen int
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5752,33 +8046,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5788,31 +8065,31 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.BlockNumber = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5822,16 +8099,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5841,29 +8118,33 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5873,28 +8154,29 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5921,49 +8203,11 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5985,7 +8229,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6008,10 +8252,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6429,6 +8673,88 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIntermediateRootsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateRootsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateRootsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, make([]byte, postIndex-iNdEx))
+			copy(m.Roots[len(m.Roots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
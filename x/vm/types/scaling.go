@@ -66,3 +66,23 @@ func ConvertCoinsDenomToExtendedDenom(coins sdk.Coins) sdk.Coins {
 	}
 	return convertedCoins.Sort()
 }
+
+// ConvertCoinsFrom18DecimalsRoundUp returns the given coins with the amount of
+// the evm coin, in its 18 decimals representation, converted to the decimals of
+// the evm denom. The amount is rounded up.
+func ConvertCoinsFrom18DecimalsRoundUp(coins sdk.Coins) sdk.Coins {
+	evmDenom := GetEVMCoinDenom()
+	conversionFactor := GetEVMCoinDecimals().ConversionFactor()
+	convertedCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Denom == evmDenom {
+			amount := coin.Amount.Quo(conversionFactor)
+			if !coin.Amount.Mod(conversionFactor).IsZero() {
+				amount = amount.AddRaw(1)
+			}
+			coin = sdk.Coin{Denom: evmDenom, Amount: amount}
+		}
+		convertedCoins[i] = coin
+	}
+	return convertedCoins
+}
@@ -149,6 +149,56 @@ func TestConvertCoinsFrom18Decimals(t *testing.T) {
 	}
 }
 
+func TestConvertCoinsFrom18DecimalsRoundUp(t *testing.T) {
+	eighteenDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]
+	sixDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID]
+
+	nonBaseCoin := sdk.Coin{Denom: "btc", Amount: math.NewInt(10)}
+
+	testCases := []struct {
+		name        string
+		evmCoinInfo evmtypes.EvmCoinInfo
+		coins       sdk.Coins
+		expCoins    sdk.Coins
+	}{
+		{
+			name:        "pass - no evm denom",
+			evmCoinInfo: sixDecimalsCoinInfo,
+			coins:       sdk.Coins{nonBaseCoin},
+			expCoins:    sdk.Coins{nonBaseCoin},
+		},
+		{
+			name:        "pass - no conversion with 18 decimals",
+			evmCoinInfo: eighteenDecimalsCoinInfo,
+			coins:       sdk.Coins{sdk.Coin{Denom: eighteenDecimalsCoinInfo.Denom, Amount: math.NewInt(10)}},
+			expCoins:    sdk.Coins{sdk.Coin{Denom: eighteenDecimalsCoinInfo.Denom, Amount: math.NewInt(10)}},
+		},
+		{
+			name:        "pass - conversion with 6 decimals",
+			evmCoinInfo: sixDecimalsCoinInfo,
+			coins:       sdk.Coins{sdk.Coin{Denom: sixDecimalsCoinInfo.Denom, Amount: math.NewInt(2e12)}},
+			expCoins:    sdk.Coins{sdk.Coin{Denom: sixDecimalsCoinInfo.Denom, Amount: math.NewInt(2)}},
+		},
+		{
+			name:        "pass - fractional amount rounded up with 6 decimals",
+			evmCoinInfo: sixDecimalsCoinInfo,
+			coins:       sdk.Coins{nonBaseCoin, sdk.Coin{Denom: sixDecimalsCoinInfo.Denom, Amount: math.NewInt(2e12 + 1)}}.Sort(),
+			expCoins:    sdk.Coins{nonBaseCoin, sdk.Coin{Denom: sixDecimalsCoinInfo.Denom, Amount: math.NewInt(3)}}.Sort(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, configurator.WithEVMCoinInfo(tc.evmCoinInfo).Configure())
+
+			coinConverted := evmtypes.ConvertCoinsFrom18DecimalsRoundUp(tc.coins)
+			require.Equal(t, tc.expCoins, coinConverted, "expected a different coin")
+		})
+	}
+}
+
 func TestConvertAmountTo18DecimalsLegacy(t *testing.T) {
 	testCases := []struct {
 		name    string
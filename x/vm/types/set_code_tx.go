@@ -0,0 +1,306 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/types"
+	ethutils "github.com/cosmos/evm/utils/eth"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewSetCodeTx(tx *ethtypes.Transaction) (*SetCodeTx, error) {
+	txData := &SetCodeTx{
+		Nonce:    tx.Nonce(),
+		Data:     tx.Data(),
+		GasLimit: tx.Gas(),
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	if to := tx.To(); to != nil {
+		txData.To = to.Hex()
+	}
+
+	if tx.Value() != nil {
+		amountInt, err := types.SafeNewIntFromBigInt(tx.Value())
+		if err != nil {
+			return nil, err
+		}
+		txData.Amount = &amountInt
+	}
+
+	if tx.GasFeeCap() != nil {
+		gasFeeCapInt, err := types.SafeNewIntFromBigInt(tx.GasFeeCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasFeeCap = &gasFeeCapInt
+	}
+
+	if tx.GasTipCap() != nil {
+		gasTipCapInt, err := types.SafeNewIntFromBigInt(tx.GasTipCap())
+		if err != nil {
+			return nil, err
+		}
+		txData.GasTipCap = &gasTipCapInt
+	}
+
+	if tx.AccessList() != nil {
+		al := tx.AccessList()
+		txData.Accesses = NewAccessList(&al)
+	}
+
+	txData.Authorizations = NewAuthorizationList(tx.SetCodeAuthorizations())
+
+	txData.SetSignatureValues(tx.ChainId(), v, r, s)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return ethtypes.SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: tx.Authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetAuthorizationList returns the Authorizations field.
+func (tx *SetCodeTx) GetAuthorizationList() []ethtypes.SetCodeAuthorization {
+	if tx.Authorizations == nil {
+		return nil
+	}
+	return tx.Authorizations.ToEthAuthorizationList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns an SetCodeTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.SetCodeTx{
+		ChainID:    uint256FromBig(tx.GetChainID()),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  uint256FromBig(tx.GetGasTipCap()),
+		GasFeeCap:  uint256FromBig(tx.GetGasFeeCap()),
+		Gas:        tx.GetGas(),
+		To:         common.HexToAddress(tx.To),
+		Value:      uint256FromBig(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		AuthList:   tx.GetAuthorizationList(),
+		V:          uint256FromBig(v),
+		R:          uint256FromBig(r),
+		S:          uint256FromBig(s),
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// SetCode txs cannot be used to create contracts
+	if tx.To == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "to address cannot be empty on SetCode txs")
+	}
+
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "authorization list cannot be empty")
+	}
+
+	if err := tx.Authorizations.Validate(); err != nil {
+		return err
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GetGas())
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GetGas())
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
@@ -0,0 +1,197 @@
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func (suite *TxDataTestSuite) signedAuthorization() ethtypes.SetCodeAuthorization {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := ethtypes.SignSetCode(key, ethtypes.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(9001),
+		Address: suite.addr,
+		Nonce:   1,
+	})
+	suite.Require().NoError(err)
+	return auth
+}
+
+func (suite *TxDataTestSuite) TestNewSetCodeTx() {
+	auth := suite.signedAuthorization()
+	ethTx := ethtypes.NewTx(&ethtypes.SetCodeTx{
+		ChainID:    uint256.NewInt(9001),
+		Nonce:      1,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(2),
+		Gas:        100,
+		To:         suite.addr,
+		Value:      uint256.NewInt(1),
+		Data:       []byte("data"),
+		AccessList: ethtypes.AccessList{},
+		AuthList:   []ethtypes.SetCodeAuthorization{auth},
+		V:          uint256.NewInt(1),
+		R:          uint256.NewInt(1),
+		S:          uint256.NewInt(1),
+	})
+
+	tx, err := types.NewSetCodeTx(ethTx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(tx.Validate())
+	suite.Require().Equal(ethTx.Hash(), ethtypes.NewTx(tx.AsEthereumData()).Hash())
+	suite.Require().Equal([]ethtypes.SetCodeAuthorization{auth}, tx.GetAuthorizationList())
+
+	authority, err := tx.GetAuthorizationList()[0].Authority()
+	suite.Require().NoError(err)
+	expAuthority, err := auth.Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAuthority, authority)
+
+	txData, err := types.NewTxDataFromTx(ethTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(ethtypes.SetCodeTxType), txData.TxType())
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxValidate() {
+	authList := types.NewAuthorizationList([]ethtypes.SetCodeAuthorization{suite.signedAuthorization()})
+	chainID := sdkmath.NewInt(9001)
+
+	testCases := []struct {
+		name     string
+		tx       types.SetCodeTx
+		expError bool
+	}{
+		{
+			"valid",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				ChainID:        &chainID,
+				Authorizations: authList,
+			},
+			false,
+		},
+		{
+			"to address empty",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				ChainID:        &chainID,
+				Authorizations: authList,
+			},
+			true,
+		},
+		{
+			"empty authorization list",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				ChainID:   &chainID,
+			},
+			true,
+		},
+		{
+			"invalid authorization address",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				ChainID:   &chainID,
+				Authorizations: types.AuthorizationList{
+					{ChainID: &chainID, Address: suite.invalidAddr},
+				},
+			},
+			true,
+		},
+		{
+			"negative authorization chain ID",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				ChainID:   &chainID,
+				Authorizations: types.AuthorizationList{
+					{ChainID: &suite.sdkMinusOneInt, Address: suite.hexAddr},
+				},
+			},
+			true,
+		},
+		{
+			"authorization signature out of bound",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				ChainID:   &chainID,
+				Authorizations: types.AuthorizationList{
+					{ChainID: &chainID, Address: suite.hexAddr, V: big.NewInt(256).Bytes()},
+				},
+			},
+			true,
+		},
+		{
+			"gas tip cap higher than gas fee cap",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkZeroInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				ChainID:        &chainID,
+				Authorizations: authList,
+			},
+			true,
+		},
+		{
+			"chain ID not present",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				Authorizations: authList,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestAuthorizationListConversion() {
+	ethAuthList := []ethtypes.SetCodeAuthorization{
+		suite.signedAuthorization(),
+		{Address: common.Address{}},
+	}
+
+	authList := types.NewAuthorizationList(ethAuthList)
+	suite.Require().Len(authList, 2)
+	suite.Require().NoError(authList.Validate())
+	suite.Require().Equal(ethAuthList, authList.ToEthAuthorizationList())
+	suite.Require().Nil(types.NewAuthorizationList(nil))
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks, including the ones
+	// filling the gaps between block number overrides, that a single
+	// eth_simulateV1 request can simulate.
+	MaxSimulateBlocks = 256
+
+	// SimErrCodeReverted is the error code of a simulated call that reverted.
+	SimErrCodeReverted = -32000
+	// SimErrCodeVMError is the error code of a simulated call that failed with
+	// an EVM error other than a revert.
+	SimErrCodeVMError = -32015
+)
+
+var (
+	// TransferLogAddress is the pseudo address that emits the ERC-7528
+	// compatible logs of the native value transfers in a simulation.
+	TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// TransferLogTopic is the topic of the logs emitted for the native value
+	// transfers in a simulation, i.e. the ERC-20 Transfer event signature.
+	TransferLogTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides StateOverride     `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// Validate performs a stateless validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	for i, block := range opts.BlockStateCalls {
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SimCallError is the error of a simulated call that failed during execution.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimCallResult is the outcome of a single simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimBlockResult is a simulated block, carrying the header fields needed to
+// format it as an RPC block, the calls with their defaults filled in and
+// their results.
+type SimBlockResult struct {
+	Number       hexutil.Uint64    `json:"number"`
+	Time         hexutil.Uint64    `json:"timestamp"`
+	GasLimit     hexutil.Uint64    `json:"gasLimit"`
+	GasUsed      hexutil.Uint64    `json:"gasUsed"`
+	FeeRecipient common.Address    `json:"miner"`
+	BaseFee      *hexutil.Big      `json:"baseFeePerGas"`
+	Transactions []TransactionArgs `json:"transactions"`
+	Calls        []SimCallResult   `json:"calls"`
+}
+
+// NewTransferLog returns the log emitted for a native value transfer of the
+// given amount between two accounts in a simulation.
+func NewTransferLog(from, to common.Address, amount *big.Int) *ethtypes.Log {
+	return &ethtypes.Log{
+		Address: TransferLogAddress,
+		Topics: []common.Hash{
+			TransferLogTopic,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.BigToHash(amount).Bytes(),
+	}
+}
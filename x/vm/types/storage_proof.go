@@ -0,0 +1,107 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// StorageTrie is the Merkle-Patricia trie of the storage of an account, built
+// as in Ethereum: the keys are the keccak256 hashes of the storage keys and the
+// values are the RLP encoded storage values with their leading zeros trimmed.
+// The storage of the EVM module lives in the IAVL tree, so the trie is built in
+// memory on demand and its root is NOT committed to by the app hash or any
+// block header: it only commits to the storage read from the queried node, and
+// its proofs are not verifiable against the chain state.
+type StorageTrie struct {
+	trie *trie.Trie
+}
+
+// NewStorageTrie returns an empty storage trie.
+func NewStorageTrie() *StorageTrie {
+	db := triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil)
+	return &StorageTrie{trie: trie.NewEmpty(db)}
+}
+
+// Update sets the value of a storage key. Empty values are not stored.
+func (st *StorageTrie) Update(key, value common.Hash) error {
+	if value == (common.Hash{}) {
+		return nil
+	}
+	encoded, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+	if err != nil {
+		return err
+	}
+	return st.trie.Update(crypto.Keccak256(key.Bytes()), encoded)
+}
+
+// Hash returns the storage root hash.
+func (st *StorageTrie) Hash() common.Hash {
+	return st.trie.Hash()
+}
+
+// Prove returns the RLP encoded trie nodes on the path from the root to the
+// storage key, ordered from the root. The proof of a missing key proves its
+// absence.
+func (st *StorageTrie) Prove(key common.Hash) ([][]byte, error) {
+	var proof proofList
+	if err := st.trie.Prove(crypto.Keccak256(key.Bytes()), &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// VerifyStorageProof verifies the Merkle-Patricia proof of a storage key
+// against a storage root hash, as returned by `eth_getProof`, and returns the
+// proven storage value. The value of a key proven absent is the zero hash.
+// As the storage root is not part of the chain state, a valid proof only shows
+// that the value is consistent with the storage root returned by the node.
+func VerifyStorageProof(storageHash, key common.Hash, proof [][]byte) (common.Hash, error) {
+	if storageHash == ethtypes.EmptyRootHash && len(proof) == 0 {
+		return common.Hash{}, nil
+	}
+
+	db := memorydb.New()
+	for _, node := range proof {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return common.Hash{}, err
+		}
+	}
+
+	encoded, err := trie.VerifyProof(storageHash, crypto.Keccak256(key.Bytes()), db)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(encoded) == 0 {
+		return common.Hash{}, nil
+	}
+
+	_, content, _, err := rlp.Split(encoded)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(content) > common.HashLength {
+		return common.Hash{}, errors.New("invalid storage value length")
+	}
+	return common.BytesToHash(content), nil
+}
+
+// proofList collects the trie nodes written by trie.Prove, in order.
+type proofList [][]byte
+
+func (l *proofList) Put(_ []byte, value []byte) error {
+	*l = append(*l, bytes.Clone(value))
+	return nil
+}
+
+func (l *proofList) Delete([]byte) error {
+	return errors.New("deleting from a proof list is not supported")
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestStorageTrie(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	storage := map[common.Hash]common.Hash{
+		common.HexToHash("0x0"):  common.HexToHash("0x2a"),
+		common.HexToHash("0x1"):  common.HexToHash("0xff00000000000000000000000000000000000000000000000000000000000001"),
+		common.HexToHash("0xab"): common.HexToHash("0x100"),
+		common.HexToHash("0xcd"): {}, // empty values are not stored
+	}
+
+	storageTrie := types.NewStorageTrie()
+	require.Equal(t, ethtypes.EmptyRootHash, storageTrie.Hash())
+
+	// the storage root must match the one of an Ethereum account with the
+	// same storage
+	ethState, err := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	ethState.SetNonce(addr, 1, tracing.NonceChangeUnspecified)
+	for key, value := range storage {
+		require.NoError(t, storageTrie.Update(key, value))
+		ethState.SetState(addr, key, value)
+	}
+	ethState.IntermediateRoot(false)
+	storageHash := storageTrie.Hash()
+	require.Equal(t, ethState.GetStorageRoot(addr), storageHash)
+
+	for key, value := range storage {
+		proof, err := storageTrie.Prove(key)
+		require.NoError(t, err)
+		proven, err := types.VerifyStorageProof(storageHash, key, proof)
+		require.NoError(t, err)
+		require.Equal(t, value, proven)
+	}
+
+	// missing keys are proven absent
+	proof, err := storageTrie.Prove(common.HexToHash("0xdead"))
+	require.NoError(t, err)
+	proven, err := types.VerifyStorageProof(storageHash, common.HexToHash("0xdead"), proof)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, proven)
+
+	// proofs don't verify against another root
+	proof, err = storageTrie.Prove(common.HexToHash("0x0"))
+	require.NoError(t, err)
+	_, err = types.VerifyStorageProof(common.HexToHash("0x1234"), common.HexToHash("0x0"), proof)
+	require.Error(t, err)
+
+	// an empty storage proves any key absent
+	proven, err = types.VerifyStorageProof(ethtypes.EmptyRootHash, common.HexToHash("0x0"), nil)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, proven)
+}
@@ -47,6 +47,14 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// TraceCallConfig is the config of the `debug_traceCall` rpc api. It extends
+// the trace config with the state and block overrides applied before tracing.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
@@ -260,6 +260,120 @@ func (m *DynamicFeeTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
+// SetCodeTx is the data of EIP-7702 set code transactions.
+type SetCodeTx struct {
+	// chain_id of the destination EVM chain
+	ChainID *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chainID"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_tip_cap defines the max value for the gas tip
+	GasTipCap *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3,customtype=cosmossdk.io/math.Int" json:"gas_tip_cap,omitempty"`
+	// gas_fee_cap defines the max value for the gas fee
+	GasFeeCap *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3,customtype=cosmossdk.io/math.Int" json:"gas_fee_cap,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the hex formatted address of the recipient
+	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the transaction amount.
+	Amount *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses AccessList `protobuf:"bytes,9,rep,name=accesses,proto3,castrepeated=AccessList" json:"accessList"`
+	// authorizations is the list of code delegations signed by the authorities
+	Authorizations AuthorizationList `protobuf:"bytes,10,rep,name=authorizations,proto3,castrepeated=AuthorizationList" json:"authorizationList"`
+	// v defines the signature value
+	V []byte `protobuf:"bytes,11,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,12,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,13,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeTx) Reset()         { *m = SetCodeTx{} }
+func (m *SetCodeTx) String() string { return proto.CompactTextString(m) }
+func (*SetCodeTx) ProtoMessage()    {}
+func (*SetCodeTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{4}
+}
+func (m *SetCodeTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeTx.Merge(m, src)
+}
+func (m *SetCodeTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeTx proto.InternalMessageInfo
+
+// SetCodeAuthorization is an authorization from an account to delegate its
+// code to a contract (EIP-7702).
+type SetCodeAuthorization struct {
+	// chain_id of the chain the authorization is valid on, zero being valid on
+	// any chain
+	ChainID *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chainID"`
+	// address is the hex formatted address of the delegated code
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce corresponds to the authority account nonce
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// v defines the signature value
+	V []byte `protobuf:"bytes,4,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeAuthorization) Reset()         { *m = SetCodeAuthorization{} }
+func (m *SetCodeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SetCodeAuthorization) ProtoMessage()    {}
+func (*SetCodeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{5}
+}
+func (m *SetCodeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeAuthorization.Merge(m, src)
+}
+func (m *SetCodeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeAuthorization proto.InternalMessageInfo
+
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
 }
@@ -268,7 +382,7 @@ func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthe
 func (m *ExtensionOptionsEthereumTx) String() string { return proto.CompactTextString(m) }
 func (*ExtensionOptionsEthereumTx) ProtoMessage()    {}
 func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a8ac5e8c9c4850, []int{4}
+	return fileDescriptor_77a8ac5e8c9c4850, []int{6}
 }
 func (m *ExtensionOptionsEthereumTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +433,7 @@ func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
 func (m *MsgEthereumTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumTxResponse) ProtoMessage()    {}
 func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a8ac5e8c9c4850, []int{5}
+	return fileDescriptor_77a8ac5e8c9c4850, []int{7}
 }
 func (m *MsgEthereumTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +475,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a8ac5e8c9c4850, []int{6}
+	return fileDescriptor_77a8ac5e8c9c4850, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +527,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a8ac5e8c9c4850, []int{7}
+	return fileDescriptor_77a8ac5e8c9c4850, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +568,7 @@ func (m *MsgRegisterPreinstalls) Reset()         { *m = MsgRegisterPreinstalls{}
 func (m *MsgRegisterPreinstalls) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPreinstalls) ProtoMessage()    {}
 func (*MsgRegisterPreinstalls) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a8ac5e8c9c4850, []int{8}
+	return fileDescriptor_77a8ac5e8c9c4850, []int{10}
 }
 func (m *MsgRegisterPreinstalls) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +620,7 @@ func (m *MsgRegisterPreinstallsResponse) Reset()         { *m = MsgRegisterPrein
 func (m *MsgRegisterPreinstallsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPreinstallsResponse) ProtoMessage()    {}
 func (*MsgRegisterPreinstallsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a8ac5e8c9c4850, []int{9}
+	return fileDescriptor_77a8ac5e8c9c4850, []int{11}
 }
 func (m *MsgRegisterPreinstallsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,6 +654,8 @@ func init() {
 	proto.RegisterType((*LegacyTx)(nil), "cosmos.evm.vm.v1.LegacyTx")
 	proto.RegisterType((*AccessListTx)(nil), "cosmos.evm.vm.v1.AccessListTx")
 	proto.RegisterType((*DynamicFeeTx)(nil), "cosmos.evm.vm.v1.DynamicFeeTx")
+	proto.RegisterType((*SetCodeTx)(nil), "cosmos.evm.vm.v1.SetCodeTx")
+	proto.RegisterType((*SetCodeAuthorization)(nil), "cosmos.evm.vm.v1.SetCodeAuthorization")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "cosmos.evm.vm.v1.ExtensionOptionsEthereumTx")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "cosmos.evm.vm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.vm.v1.MsgUpdateParams")
@@ -551,78 +667,85 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/tx.proto", fileDescriptor_77a8ac5e8c9c4850) }
 
 var fileDescriptor_77a8ac5e8c9c4850 = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x6b, 0x23, 0x45,
-	0x1c, 0xef, 0x26, 0x9b, 0x5f, 0x93, 0x78, 0x77, 0xee, 0xb5, 0x76, 0x1b, 0xee, 0xb2, 0xb9, 0xd5,
-	0x3b, 0x73, 0x95, 0x66, 0xbd, 0x0a, 0x42, 0xe3, 0x53, 0x73, 0x6d, 0xa5, 0xd2, 0x62, 0x59, 0x73,
-	0x2f, 0x22, 0xc4, 0xe9, 0xee, 0x74, 0xb3, 0x98, 0xdd, 0x59, 0x77, 0x26, 0x21, 0x11, 0x04, 0xb9,
-	0x27, 0xf1, 0x49, 0xf0, 0x59, 0xf0, 0xc1, 0x07, 0xf5, 0xa9, 0x0f, 0xf7, 0xe4, 0x5f, 0x70, 0xf8,
-	0x74, 0x28, 0x88, 0x88, 0xe4, 0xa4, 0x15, 0x0a, 0x7d, 0xf4, 0x2f, 0x90, 0x99, 0xd9, 0x34, 0x9b,
-	0x26, 0xfd, 0x61, 0x41, 0x21, 0x84, 0x99, 0xf9, 0x7e, 0xbe, 0xdf, 0x99, 0xef, 0xe7, 0xf3, 0xd9,
-	0xd9, 0x05, 0x0b, 0x16, 0x26, 0x1e, 0x26, 0x06, 0xea, 0x7a, 0x06, 0xfb, 0x3d, 0x30, 0x68, 0xaf,
-	0x1a, 0x84, 0x98, 0x62, 0xe5, 0x86, 0x08, 0x55, 0x51, 0xd7, 0xab, 0xb2, 0xdf, 0x83, 0xe2, 0x8b,
-	0xd0, 0x73, 0x7d, 0x6c, 0xf0, 0x7f, 0x01, 0x2a, 0x16, 0x27, 0xf2, 0x19, 0x5c, 0xc4, 0xe6, 0xa3,
-	0x98, 0x47, 0x1c, 0x16, 0xf0, 0x88, 0x13, 0x05, 0xa2, 0x4d, 0x9b, 0x7c, 0x66, 0x44, 0xdb, 0x88,
-	0xd0, 0xac, 0x83, 0x1d, 0x2c, 0xd6, 0xd9, 0x28, 0x5a, 0xbd, 0xe5, 0x60, 0xec, 0xb4, 0x91, 0x01,
-	0x03, 0xd7, 0x80, 0xbe, 0x8f, 0x29, 0xa4, 0x2e, 0xf6, 0x87, 0x39, 0x0b, 0x51, 0x94, 0xcf, 0x76,
-	0x3b, 0x7b, 0x06, 0xf4, 0xfb, 0x22, 0xa4, 0x1f, 0x4a, 0xe0, 0x85, 0x6d, 0xe2, 0xac, 0xd3, 0x16,
-	0x0a, 0x51, 0xc7, 0x6b, 0xf4, 0x94, 0x0a, 0x90, 0x6d, 0x48, 0xa1, 0x2a, 0x95, 0xa5, 0x4a, 0x7e,
-	0x79, 0xb6, 0x2a, 0x72, 0xab, 0xc3, 0xdc, 0xea, 0xaa, 0xdf, 0x37, 0x39, 0x42, 0x29, 0x01, 0x99,
-	0xb8, 0x9f, 0x20, 0x35, 0x51, 0x96, 0x2a, 0x52, 0x1d, 0x1c, 0x0f, 0x34, 0x69, 0xe9, 0xbb, 0xa3,
-	0xfd, 0x45, 0xc9, 0xe4, 0xeb, 0xca, 0x2b, 0x40, 0x6e, 0x41, 0xd2, 0x52, 0x93, 0x65, 0xa9, 0x92,
-	0xab, 0xdf, 0xf8, 0x7b, 0xa0, 0x65, 0xc2, 0x76, 0x50, 0xd3, 0x97, 0xf4, 0x08, 0xc5, 0xa2, 0xca,
-	0x6b, 0xe0, 0xba, 0x8d, 0x82, 0x10, 0x59, 0x90, 0x22, 0xbb, 0xb9, 0x17, 0x62, 0x4f, 0x95, 0x79,
-	0x42, 0x42, 0x95, 0xcc, 0x6b, 0xa3, 0xd0, 0x46, 0x88, 0x3d, 0x45, 0x01, 0x32, 0x47, 0xa4, 0xca,
-	0x52, 0xa5, 0x60, 0xf2, 0x71, 0xed, 0xce, 0xe7, 0xdf, 0x68, 0x33, 0x5f, 0x1c, 0xed, 0x2f, 0xaa,
-	0x31, 0xaa, 0xc7, 0x7a, 0xd2, 0xbf, 0x4f, 0x80, 0xec, 0x16, 0x72, 0xa0, 0xd5, 0x6f, 0xf4, 0x94,
-	0x59, 0x90, 0xf2, 0xb1, 0x6f, 0x21, 0xde, 0xa1, 0x6c, 0x8a, 0x89, 0xf2, 0x26, 0xc8, 0x39, 0x90,
-	0x31, 0xee, 0x5a, 0xa2, 0xa3, 0x5c, 0x7d, 0xe1, 0xf7, 0x81, 0x36, 0x27, 0x6a, 0x12, 0xfb, 0xa3,
-	0xaa, 0x8b, 0x0d, 0x0f, 0xd2, 0x56, 0x75, 0xd3, 0xa7, 0x66, 0xd6, 0x81, 0x64, 0x87, 0x41, 0x95,
-	0x12, 0x48, 0x3a, 0x90, 0xf0, 0x1e, 0xe5, 0x7a, 0xe1, 0x60, 0xa0, 0x65, 0xdf, 0x86, 0x64, 0xcb,
-	0xf5, 0x5c, 0x6a, 0xb2, 0x80, 0x72, 0x0d, 0x24, 0x28, 0x16, 0x1d, 0x99, 0x09, 0x8a, 0x95, 0x15,
-	0x90, 0xea, 0xc2, 0x76, 0x07, 0xf1, 0x16, 0x72, 0xf5, 0x97, 0xcf, 0xdc, 0xe3, 0x60, 0xa0, 0xa5,
-	0x57, 0x3d, 0xdc, 0xf1, 0xa9, 0x29, 0x32, 0x58, 0xf3, 0x5c, 0x99, 0xb4, 0x68, 0x9e, 0x6b, 0x50,
-	0x00, 0x52, 0x57, 0xcd, 0xf0, 0x05, 0xa9, 0xcb, 0x66, 0xa1, 0x9a, 0x15, 0xb3, 0x90, 0xcd, 0x88,
-	0x9a, 0x13, 0x33, 0x52, 0xbb, 0xc7, 0x68, 0xfa, 0xe9, 0xc9, 0x52, 0xba, 0xd1, 0x5b, 0x83, 0x14,
-	0x32, 0xc2, 0x6e, 0xc6, 0x08, 0x1b, 0xd2, 0xa3, 0x3f, 0x4f, 0x82, 0xc2, 0xaa, 0x65, 0x21, 0x42,
-	0xb6, 0x5c, 0x42, 0x1b, 0x3d, 0xe5, 0x1d, 0x90, 0xb5, 0x5a, 0xd0, 0xf5, 0x9b, 0xae, 0xcd, 0x29,
-	0xcb, 0xd5, 0x8d, 0xf3, 0x0e, 0x9d, 0x79, 0xc8, 0xc0, 0x9b, 0x6b, 0xc7, 0x03, 0x2d, 0x63, 0x89,
-	0xa1, 0x19, 0x0d, 0xec, 0x11, 0xf7, 0x89, 0x33, 0xb9, 0x4f, 0xfe, 0x6b, 0xee, 0xe5, 0xf3, 0xb9,
-	0x4f, 0x4d, 0x72, 0x9f, 0xbe, 0x32, 0xf7, 0x99, 0x18, 0xf7, 0x1f, 0x82, 0x2c, 0xe4, 0x44, 0x21,
-	0xa2, 0x66, 0xcb, 0xc9, 0x4a, 0x7e, 0xf9, 0x76, 0xf5, 0xf4, 0x95, 0x50, 0x15, 0x54, 0x36, 0x3a,
-	0x41, 0x1b, 0xd5, 0xef, 0x3e, 0x1d, 0x68, 0x33, 0xc7, 0x03, 0x0d, 0xc0, 0x13, 0x7e, 0x7f, 0x78,
-	0xae, 0x81, 0x11, 0xdb, 0xe2, 0xb9, 0x38, 0xa9, 0x2a, 0xd4, 0xcd, 0x8d, 0xa9, 0x0b, 0xc6, 0xd4,
-	0xcd, 0x0f, 0xd5, 0x5d, 0x9c, 0x54, 0x77, 0x3e, 0xa6, 0x6e, 0x5c, 0x50, 0xfd, 0x6b, 0x19, 0x14,
-	0xd6, 0xfa, 0x3e, 0xf4, 0x5c, 0x6b, 0x03, 0xa1, 0xff, 0x45, 0xe1, 0x15, 0x90, 0x67, 0x0a, 0x53,
-	0x37, 0x68, 0x5a, 0x30, 0xb8, 0x58, 0x63, 0xe6, 0x87, 0x86, 0x1b, 0x3c, 0x84, 0xc1, 0x30, 0x75,
-	0x0f, 0x21, 0x9e, 0x2a, 0x5f, 0x26, 0x75, 0x03, 0x21, 0x96, 0x1a, 0xf9, 0x23, 0x75, 0xbe, 0x3f,
-	0xd2, 0x93, 0xfe, 0xc8, 0x5c, 0xd9, 0x1f, 0xd9, 0x33, 0xfc, 0x91, 0xfb, 0xef, 0xfc, 0x01, 0xc6,
-	0xfc, 0x91, 0x1f, 0xf3, 0x47, 0xe1, 0x92, 0xfe, 0x88, 0xdb, 0x41, 0xd7, 0x41, 0x71, 0xbd, 0x47,
-	0x91, 0x4f, 0x5c, 0xec, 0xbf, 0x1b, 0xf0, 0x17, 0xc9, 0xe8, 0x2e, 0xad, 0xc9, 0xac, 0x92, 0xfe,
-	0xad, 0x04, 0xe6, 0xc6, 0xee, 0x58, 0x13, 0x91, 0x00, 0xfb, 0x84, 0x33, 0xc1, 0x6f, 0x7d, 0x6e,
-	0xa4, 0xe8, 0x8e, 0xbf, 0x0f, 0xe4, 0x36, 0x76, 0x88, 0x9a, 0xe0, 0x2c, 0xcc, 0x4d, 0xb2, 0xb0,
-	0x85, 0x1d, 0x93, 0x43, 0x94, 0x1b, 0x20, 0x19, 0x22, 0xca, 0x1d, 0x52, 0x30, 0xd9, 0x50, 0x59,
-	0x00, 0xd9, 0xae, 0xd7, 0x44, 0x61, 0x88, 0xc3, 0xe8, 0x1e, 0xcd, 0x74, 0xbd, 0x75, 0x36, 0x65,
-	0x21, 0xe6, 0x8d, 0x0e, 0x41, 0xb6, 0x50, 0xd9, 0xcc, 0x38, 0x90, 0x3c, 0x22, 0xc8, 0x8e, 0x8e,
-	0xf9, 0xa3, 0x04, 0xae, 0x6f, 0x13, 0xe7, 0x51, 0x60, 0x43, 0x8a, 0x76, 0x60, 0x08, 0x3d, 0xc2,
-	0x6e, 0x1b, 0xd8, 0xa1, 0x2d, 0x1c, 0xba, 0xb4, 0x1f, 0xd9, 0x5d, 0xfd, 0xf9, 0xc9, 0xd2, 0x6c,
-	0x74, 0xa8, 0x55, 0xdb, 0x0e, 0x11, 0x21, 0xef, 0xd1, 0xd0, 0xf5, 0x1d, 0x73, 0x04, 0x55, 0xde,
-	0x02, 0xe9, 0x80, 0x57, 0xe0, 0xd6, 0xce, 0x2f, 0xab, 0x93, 0x6d, 0x88, 0x1d, 0xea, 0x39, 0xa6,
-	0xa3, 0xd0, 0x2a, 0x4a, 0xa9, 0x2d, 0x3f, 0x3e, 0xda, 0x5f, 0x1c, 0x15, 0x63, 0xfc, 0x6b, 0x31,
-	0xfe, 0x7b, 0x86, 0x78, 0x67, 0xc5, 0x0f, 0xaa, 0x2f, 0x80, 0xf9, 0x53, 0x4b, 0x43, 0x92, 0xf5,
-	0x5f, 0x25, 0xf0, 0xd2, 0x36, 0x71, 0x4c, 0xe4, 0xb8, 0x84, 0xa2, 0x70, 0x27, 0x44, 0xae, 0x4f,
-	0x28, 0x6c, 0xb7, 0xaf, 0xde, 0xde, 0x26, 0xc8, 0x07, 0xa3, 0x32, 0x91, 0x54, 0xb7, 0xa6, 0xf4,
-	0x78, 0x02, 0x8a, 0xf7, 0x19, 0xcf, 0xad, 0xad, 0x4c, 0x36, 0x7b, 0x6f, 0x4a, 0xb3, 0x53, 0x4e,
-	0xaf, 0x97, 0x41, 0x69, 0x7a, 0x64, 0xd8, 0xfa, 0xf2, 0x1f, 0x09, 0x90, 0xdc, 0x26, 0x8e, 0xf2,
-	0x29, 0x00, 0xb1, 0xaf, 0x16, 0x6d, 0xf2, 0xa0, 0x63, 0xf6, 0x2c, 0xbe, 0x7a, 0x01, 0xe0, 0x84,
-	0xda, 0xbb, 0x8f, 0x7f, 0xf9, 0xeb, 0xab, 0x84, 0xa6, 0xdf, 0x36, 0x26, 0xbf, 0xdc, 0x22, 0x74,
-	0x93, 0xf6, 0x94, 0x0f, 0x40, 0x61, 0xcc, 0x55, 0x77, 0xa6, 0xd6, 0x8f, 0x43, 0x8a, 0xf7, 0x2f,
-	0x84, 0x9c, 0x3c, 0x44, 0x1f, 0x83, 0x9b, 0xd3, 0xb4, 0xad, 0x4c, 0xad, 0x30, 0x05, 0x59, 0x7c,
-	0xfd, 0xb2, 0xc8, 0xe1, 0x96, 0xc5, 0xd4, 0x67, 0x4c, 0xc8, 0x7a, 0xed, 0xe9, 0x41, 0x49, 0x7a,
-	0x76, 0x50, 0x92, 0xfe, 0x3c, 0x28, 0x49, 0x5f, 0x1e, 0x96, 0x66, 0x9e, 0x1d, 0x96, 0x66, 0x7e,
-	0x3b, 0x2c, 0xcd, 0xbc, 0x5f, 0x76, 0x5c, 0xda, 0xea, 0xec, 0x56, 0x2d, 0xec, 0x19, 0xa7, 0xd5,
-	0xa4, 0xfd, 0x00, 0x91, 0xdd, 0x34, 0xff, 0x48, 0x7c, 0xe3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8a, 0x31, 0x4a, 0xe9, 0x34, 0x0b, 0x00, 0x00,
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcb, 0x8b, 0xdb, 0x46,
+	0x18, 0x5f, 0xd9, 0xf2, 0x6b, 0xec, 0xbc, 0x94, 0x4d, 0xa3, 0x35, 0x89, 0xe5, 0xa8, 0x4d, 0xe2,
+	0x6c, 0x59, 0xa9, 0xd9, 0x40, 0x21, 0xee, 0xc9, 0xce, 0xa3, 0xa4, 0xec, 0xd2, 0xa0, 0x38, 0x97,
+	0x52, 0x70, 0x27, 0xd2, 0x44, 0x16, 0xb5, 0x34, 0xaa, 0x66, 0x6c, 0xec, 0x40, 0xa1, 0xa4, 0x97,
+	0xd2, 0x53, 0xa1, 0xe7, 0x40, 0x0f, 0x3d, 0xb4, 0x3d, 0xe5, 0x90, 0x53, 0x8f, 0x3d, 0x85, 0x9e,
+	0x42, 0x0b, 0xa5, 0x94, 0xe2, 0x94, 0xdd, 0x42, 0x20, 0xc7, 0xfe, 0x05, 0x65, 0x66, 0x64, 0x5b,
+	0x5a, 0x3b, 0x8f, 0x86, 0xb6, 0x50, 0x28, 0x88, 0x65, 0x66, 0xbe, 0xef, 0x9b, 0x6f, 0x7e, 0x0f,
+	0xad, 0x47, 0x60, 0xcd, 0xc6, 0xc4, 0xc7, 0xc4, 0x44, 0x43, 0xdf, 0x64, 0xcf, 0x59, 0x93, 0x8e,
+	0x8c, 0x30, 0xc2, 0x14, 0x2b, 0x07, 0x45, 0xc8, 0x40, 0x43, 0xdf, 0x60, 0xcf, 0xd9, 0xea, 0x21,
+	0xe8, 0x7b, 0x01, 0x36, 0xf9, 0x5f, 0x91, 0x54, 0xad, 0x2e, 0xd4, 0xb3, 0x74, 0x11, 0x3b, 0x1a,
+	0xc7, 0x7c, 0xe2, 0xb2, 0x80, 0x4f, 0xdc, 0x38, 0x10, 0x37, 0xed, 0xf2, 0x99, 0x19, 0xb7, 0x11,
+	0xa1, 0x55, 0x17, 0xbb, 0x58, 0xac, 0xb3, 0x51, 0xbc, 0x7a, 0xcc, 0xc5, 0xd8, 0xed, 0x23, 0x13,
+	0x86, 0x9e, 0x09, 0x83, 0x00, 0x53, 0x48, 0x3d, 0x1c, 0x4c, 0x6b, 0xd6, 0xe2, 0x28, 0x9f, 0xdd,
+	0x18, 0xdc, 0x34, 0x61, 0x30, 0x16, 0x21, 0x7d, 0x57, 0x02, 0xfb, 0xb6, 0x89, 0x7b, 0x89, 0xf6,
+	0x50, 0x84, 0x06, 0x7e, 0x67, 0xa4, 0x34, 0x80, 0xec, 0x40, 0x0a, 0x55, 0xa9, 0x2e, 0x35, 0xca,
+	0x9b, 0xab, 0x86, 0xa8, 0x35, 0xa6, 0xb5, 0x46, 0x2b, 0x18, 0x5b, 0x3c, 0x43, 0xa9, 0x01, 0x99,
+	0x78, 0xb7, 0x90, 0x9a, 0xa9, 0x4b, 0x0d, 0xa9, 0x0d, 0x1e, 0x4f, 0x34, 0x69, 0xe3, 0xab, 0x47,
+	0x77, 0xd7, 0x25, 0x8b, 0xaf, 0x2b, 0xaf, 0x00, 0xb9, 0x07, 0x49, 0x4f, 0xcd, 0xd6, 0xa5, 0x46,
+	0xa9, 0x7d, 0xf0, 0x8f, 0x89, 0x56, 0x88, 0xfa, 0x61, 0x53, 0xdf, 0xd0, 0xe3, 0x2c, 0x16, 0x55,
+	0x5e, 0x05, 0x07, 0x1c, 0x14, 0x46, 0xc8, 0x86, 0x14, 0x39, 0xdd, 0x9b, 0x11, 0xf6, 0x55, 0x99,
+	0x17, 0x64, 0x54, 0xc9, 0xda, 0x3f, 0x0f, 0x5d, 0x8e, 0xb0, 0xaf, 0x28, 0x40, 0xe6, 0x19, 0xb9,
+	0xba, 0xd4, 0xa8, 0x58, 0x7c, 0xdc, 0x3c, 0xf1, 0xc9, 0x17, 0xda, 0xca, 0xa7, 0x8f, 0xee, 0xae,
+	0xab, 0x09, 0xaa, 0x53, 0x98, 0xf4, 0xaf, 0x33, 0xa0, 0xb8, 0x85, 0x5c, 0x68, 0x8f, 0x3b, 0x23,
+	0x65, 0x15, 0xe4, 0x02, 0x1c, 0xd8, 0x88, 0x23, 0x94, 0x2d, 0x31, 0x51, 0x5e, 0x07, 0x25, 0x17,
+	0x32, 0xc6, 0x3d, 0x5b, 0x20, 0x2a, 0xb5, 0xd7, 0x7e, 0x99, 0x68, 0x47, 0xc4, 0x9e, 0xc4, 0x79,
+	0xdf, 0xf0, 0xb0, 0xe9, 0x43, 0xda, 0x33, 0xae, 0x04, 0xd4, 0x2a, 0xba, 0x90, 0x5c, 0x65, 0xa9,
+	0x4a, 0x0d, 0x64, 0x5d, 0x48, 0x38, 0x46, 0xb9, 0x5d, 0xd9, 0x99, 0x68, 0xc5, 0x37, 0x21, 0xd9,
+	0xf2, 0x7c, 0x8f, 0x5a, 0x2c, 0xa0, 0xec, 0x07, 0x19, 0x8a, 0x05, 0x22, 0x2b, 0x43, 0xb1, 0x72,
+	0x1e, 0xe4, 0x86, 0xb0, 0x3f, 0x40, 0x1c, 0x42, 0xa9, 0xfd, 0xf2, 0x13, 0x7b, 0xec, 0x4c, 0xb4,
+	0x7c, 0xcb, 0xc7, 0x83, 0x80, 0x5a, 0xa2, 0x82, 0x81, 0xe7, 0xca, 0xe4, 0x05, 0x78, 0xae, 0x41,
+	0x05, 0x48, 0x43, 0xb5, 0xc0, 0x17, 0xa4, 0x21, 0x9b, 0x45, 0x6a, 0x51, 0xcc, 0x22, 0x36, 0x23,
+	0x6a, 0x49, 0xcc, 0x48, 0xf3, 0x14, 0xa3, 0xe9, 0xfb, 0x7b, 0x1b, 0xf9, 0xce, 0xe8, 0x22, 0xa4,
+	0x90, 0x11, 0x76, 0x38, 0x41, 0xd8, 0x94, 0x1e, 0xfd, 0x61, 0x16, 0x54, 0x5a, 0xb6, 0x8d, 0x08,
+	0xd9, 0xf2, 0x08, 0xed, 0x8c, 0x94, 0xb7, 0x40, 0xd1, 0xee, 0x41, 0x2f, 0xe8, 0x7a, 0x0e, 0xa7,
+	0xac, 0xd4, 0x36, 0x9f, 0x76, 0xe8, 0xc2, 0x05, 0x96, 0x7c, 0xe5, 0xe2, 0xe3, 0x89, 0x56, 0xb0,
+	0xc5, 0xd0, 0x8a, 0x07, 0xce, 0x9c, 0xfb, 0xcc, 0x13, 0xb9, 0xcf, 0xfe, 0x65, 0xee, 0xe5, 0xa7,
+	0x73, 0x9f, 0x5b, 0xe4, 0x3e, 0xff, 0xc2, 0xdc, 0x17, 0x12, 0xdc, 0xbf, 0x07, 0x8a, 0x90, 0x13,
+	0x85, 0x88, 0x5a, 0xac, 0x67, 0x1b, 0xe5, 0xcd, 0xe3, 0xc6, 0xde, 0x7f, 0x09, 0x86, 0xa0, 0xb2,
+	0x33, 0x08, 0xfb, 0xa8, 0x7d, 0xf2, 0xfe, 0x44, 0x5b, 0x79, 0x3c, 0xd1, 0x00, 0x9c, 0xf1, 0xfb,
+	0xcd, 0x43, 0x0d, 0xcc, 0xd9, 0x16, 0xef, 0xc5, 0x6c, 0x57, 0xa1, 0x6e, 0x29, 0xa5, 0x2e, 0x48,
+	0xa9, 0x5b, 0x9e, 0xaa, 0xbb, 0xbe, 0xa8, 0xee, 0xd1, 0x84, 0xba, 0x49, 0x41, 0xf5, 0x3b, 0x32,
+	0xa8, 0x5c, 0x1c, 0x07, 0xd0, 0xf7, 0xec, 0xcb, 0x08, 0xfd, 0x2b, 0x0a, 0x9f, 0x07, 0x65, 0xa6,
+	0x30, 0xf5, 0xc2, 0xae, 0x0d, 0xc3, 0x67, 0x6b, 0xcc, 0xfc, 0xd0, 0xf1, 0xc2, 0x0b, 0x30, 0x9c,
+	0x96, 0xde, 0x44, 0x88, 0x97, 0xca, 0xcf, 0x53, 0x7a, 0x19, 0x21, 0x56, 0x1a, 0xfb, 0x23, 0xf7,
+	0x74, 0x7f, 0xe4, 0x17, 0xfd, 0x51, 0x78, 0x61, 0x7f, 0x14, 0x9f, 0xe0, 0x8f, 0xd2, 0x3f, 0xe7,
+	0x0f, 0x90, 0xf2, 0x47, 0x39, 0xe5, 0x8f, 0xca, 0x73, 0xfa, 0x23, 0x69, 0x07, 0xfd, 0x4e, 0x0e,
+	0x94, 0xae, 0x21, 0x7a, 0x01, 0x3b, 0xff, 0x9b, 0xe3, 0x3f, 0x6c, 0x8e, 0x8f, 0x25, 0xb0, 0x1f,
+	0x0e, 0x68, 0x0f, 0x47, 0xde, 0x2d, 0x71, 0x1d, 0x50, 0x01, 0x6f, 0x74, 0x6a, 0xb1, 0x51, 0x2c,
+	0x77, 0x2b, 0x99, 0xde, 0x3e, 0x17, 0x77, 0x3c, 0x94, 0xda, 0x25, 0x6e, 0x7c, 0xa8, 0xb5, 0x77,
+	0x51, 0xf4, 0xdf, 0xd3, 0x52, 0x58, 0xb4, 0x9c, 0xb2, 0x68, 0x25, 0x65, 0xd1, 0x7d, 0x53, 0x8b,
+	0x9e, 0x5e, 0xb4, 0xe8, 0x6a, 0xc2, 0xa2, 0x33, 0x47, 0xea, 0xdf, 0x49, 0x60, 0x75, 0xd9, 0x81,
+	0xff, 0x56, 0xab, 0xaa, 0xa0, 0x00, 0x1d, 0x27, 0x42, 0x84, 0x88, 0xdb, 0x80, 0x35, 0x9d, 0xce,
+	0x4d, 0x9c, 0x4d, 0x9a, 0x98, 0xe3, 0x94, 0x53, 0x38, 0x73, 0x29, 0x9c, 0xf9, 0x29, 0x4e, 0x99,
+	0xe1, 0xd4, 0x75, 0x50, 0xbd, 0x34, 0xa2, 0x28, 0x20, 0x1e, 0x0e, 0xde, 0x0e, 0x39, 0x57, 0xf3,
+	0x0b, 0x4b, 0x9c, 0xf3, 0xa5, 0x04, 0x8e, 0xa4, 0x2e, 0x32, 0x16, 0x22, 0x21, 0x0e, 0x08, 0x77,
+	0x14, 0xbf, 0x5a, 0x71, 0x94, 0xf1, 0x45, 0xea, 0x0c, 0x90, 0xfb, 0xd8, 0x65, 0xc7, 0x65, 0x22,
+	0x1f, 0x59, 0x14, 0x79, 0x0b, 0xbb, 0x16, 0x4f, 0x51, 0x0e, 0x82, 0x6c, 0x84, 0x28, 0x07, 0x50,
+	0xb1, 0xd8, 0x50, 0x59, 0x03, 0xc5, 0xa1, 0xdf, 0x45, 0x51, 0x84, 0xa3, 0xf8, 0xb2, 0x52, 0x18,
+	0xfa, 0x97, 0xd8, 0x94, 0x85, 0xd8, 0x3b, 0x36, 0x20, 0xc8, 0x11, 0x6f, 0x8b, 0x55, 0x70, 0x21,
+	0xb9, 0x4e, 0x90, 0x13, 0x1f, 0xf3, 0x5b, 0x09, 0x1c, 0xd8, 0x26, 0xee, 0xf5, 0xd0, 0x81, 0x14,
+	0x5d, 0x85, 0x11, 0xf4, 0x09, 0xfb, 0x49, 0x8f, 0x8d, 0x40, 0xc7, 0xb1, 0x16, 0xea, 0x0f, 0xf7,
+	0x36, 0x62, 0x41, 0x8d, 0x96, 0xe0, 0xf2, 0x1a, 0x8d, 0xbc, 0xc0, 0xb5, 0xe6, 0xa9, 0xca, 0x1b,
+	0x20, 0x1f, 0xf2, 0x1d, 0x38, 0xeb, 0xe5, 0x4d, 0x75, 0x11, 0x86, 0xe8, 0xd0, 0x2e, 0x31, 0x77,
+	0x0a, 0xcf, 0xc5, 0x25, 0xcd, 0xcd, 0xdb, 0x8f, 0xee, 0xae, 0xcf, 0x37, 0x63, 0x0e, 0xd2, 0x12,
+	0x0e, 0x1a, 0x99, 0xe2, 0x62, 0x98, 0x3c, 0xa8, 0xbe, 0x06, 0x8e, 0xee, 0x59, 0x9a, 0x92, 0xac,
+	0xff, 0x24, 0x81, 0x97, 0xb6, 0x89, 0x6b, 0x21, 0xd7, 0x23, 0x14, 0x45, 0x57, 0x23, 0xe4, 0x05,
+	0x84, 0xc2, 0x7e, 0xff, 0xc5, 0xe1, 0x5d, 0x01, 0xe5, 0x70, 0xbe, 0x4d, 0x2c, 0xd5, 0xb1, 0x25,
+	0x18, 0x67, 0x49, 0x49, 0x9c, 0xc9, 0xda, 0xe6, 0xf9, 0x45, 0xb0, 0xa7, 0x96, 0x80, 0x5d, 0x72,
+	0x7a, 0xbd, 0x0e, 0x6a, 0xcb, 0x23, 0x53, 0xe8, 0x9b, 0xbf, 0x66, 0x40, 0x76, 0x9b, 0xb8, 0xca,
+	0x87, 0x00, 0x24, 0x3e, 0x0d, 0xb4, 0xc5, 0x83, 0xa6, 0xec, 0x59, 0x3d, 0xfd, 0x8c, 0x84, 0x19,
+	0xb5, 0x27, 0x6f, 0xff, 0xf8, 0xfb, 0xe7, 0x19, 0x4d, 0x3f, 0x6e, 0x2e, 0x7e, 0x1e, 0xc5, 0xd9,
+	0x5d, 0x3a, 0x52, 0xde, 0x05, 0x95, 0x94, 0xab, 0x4e, 0x2c, 0xdd, 0x3f, 0x99, 0x52, 0x3d, 0xf3,
+	0xcc, 0x94, 0xd9, 0x4b, 0xf4, 0x01, 0x38, 0xbc, 0x4c, 0xdb, 0xc6, 0xd2, 0x1d, 0x96, 0x64, 0x56,
+	0x5f, 0x7b, 0xde, 0xcc, 0x69, 0xcb, 0x6a, 0xee, 0x23, 0x26, 0x64, 0xbb, 0x79, 0x7f, 0xa7, 0x26,
+	0x3d, 0xd8, 0xa9, 0x49, 0xbf, 0xed, 0xd4, 0xa4, 0xcf, 0x76, 0x6b, 0x2b, 0x0f, 0x76, 0x6b, 0x2b,
+	0x3f, 0xef, 0xd6, 0x56, 0xde, 0xa9, 0xbb, 0x1e, 0xed, 0x0d, 0x6e, 0x18, 0x36, 0xf6, 0xcd, 0xbd,
+	0x6a, 0xd2, 0x71, 0x88, 0xc8, 0x8d, 0x3c, 0xff, 0x12, 0x3b, 0xf7, 0x67, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0xd3, 0x64, 0x8c, 0xec, 0x99, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1193,30 +1316,7 @@ func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetCodeTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1226,39 +1326,41 @@ func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetCodeTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetCodeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6a
 	}
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x62
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x5a
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1266,60 +1368,99 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetCodeAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1329,12 +1470,206 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetCodeAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetCodeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1601,62 +1936,157 @@ func (m *DynamicFeeTx) Size() (n int) {
 	return n
 }
 
-func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+func (m *SetCodeTx) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgEthereumTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Ret)
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.VmError)
+	l = len(m.R)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *SetCodeAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1729,7 +2159,742 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Size_ = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1756,29 +2921,16 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Size_ = float64(math.Float64frombits(v))
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1788,29 +2940,31 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1820,27 +2974,29 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedFrom = string(dAtA[iNdEx:postIndex])
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1867,9 +3023,9 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
-			if m.From == nil {
-				m.From = []byte{}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1893,7 +3049,7 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,13 +3072,49 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -1941,9 +3133,9 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1972,12 +3164,48 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -1996,7 +3224,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -2028,7 +3256,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2064,7 +3292,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -2098,7 +3326,41 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -2132,7 +3394,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2166,7 +3428,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -2221,7 +3483,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+func (m *SetCodeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,10 +3506,10 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCodeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCodeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2307,7 +3569,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2336,12 +3598,48 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -2360,7 +3658,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -2392,7 +3690,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2428,7 +3726,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -2462,7 +3760,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
 			}
@@ -2496,7 +3794,41 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, SetCodeAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -2530,7 +3862,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2564,7 +3896,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -2619,7 +3951,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+func (m *SetCodeAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,10 +3974,10 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCodeAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCodeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2685,63 +4017,8 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.GasTipCap = &v
-			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2769,138 +4046,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasFeeCap = &v
-			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2910,27 +4062,12 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -2964,7 +4101,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2998,7 +4135,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}